@@ -1,11 +1,11 @@
 package main
 
 import (
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	"log"
 	"runtime"
-	"fmt"
 )
 
 const (
@@ -14,59 +14,15 @@ const (
 	Title = "Basic Rendering Pipeline"
 )
 
-func initGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	// create a window, requesting OpenGL version 3.3 with the core profile and forward compatibility enabled
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-
-	// specify a 24-bit depth buffer, and an 8-bit stencil buffer; 32-bit depth buffers cause problems on old macbooks
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
+func main() {
+	runtime.LockOSThread()
 
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title})
 
 	if err != nil {
-		panic(err)
-	}
-
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
-
-	return window
-}
-
-func initOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
+		log.Fatal(err.Error())
 	}
 
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
-
-	// specify a color to wipe the screen with after every frame is drawn; thanks to Ethan Schoonover for Solarized
-	gl.ClearColor(0, 0.1568627451, 0.2039215686, 1)
-
-	width, height := window.GetFramebufferSize()
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
-
-func main() {
-	runtime.LockOSThread()
-
-	window := initGLFW()
-	initOpenGL(window)
-
 	points := []float32{
 		0.0, 0.5, 0.0,
 		-0.5, -0.5, 0.0,
@@ -117,24 +73,11 @@ void main() {
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	for !window.ShouldClose() {
 		width, height := window.GetFramebufferSize()
@@ -142,7 +85,7 @@ void main() {
 		// wipe the drawing surface clear
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		// bind the shader program to be used in this render pass
-		gl.UseProgram(shaderProgram)
+		shaderProgram.Use()
 		// bind the vao containing the triangle vertex data
 		gl.BindVertexArray(vaoId)
 		// draw 3 vertices from the currently bound VAO with current in-use shader
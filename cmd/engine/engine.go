@@ -0,0 +1,155 @@
+// Package engine opens the window and OpenGL context that the examples draw into.
+package engine
+
+import (
+	"fmt"
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"log"
+)
+
+// Profile is the kind of OpenGL context to ask for.
+type Profile int
+
+const (
+	CoreProfile Profile = iota
+	CompatibilityProfile
+	AnyProfile // leaves the choice to the driver, needed for versions before 3.2
+)
+
+// Options describes the window and context. The zero value asks for the examples' usual 640x480, non-resizable
+// OpenGL 3.3 core context.
+type Options struct {
+	Width        int // defaults to 640
+	Height       int // defaults to 480
+	Title        string
+	Resizable    bool
+	VSync        bool
+	Samples      int // MSAA samples per pixel, 0 turns multisampling off
+	MajorVersion int // defaults to 3.3
+	MinorVersion int
+	Profile      Profile
+	Debug        bool        // asks for a debug context, drivers then report more through GetError and their logs
+	ClearColor   *[4]float32 // defaults to Solarized base03
+}
+
+// DefaultClearColor is the colour the screen is wiped with after every frame unless Options say otherwise;
+// thanks to Ethan Schoonover for Solarized.
+var DefaultClearColor = [4]float32{0, 0.1568627451, 0.2039215686, 1}
+
+// Init creates the window, makes its context current and sets up the GL state the examples share. It must be
+// called from the main thread, after runtime.LockOSThread.
+func Init(options Options) (*glfw.Window, error) {
+	if options.Width == 0 {
+		options.Width = 640
+	}
+
+	if options.Height == 0 {
+		options.Height = 480
+	}
+
+	if options.MajorVersion == 0 {
+		options.MajorVersion, options.MinorVersion = 3, 3
+	}
+
+	if options.Width < 0 || options.Height < 0 || options.Samples < 0 {
+		return nil, fmt.Errorf("engine: invalid window size %dx%d with %d samples", options.Width, options.Height, options.Samples)
+	}
+
+	if err := glfw.Init(); err != nil {
+		return nil, fmt.Errorf("engine: %v", err)
+	}
+
+	glfw.WindowHint(glfw.Resizable, glfwBool(options.Resizable))
+	glfw.WindowHint(glfw.ContextVersionMajor, options.MajorVersion)
+	glfw.WindowHint(glfw.ContextVersionMinor, options.MinorVersion)
+	glfw.WindowHint(glfw.OpenGLDebugContext, glfwBool(options.Debug))
+	glfw.WindowHint(glfw.Samples, options.Samples)
+
+	switch options.Profile {
+	case CoreProfile:
+		// macOS only hands out core contexts that are forward compatible
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	case CompatibilityProfile:
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCompatProfile)
+	case AnyProfile:
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLAnyProfile)
+	default:
+		glfw.Terminate()
+		return nil, fmt.Errorf("engine: unknown profile %d", options.Profile)
+	}
+
+	// specify a 24-bit depth buffer, and an 8-bit stencil buffer; 32-bit depth buffers cause problems on old macbooks
+	glfw.WindowHint(glfw.DepthBits, 24)
+	glfw.WindowHint(glfw.StencilBits, 8)
+
+	window, err := glfw.CreateWindow(options.Width, options.Height, options.Title, nil, nil)
+
+	if err != nil {
+		glfw.Terminate()
+		return nil, fmt.Errorf("engine: %v", err)
+	}
+
+	window.SetCursorPos(0, 0)
+	window.MakeContextCurrent()
+
+	if options.VSync {
+		glfw.SwapInterval(1)
+	} else {
+		glfw.SwapInterval(0)
+	}
+
+	if err := gl.Init(); err != nil {
+		window.Destroy()
+		glfw.Terminate()
+		return nil, fmt.Errorf("engine: %v", err)
+	}
+
+	version := gl.GoStr(gl.GetString(gl.VERSION))
+	log.Println("OpenGL version", version)
+
+	clearColor := DefaultClearColor
+
+	if options.ClearColor != nil {
+		clearColor = *options.ClearColor
+	}
+
+	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
+
+	width, height := window.GetFramebufferSize()
+	gl.Viewport(0, 0, int32(width), int32(height))
+	gl.FrontFace(gl.CCW)
+	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
+	gl.Enable(gl.DEPTH_TEST)
+	gl.Enable(gl.BLEND)
+
+	if options.Samples > 0 {
+		gl.Enable(gl.MULTISAMPLE)
+	}
+
+	if err := CheckError(); err != nil {
+		window.Destroy()
+		glfw.Terminate()
+		return nil, err
+	}
+
+	return window, nil
+}
+
+func glfwBool(b bool) int {
+	if b {
+		return glfw.True
+	}
+
+	return glfw.False
+}
+
+// CheckError returns the oldest GL error flag that is set, if any.
+func CheckError() error {
+	if err := gl.GetError(); err != gl.NO_ERROR {
+		return fmt.Errorf("engine: GL error 0x%X", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"log"
+	"strings"
+)
+
+// Variable is an active uniform or attribute of a linked program.
+type Variable struct {
+	Location int32
+	Type     uint32 // gl.FLOAT_MAT4, gl.SAMPLER_2D and so on
+	Size     int32  // the array length, 1 for anything that is not an array
+}
+
+// Program is a linked shader program whose active uniforms and attributes are looked up once, at link time, so
+// that setting them each frame needs no GL queries or C strings.
+type Program struct {
+	ID         uint32
+	Uniforms   map[string]Variable // arrays are under both "name" and "name[0]"
+	Attributes map[string]Variable
+
+	warned map[string]bool
+}
+
+// NewProgram compiles and links a vertex and fragment shader pair and reads the program's active variables.
+func NewProgram(vertexSource, fragmentSource string) (*Program, error) {
+	id, err := CompileProgram(vertexSource, fragmentSource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Introspect(id), nil
+}
+
+// Introspect reads the active uniforms and attributes of a program that is already linked.
+func Introspect(id uint32) *Program {
+	p := &Program{ID: id, Uniforms: map[string]Variable{}, Attributes: map[string]Variable{}, warned: map[string]bool{}}
+
+	p.read(gl.ACTIVE_UNIFORMS, gl.ACTIVE_UNIFORM_MAX_LENGTH, gl.GetActiveUniform, gl.GetUniformLocation, p.Uniforms)
+	p.read(gl.ACTIVE_ATTRIBUTES, gl.ACTIVE_ATTRIBUTE_MAX_LENGTH, gl.GetActiveAttrib, gl.GetAttribLocation, p.Attributes)
+
+	return p
+}
+
+func (p *Program) read(countName, lengthName uint32, active func(uint32, uint32, int32, *int32, *int32, *uint32, *uint8),
+	location func(uint32, *uint8) int32, variables map[string]Variable) {
+	var count, maxLength int32
+	gl.GetProgramiv(p.ID, countName, &count)
+	gl.GetProgramiv(p.ID, lengthName, &maxLength)
+
+	for i := int32(0); i < count; i++ {
+		// the extra byte keeps the name NUL terminated for the location query
+		buffer := make([]uint8, maxLength+1)
+		var length, size int32
+		var kind uint32
+		active(p.ID, uint32(i), maxLength, &length, &size, &kind, &buffer[0])
+		name := string(buffer[:length])
+
+		// built in inputs such as gl_VertexID and uniform block members have no location
+		v := Variable{Location: location(p.ID, &buffer[0]), Type: kind, Size: size}
+
+		if strings.HasPrefix(name, "gl_") || v.Location < 0 {
+			continue
+		}
+
+		variables[name] = v
+
+		if strings.HasSuffix(name, "[0]") {
+			variables[strings.TrimSuffix(name, "[0]")] = v
+		}
+	}
+}
+
+// Use makes the program current. The setters only affect the current program.
+func (p *Program) Use() {
+	gl.UseProgram(p.ID)
+}
+
+// Delete frees the program.
+func (p *Program) Delete() {
+	gl.DeleteProgram(p.ID)
+}
+
+// Location returns a uniform's location, or -1 after logging a warning, once per name, when the program has no
+// such active uniform. GLSL compilers drop uniforms that do not affect the output, so a misspelt name and an
+// unused uniform look the same. Setting location -1 is ignored by GL.
+func (p *Program) Location(name string) int32 {
+	if v, present := p.Uniforms[name]; present {
+		return v.Location
+	}
+
+	if !p.warned[name] {
+		log.Printf("engine: program %d has no active uniform %q, it is misspelt or unused by the shaders", p.ID, name)
+		p.warned[name] = true
+	}
+
+	return -1
+}
+
+// SetMat4 sets a mat4 uniform, or as many elements of a mat4 array as there are matrices, from row-major values
+// in the order Matrix4f.Get1D returns them.
+func (p *Program) SetMat4(name string, values []float32) {
+	if location := p.Location(name); location >= 0 && len(values) >= 16 {
+		gl.UniformMatrix4fv(location, int32(len(values)/16), true, &values[0])
+	}
+}
+
+// SetSampler binds a sampler uniform to a texture unit, 0 for gl.TEXTURE0.
+func (p *Program) SetSampler(name string, unit int) {
+	gl.Uniform1i(p.Location(name), int32(unit))
+}
+
+// SetFloat sets a float uniform.
+func (p *Program) SetFloat(name string, value float32) {
+	gl.Uniform1f(p.Location(name), value)
+}
+
+// SetInt sets an int uniform.
+func (p *Program) SetInt(name string, value int32) {
+	gl.Uniform1i(p.Location(name), value)
+}
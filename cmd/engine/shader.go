@@ -0,0 +1,220 @@
+package engine
+
+import (
+	"fmt"
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ShaderError is a shader that failed to compile or a program that failed to link or validate. Its message
+// carries the driver's info log and, for compile failures, the source lines the log points at.
+type ShaderError struct {
+	Stage  string // "vertex", "fragment", "geometry", "link" or "validate"
+	Log    string // the driver's info log
+	Source string // the shader source, empty unless Stage is a shader stage
+}
+
+func (e *ShaderError) Error() string {
+	var message string
+
+	switch e.Stage {
+	case "link":
+		message = "engine: program failed to link"
+	case "validate":
+		message = "engine: program failed to validate"
+	default:
+		message = fmt.Sprintf("engine: %s shader failed to compile", e.Stage)
+	}
+
+	if log := strings.TrimSpace(e.Log); log != "" {
+		message += ":\n" + log
+	}
+
+	if excerpt := e.Excerpt(2); excerpt != "" {
+		message += "\n\n" + excerpt
+	}
+
+	return message
+}
+
+// NVIDIA logs "0(12) : error", Mesa "0:12(5): error" and AMD, Apple and ANGLE "ERROR: 0:12: ...", the first
+// number is the source string and the second the line
+var logLine = regexp.MustCompile(`(?m)^\s*(?:(?:ERROR|WARNING|error|warning):\s*)?\d+[:(](\d+)`)
+
+// Lines returns the source line numbers, counted from 1, that the info log points at in the order they appear.
+func (e *ShaderError) Lines() []int {
+	var lines []int
+	seen := map[int]bool{}
+
+	for _, match := range logLine.FindAllStringSubmatch(e.Log, -1) {
+		line, err := strconv.Atoi(match[1])
+
+		if err == nil && line > 0 && !seen[line] {
+			lines = append(lines, line)
+			seen[line] = true
+		}
+	}
+
+	return lines
+}
+
+// Excerpt returns the offending source lines with context lines either side, numbered and marked with >. It is
+// empty when there is no source or the log names no lines in it.
+func (e *ShaderError) Excerpt(context int) string {
+	if e.Source == "" {
+		return ""
+	}
+
+	source := strings.Split(strings.TrimRight(e.Source, "\n"), "\n")
+	offending := map[int]bool{}
+
+	for _, line := range e.Lines() {
+		if line <= len(source) {
+			offending[line] = true
+		}
+	}
+
+	if len(offending) == 0 {
+		return ""
+	}
+
+	var excerpt []string
+	width := len(strconv.Itoa(len(source)))
+	last := 0
+
+	for line := 1; line <= len(source); line++ {
+		near := false
+
+		for l := line - context; l <= line+context; l++ {
+			near = near || offending[l]
+		}
+
+		if !near {
+			continue
+		}
+
+		if last != 0 && line > last+1 {
+			excerpt = append(excerpt, "  ...")
+		}
+
+		marker := " "
+
+		if offending[line] {
+			marker = ">"
+		}
+
+		excerpt = append(excerpt, fmt.Sprintf("%s %*d | %s", marker, width, line, source[line-1]))
+		last = line
+	}
+
+	return strings.Join(excerpt, "\n")
+}
+
+var stageNames = map[uint32]string{gl.VERTEX_SHADER: "vertex", gl.FRAGMENT_SHADER: "fragment", gl.GEOMETRY_SHADER: "geometry"}
+
+// CompileShader compiles the source of one stage, gl.VERTEX_SHADER, gl.FRAGMENT_SHADER or gl.GEOMETRY_SHADER.
+// Failures return a *ShaderError.
+func CompileShader(stage uint32, source string) (uint32, error) {
+	name, known := stageNames[stage]
+
+	if !known {
+		return 0, fmt.Errorf("engine: unknown shader stage 0x%X", stage)
+	}
+
+	shader := gl.CreateShader(stage)
+	sources, free := gl.Strs(source + "\x00")
+	gl.ShaderSource(shader, 1, sources, nil)
+	free()
+	gl.CompileShader(shader)
+
+	var status int32
+	gl.GetShaderiv(shader, gl.COMPILE_STATUS, &status)
+
+	if status == gl.FALSE {
+		err := &ShaderError{Stage: name, Log: infoLog(shader, gl.GetShaderiv, gl.GetShaderInfoLog), Source: source}
+		gl.DeleteShader(shader)
+		return 0, err
+	}
+
+	return shader, nil
+}
+
+// LinkProgram links compiled shaders into a program. The shaders are left alone, they can be deleted once
+// linked. Failures return a *ShaderError.
+func LinkProgram(shaders ...uint32) (uint32, error) {
+	program := gl.CreateProgram()
+
+	for _, shader := range shaders {
+		gl.AttachShader(program, shader)
+	}
+
+	gl.LinkProgram(program)
+
+	for _, shader := range shaders {
+		gl.DetachShader(program, shader)
+	}
+
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+
+	if status == gl.FALSE {
+		err := &ShaderError{Stage: "link", Log: infoLog(program, gl.GetProgramiv, gl.GetProgramInfoLog)}
+		gl.DeleteProgram(program)
+		return 0, err
+	}
+
+	return program, nil
+}
+
+// CompileProgram compiles and links a vertex and fragment shader pair.
+func CompileProgram(vertexSource, fragmentSource string) (uint32, error) {
+	vs, err := CompileShader(gl.VERTEX_SHADER, vertexSource)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer gl.DeleteShader(vs)
+
+	fs, err := CompileShader(gl.FRAGMENT_SHADER, fragmentSource)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer gl.DeleteShader(fs)
+
+	return LinkProgram(vs, fs)
+}
+
+// ValidateProgram checks that a program can run with the current GL state, such as its samplers' texture units.
+// Drivers only validate against the state at the time of the call, so it belongs just before a draw.
+func ValidateProgram(program uint32) error {
+	gl.ValidateProgram(program)
+
+	var status int32
+	gl.GetProgramiv(program, gl.VALIDATE_STATUS, &status)
+
+	if status == gl.FALSE {
+		return &ShaderError{Stage: "validate", Log: infoLog(program, gl.GetProgramiv, gl.GetProgramInfoLog)}
+	}
+
+	return nil
+}
+
+func infoLog(object uint32, get func(uint32, uint32, *int32), read func(uint32, int32, *int32, *uint8)) string {
+	var length int32
+	get(object, gl.INFO_LOG_LENGTH, &length)
+
+	if length <= 0 {
+		return ""
+	}
+
+	buffer := make([]uint8, length)
+	var written int32
+	read(object, length, &written, &buffer[0])
+
+	return string(buffer[:written])
+}
@@ -0,0 +1,118 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestShaderErrorLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		log   string
+		lines []int
+	}{
+		{"NVIDIA", "0(12) : error C1008: undefined variable \"colour\"\n", []int{12}},
+		{"NVIDIA warning", "0(3) : warning C7022: unrecognized profile specifier \"highp\"\n0(7) : error C0000: syntax error\n", []int{3, 7}},
+		{"Mesa", "0:12(5): error: `colour' undeclared\n0:12(5): error: operands to arithmetic operators must be numeric\n", []int{12}},
+		{"Mesa lines in order", "0:9(1): error: syntax error, unexpected '}'\n0:4(10): error: `x' undeclared\n", []int{9, 4}},
+		{"AMD", "ERROR: 0:12: 'colour' : undeclared identifier \nERROR: 1 compilation errors.  No code generated.\n\n", []int{12}},
+		{"Apple", "ERROR: 0:5: Use of undeclared identifier 'colour'\nERROR: 0:6: Use of undeclared identifier 'colour'\n", []int{5, 6}},
+		{"ANGLE warning", "WARNING: 0:2: '' : extension directive should occur before any non-preprocessor tokens\n", []int{2}},
+		{"second source string", "1(8) : error C0000: syntax error\n", []int{8}},
+		{"line zero", "0(0) : error C0501: type name expected\n", nil},
+		{"link log", "error: vertex shader output `uv' not read by fragment shader\n", nil},
+		{"empty", "", nil},
+	}
+
+	for _, test := range tests {
+		if lines := (&ShaderError{Stage: "fragment", Log: test.log}).Lines(); !reflect.DeepEqual(lines, test.lines) {
+			t.Errorf("%s: got lines %v, want %v", test.name, lines, test.lines)
+		}
+	}
+}
+
+func TestShaderErrorExcerpt(t *testing.T) {
+	source := "#version 330\nin vec2 uv;\nout vec4 colour;\nuniform sampler2D diffuse;\n\nvoid main() {\n\tcolor = texture(diffuse, uv);\n}\n\n// end\n"
+
+	tests := []struct {
+		name    string
+		log     string
+		source  string
+		context int
+		excerpt []string
+	}{
+		{"one line", "0(7) : error C1008: undefined variable \"color\"", source, 1, []string{
+			"   6 | void main() {",
+			">  7 | \tcolor = texture(diffuse, uv);",
+			"   8 | }",
+		}},
+		{"no context", "0:7(2): error: `color' undeclared", source, 0, []string{
+			">  7 | \tcolor = texture(diffuse, uv);",
+		}},
+		{"gap", "ERROR: 0:2: error\nERROR: 0:7: error", source, 1, []string{
+			"   1 | #version 330",
+			">  2 | in vec2 uv;",
+			"   3 | out vec4 colour;",
+			"  ...",
+			"   6 | void main() {",
+			">  7 | \tcolor = texture(diffuse, uv);",
+			"   8 | }",
+		}},
+		{"overlapping context", "ERROR: 0:3: error\nERROR: 0:5: error", source, 1, []string{
+			"   2 | in vec2 uv;",
+			">  3 | out vec4 colour;",
+			"   4 | uniform sampler2D diffuse;",
+			">  5 | ",
+			"   6 | void main() {",
+		}},
+		{"context clipped at the ends", "0(1) : error\n0(10) : error", source, 2, []string{
+			">  1 | #version 330",
+			"   2 | in vec2 uv;",
+			"   3 | out vec4 colour;",
+			"  ...",
+			"   8 | }",
+			"   9 | ",
+			"> 10 | // end",
+		}},
+		{"short source", "0(2) : error", "a\nb\nc", 5, []string{
+			"  1 | a",
+			"> 2 | b",
+			"  3 | c",
+		}},
+		{"line past the source", "0(11) : error", source, 2, nil},
+		{"no source", "0(1) : error", "", 2, nil},
+		{"no lines in the log", "error: linking failed", source, 2, nil},
+	}
+
+	for _, test := range tests {
+		excerpt := (&ShaderError{Stage: "fragment", Log: test.log, Source: test.source}).Excerpt(test.context)
+
+		if want := strings.Join(test.excerpt, "\n"); excerpt != want {
+			t.Errorf("%s: got excerpt\n%s\nwant\n%s", test.name, excerpt, want)
+		}
+	}
+}
+
+func TestShaderErrorMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     *ShaderError
+		message string
+	}{
+		{"compile", &ShaderError{Stage: "vertex", Log: "0(2) : error C0000: syntax error\n", Source: "#version 330\nvoid main() {\n}"},
+			"engine: vertex shader failed to compile:\n0(2) : error C0000: syntax error\n\n  1 | #version 330\n> 2 | void main() {\n  3 | }"},
+		{"compile without a log", &ShaderError{Stage: "geometry", Source: "#version 330"},
+			"engine: geometry shader failed to compile"},
+		{"link", &ShaderError{Stage: "link", Log: "error: vertex shader output `uv' not read by fragment shader\n"},
+			"engine: program failed to link:\nerror: vertex shader output `uv' not read by fragment shader"},
+		{"validate", &ShaderError{Stage: "validate", Log: "  \n"},
+			"engine: program failed to validate"},
+	}
+
+	for _, test := range tests {
+		if message := test.err.Error(); message != test.message {
+			t.Errorf("%s: got message\n%s\nwant\n%s", test.name, message, test.message)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+	"sort"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderinganimatedtextures/spritesheet"
+)
+
+// Options control how sprites are packed. Padding is the gap left between sprites; Extrude repeats each
+// sprite's edge pixels outwards so filtering and mipmapping near the edge sample the sprite's own colours.
+type Options struct {
+	MaxWidth      int
+	MaxHeight     int
+	Padding       int
+	Extrude       int
+	AllowRotation bool
+	PowerOfTwo    bool
+}
+
+var DefaultOptions = Options{
+	MaxWidth:      4096,
+	MaxHeight:     4096,
+	Padding:       2,
+	Extrude:       1,
+	AllowRotation: true,
+}
+
+type Sprite struct {
+	Name  string
+	Image image.Image
+}
+
+// Atlas is a packed image with the region of every sprite, in the order the sprites were given. Rotated
+// regions hold their sprite turned 90 degrees clockwise, as spritesheet.Region expects.
+type Atlas struct {
+	Image   *image.RGBA
+	Names   []string
+	Regions map[string]spritesheet.Region
+}
+
+// Pack finds the smallest atlas the sprites fit in, up to the maximum size in the options, and draws them
+// into it.
+func Pack(sprites []Sprite, options Options) (*Atlas, error) {
+	if len(sprites) == 0 {
+		return nil, fmt.Errorf("atlas: no sprites")
+	}
+
+	border := 2*options.Extrude + options.Padding
+	area, widest, tallest := 0, 0, 0
+	names := map[string]bool{}
+
+	for _, s := range sprites {
+		if names[s.Name] {
+			return nil, fmt.Errorf("atlas: sprite %q appears more than once", s.Name)
+		}
+
+		names[s.Name] = true
+		size := s.Image.Bounds().Size()
+
+		if size.X == 0 || size.Y == 0 {
+			return nil, fmt.Errorf("atlas: sprite %q is empty", s.Name)
+		}
+
+		w, h := size.X+border, size.Y+border
+		area += w * h
+
+		if w > widest {
+			widest = w
+		}
+
+		if h > tallest {
+			tallest = h
+		}
+	}
+
+	if options.AllowRotation {
+		widest, tallest = maxInt(widest, tallest), maxInt(widest, tallest)
+	}
+
+	// the padding after the last sprite in a row or column may fall outside the atlas
+	side := int(math.Ceil(math.Sqrt(float64(area))))
+	width, height := options.round(maxInt(side, widest-options.Padding)), options.round(maxInt(side, tallest-options.Padding))
+
+	for width <= options.MaxWidth && height <= options.MaxHeight {
+		if placements, ok := pack(sprites, width, height, options); ok {
+			return render(sprites, placements, width, height, options), nil
+		}
+
+		if width <= height {
+			width = options.grow(width)
+		} else {
+			height = options.grow(height)
+		}
+	}
+
+	return nil, fmt.Errorf("atlas: sprites do not fit in %dx%d", options.MaxWidth, options.MaxHeight)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func (o Options) round(size int) int {
+	if !o.PowerOfTwo {
+		return size
+	}
+
+	p := 1
+
+	for p < size {
+		p *= 2
+	}
+
+	return p
+}
+
+func (o Options) grow(size int) int {
+	if o.PowerOfTwo {
+		return size * 2
+	}
+
+	return size + size/8 + 1
+}
+
+type placement struct {
+	rect    Rect
+	rotated bool
+}
+
+// pack places the biggest sprites first, they are the hardest to fit late.
+func pack(sprites []Sprite, width, height int, options Options) ([]placement, bool) {
+	border := 2*options.Extrude + options.Padding
+	order := make([]int, len(sprites))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		sa, sb := sprites[order[a]].Image.Bounds().Size(), sprites[order[b]].Image.Bounds().Size()
+
+		if maxInt(sa.X, sa.Y) != maxInt(sb.X, sb.Y) {
+			return maxInt(sa.X, sa.Y) > maxInt(sb.X, sb.Y)
+		}
+
+		return sa.X*sa.Y > sb.X*sb.Y
+	})
+
+	bin := NewMaxRects(width+options.Padding, height+options.Padding, options.AllowRotation)
+	placements := make([]placement, len(sprites))
+
+	for _, i := range order {
+		size := sprites[i].Image.Bounds().Size()
+		rect, rotated, ok := bin.Insert(size.X+border, size.Y+border)
+
+		if !ok {
+			return nil, false
+		}
+
+		placements[i] = placement{rect, rotated}
+	}
+
+	return placements, true
+}
+
+func render(sprites []Sprite, placements []placement, width, height int, options Options) *Atlas {
+	// shrink to what is used unless the size has to stay a power of two
+	if !options.PowerOfTwo {
+		width, height = 0, 0
+
+		for _, p := range placements {
+			width = maxInt(width, p.rect.X+p.rect.W-options.Padding)
+			height = maxInt(height, p.rect.Y+p.rect.H-options.Padding)
+		}
+	}
+
+	a := &Atlas{
+		Image:   image.NewRGBA(image.Rect(0, 0, width, height)),
+		Names:   []string{},
+		Regions: map[string]spritesheet.Region{},
+	}
+
+	for i, s := range sprites {
+		p := placements[i]
+		bounds := s.Image.Bounds()
+		region := spritesheet.Region{
+			X:       p.rect.X + options.Extrude,
+			Y:       p.rect.Y + options.Extrude,
+			W:       bounds.Dx(),
+			H:       bounds.Dy(),
+			Rotated: p.rotated,
+		}
+
+		if p.rotated {
+			region.W, region.H = bounds.Dy(), bounds.Dx()
+
+			for y := 0; y < bounds.Dy(); y++ {
+				for x := 0; x < bounds.Dx(); x++ {
+					a.Image.Set(region.X+bounds.Dy()-1-y, region.Y+x, s.Image.At(bounds.Min.X+x, bounds.Min.Y+y))
+				}
+			}
+		} else {
+			target := image.Rect(region.X, region.Y, region.X+region.W, region.Y+region.H)
+			draw.Draw(a.Image, target, s.Image, bounds.Min, draw.Src)
+		}
+
+		a.extrude(region, options.Extrude)
+		a.Names = append(a.Names, s.Name)
+		a.Regions[s.Name] = region
+	}
+
+	return a
+}
+
+// extrude copies the region's edge pixels n pixels outwards, corners included.
+func (a *Atlas) extrude(region spritesheet.Region, n int) {
+	for y := region.Y - n; y < region.Y+region.H+n; y++ {
+		for x := region.X - n; x < region.X+region.W+n; x++ {
+			if x >= region.X && x < region.X+region.W && y >= region.Y && y < region.Y+region.H {
+				continue
+			}
+
+			cx := clamp(x, region.X, region.X+region.W-1)
+			cy := clamp(y, region.Y, region.Y+region.H-1)
+
+			a.Image.SetRGBA(x, y, a.Image.RGBAAt(cx, cy))
+		}
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+
+	if v > max {
+		return max
+	}
+
+	return v
+}
+
+// Animation plays the given sprites as frames, in order, e.g. the loose frames of a walk cycle.
+func (a *Atlas) Animation(names []string, fps int64) (*spritesheet.TextureAnimation, error) {
+	textureAnimation := spritesheet.NewTextureAnimation(fps)
+	size := a.Image.Bounds().Size()
+
+	for _, name := range names {
+		region, present := a.Regions[name]
+
+		if !present {
+			return nil, fmt.Errorf("atlas: no sprite %q", name)
+		}
+
+		textureAnimation.AddFrame(region, size.X, size.Y)
+	}
+
+	return textureAnimation, nil
+}
+
+type texturePackerFrame struct {
+	Filename string `json:"filename"`
+	Frame    struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+		W int `json:"w"`
+		H int `json:"h"`
+	} `json:"frame"`
+	Rotated bool `json:"rotated"`
+	Trimmed bool `json:"trimmed"`
+}
+
+// MarshalTexturePacker writes the regions as TexturePacker array JSON, which spritesheet.Load reads back.
+func (a *Atlas) MarshalTexturePacker(imageName string) ([]byte, error) {
+	frames := []texturePackerFrame{}
+
+	for _, name := range a.Names {
+		region := a.Regions[name]
+		frame := texturePackerFrame{Filename: name, Rotated: region.Rotated}
+		frame.Frame.X, frame.Frame.Y, frame.Frame.W, frame.Frame.H = region.X, region.Y, region.W, region.H
+
+		// TexturePacker stores the size of the unrotated sprite
+		if region.Rotated {
+			frame.Frame.W, frame.Frame.H = region.H, region.W
+		}
+
+		frames = append(frames, frame)
+	}
+
+	size := a.Image.Bounds().Size()
+	meta := map[string]interface{}{
+		"app":   "hellmouthxyz atlaspack",
+		"image": imageName,
+		"size":  map[string]int{"w": size.X, "h": size.Y},
+	}
+
+	return json.MarshalIndent(map[string]interface{}{"frames": frames, "meta": meta}, "", "  ")
+}
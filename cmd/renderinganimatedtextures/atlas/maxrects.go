@@ -0,0 +1,119 @@
+package atlas
+
+// Rect is a rectangle of the atlas in pixels.
+type Rect struct {
+	X, Y, W, H int
+}
+
+func (r Rect) contains(o Rect) bool {
+	return o.X >= r.X && o.Y >= r.Y && o.X+o.W <= r.X+r.W && o.Y+o.H <= r.Y+r.H
+}
+
+func (r Rect) intersects(o Rect) bool {
+	return o.X < r.X+r.W && o.X+o.W > r.X && o.Y < r.Y+r.H && o.Y+o.H > r.Y
+}
+
+// MaxRects packs rectangles into a fixed size bin, keeping every maximal free rectangle and placing each new
+// rectangle where it leaves the shortest leftover side.
+type MaxRects struct {
+	Width         int
+	Height        int
+	AllowRotation bool
+	free          []Rect
+}
+
+func NewMaxRects(width, height int, allowRotation bool) *MaxRects {
+	return &MaxRects{
+		Width:         width,
+		Height:        height,
+		AllowRotation: allowRotation,
+		free:          []Rect{{0, 0, width, height}},
+	}
+}
+
+// Insert places a w by h rectangle and reports whether it fit. A rotated placement is h wide and w high.
+func (m *MaxRects) Insert(w, h int) (Rect, bool, bool) {
+	best := Rect{}
+	bestShort, bestLong := -1, -1
+	rotated := false
+
+	try := func(free Rect, w, h int, rotate bool) {
+		if w > free.W || h > free.H {
+			return
+		}
+
+		short, long := free.W-w, free.H-h
+
+		if short > long {
+			short, long = long, short
+		}
+
+		if bestShort == -1 || short < bestShort || (short == bestShort && long < bestLong) {
+			best = Rect{free.X, free.Y, w, h}
+			bestShort, bestLong = short, long
+			rotated = rotate
+		}
+	}
+
+	for _, free := range m.free {
+		try(free, w, h, false)
+
+		if m.AllowRotation && w != h {
+			try(free, h, w, true)
+		}
+	}
+
+	if bestShort == -1 {
+		return Rect{}, false, false
+	}
+
+	m.place(best)
+
+	return best, rotated, true
+}
+
+func (m *MaxRects) place(used Rect) {
+	free := []Rect{}
+
+	for _, f := range m.free {
+		if !f.intersects(used) {
+			free = append(free, f)
+			continue
+		}
+
+		// keep the parts of f on each side of used
+		if used.X > f.X {
+			free = append(free, Rect{f.X, f.Y, used.X - f.X, f.H})
+		}
+
+		if used.X+used.W < f.X+f.W {
+			free = append(free, Rect{used.X + used.W, f.Y, f.X + f.W - used.X - used.W, f.H})
+		}
+
+		if used.Y > f.Y {
+			free = append(free, Rect{f.X, f.Y, f.W, used.Y - f.Y})
+		}
+
+		if used.Y+used.H < f.Y+f.H {
+			free = append(free, Rect{f.X, used.Y + used.H, f.W, f.Y + f.H - used.Y - used.H})
+		}
+	}
+
+	// drop free rectangles inside others, they can never give a better fit
+	m.free = m.free[:0]
+
+	for i, f := range free {
+		contained := false
+
+		for j, o := range free {
+			if i != j && o.contains(f) && (o != f || j < i) {
+				contained = true
+				break
+			}
+		}
+
+		if !contained {
+			m.free = append(m.free, f)
+		}
+	}
+}
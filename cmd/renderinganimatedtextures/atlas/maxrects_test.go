@@ -0,0 +1,119 @@
+package atlas
+
+import (
+	"math/rand"
+	"testing"
+)
+
+type placed struct {
+	rect    Rect
+	rotated bool
+	ok      bool
+}
+
+func TestMaxRectsInsert(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		rotation      bool
+		sizes         [][2]int
+		want          []placed
+	}{
+		{"fills the bin", 64, 64, false, [][2]int{{64, 64}, {1, 1}}, []placed{
+			{Rect{0, 0, 64, 64}, false, true},
+			{Rect{}, false, false},
+		}},
+		{"quadrants", 64, 64, false, [][2]int{{32, 32}, {32, 32}, {32, 32}, {32, 32}, {1, 1}}, []placed{
+			{Rect{0, 0, 32, 32}, false, true},
+			{Rect{32, 0, 32, 32}, false, true},
+			{Rect{0, 32, 32, 32}, false, true},
+			{Rect{32, 32, 32, 32}, false, true},
+			{Rect{}, false, false},
+		}},
+		{"too wide", 64, 64, false, [][2]int{{65, 1}}, []placed{
+			{Rect{}, false, false},
+		}},
+		{"too wide without rotation", 10, 40, false, [][2]int{{40, 10}}, []placed{
+			{Rect{}, false, false},
+		}},
+		{"fits rotated", 10, 40, true, [][2]int{{40, 10}}, []placed{
+			{Rect{0, 0, 10, 40}, true, true},
+		}},
+		{"squares never rotate", 16, 16, true, [][2]int{{16, 16}}, []placed{
+			{Rect{0, 0, 16, 16}, false, true},
+		}},
+		{"columns", 64, 64, false, [][2]int{{30, 64}, {34, 64}}, []placed{
+			{Rect{0, 0, 30, 64}, false, true},
+			{Rect{30, 0, 34, 64}, false, true},
+		}},
+		// after a 100x30 strip, a 50x70 block fits the space below it exactly in height
+		{"shortest side fit", 100, 100, false, [][2]int{{100, 30}, {50, 70}, {50, 70}, {1, 1}}, []placed{
+			{Rect{0, 0, 100, 30}, false, true},
+			{Rect{0, 30, 50, 70}, false, true},
+			{Rect{50, 30, 50, 70}, false, true},
+			{Rect{}, false, false},
+		}},
+		{"rotation picks the tighter fit", 100, 100, true, [][2]int{{100, 60}, {40, 100}}, []placed{
+			{Rect{0, 0, 100, 60}, false, true},
+			{Rect{0, 60, 100, 40}, true, true},
+		}},
+	}
+
+	for _, test := range tests {
+		m := NewMaxRects(test.width, test.height, test.rotation)
+
+		for i, size := range test.sizes {
+			rect, rotated, ok := m.Insert(size[0], size[1])
+			got := placed{rect, rotated, ok}
+
+			if got != test.want[i] {
+				t.Errorf("%s: insert %d of %dx%d gave %+v, want %+v", test.name, i, size[0], size[1], got, test.want[i])
+				break
+			}
+		}
+	}
+}
+
+func TestMaxRectsNeverOverlaps(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, rotation := range []bool{false, true} {
+		m := NewMaxRects(256, 256, rotation)
+		used := []Rect{}
+		area := 0
+
+		for i := 0; i < 500; i++ {
+			w, h := 1+random.Intn(40), 1+random.Intn(40)
+			rect, rotated, ok := m.Insert(w, h)
+
+			if !ok {
+				continue
+			}
+
+			if rotated {
+				w, h = h, w
+			}
+
+			if rect.W != w || rect.H != h {
+				t.Fatalf("a %dx%d insert was placed as %+v", w, h, rect)
+			}
+
+			if !(Rect{0, 0, 256, 256}).contains(rect) {
+				t.Fatalf("%+v is outside the bin", rect)
+			}
+
+			for _, other := range used {
+				if other.intersects(rect) {
+					t.Fatalf("%+v overlaps %+v", rect, other)
+				}
+			}
+
+			used = append(used, rect)
+			area += rect.W * rect.H
+		}
+
+		if area < 256*256*3/4 {
+			t.Errorf("rotation %v: only %d of %d pixels were used", rotation, area, 256*256)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderinganimatedtextures/atlas"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// atlaspack packs PNG files, given one by one or as directories, into one atlas image. It writes <out>.png,
+// TexturePacker array JSON to <out>.json, and with -animation a TextureAnimation playing the sprites in name
+// order.
+func main() {
+	out := flag.String("out", "atlas", "output path without extension")
+	maxSize := flag.Int("maxSize", atlas.DefaultOptions.MaxWidth, "largest atlas width and height")
+	padding := flag.Int("padding", atlas.DefaultOptions.Padding, "pixels between sprites")
+	extrude := flag.Int("extrude", atlas.DefaultOptions.Extrude, "pixels to repeat each sprite's edges by")
+	rotate := flag.Bool("rotate", atlas.DefaultOptions.AllowRotation, "allow sprites to be rotated")
+	powerOfTwo := flag.Bool("pot", false, "make the atlas width and height powers of two")
+	animationFile := flag.String("animation", "", "TextureAnimation JSON output file")
+	fps := flag.Int64("fps", 30, "frames per second of the animation")
+	flag.Parse()
+
+	files := []string{}
+
+	for _, arg := range flag.Args() {
+		info, err := os.Stat(arg)
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		matches, err := filepath.Glob(filepath.Join(arg, "*.png"))
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		files = append(files, matches...)
+	}
+
+	if len(files) == 0 {
+		log.Fatal("no PNG files given")
+	}
+
+	sort.Strings(files)
+
+	sprites := []atlas.Sprite{}
+	names := []string{}
+
+	for _, file := range files {
+		f, err := os.Open(file)
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		im, err := png.Decode(f)
+		f.Close()
+
+		if err != nil {
+			log.Fatalf("%s: %s", file, err.Error())
+		}
+
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		sprites = append(sprites, atlas.Sprite{Name: name, Image: im})
+		names = append(names, name)
+	}
+
+	a, err := atlas.Pack(sprites, atlas.Options{
+		MaxWidth:      *maxSize,
+		MaxHeight:     *maxSize,
+		Padding:       *padding,
+		Extrude:       *extrude,
+		AllowRotation: *rotate,
+		PowerOfTwo:    *powerOfTwo,
+	})
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	writePNG(*out+".png", a.Image)
+
+	metadata, err := a.MarshalTexturePacker(filepath.Base(*out) + ".png")
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if err := ioutil.WriteFile(*out+".json", metadata, 0644); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if *animationFile != "" {
+		textureAnimation, err := a.Animation(names, *fps)
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		b, err := json.MarshalIndent(textureAnimation, "", "  ")
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		if err := ioutil.WriteFile(*animationFile, b, 0644); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+
+	size := a.Image.Bounds().Size()
+	fmt.Printf("packed %d sprites into %dx%d\n", len(sprites), size.X, size.Y)
+}
+
+func writePNG(file string, im image.Image) {
+	f, err := os.Create(file)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if err := png.Encode(f, im); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderinganimatedtextures/spritesheet"
+	"image"
+	_ "image/png"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+// gridsheet writes the TextureAnimation JSON for a sprite sheet laid out as a grid of equally sized cells. With
+// -const the JSON is wrapped in a Go string constant, ready to replace an example's data.go. The animated textures
+// example's data.go comes from, run in cmd/renderinganimatedtextures:
+//
+//	go run ./gridsheet -image ../../spritesheet.png -cellWidth 92 -cellHeight 92 -fps 30 -const AnimatedTextureFrames -out data.go
+func main() {
+	imageFile := flag.String("image", "", "sheet image, read for its size instead of -width and -height")
+	width := flag.Int("width", 0, "sheet width in pixels")
+	height := flag.Int("height", 0, "sheet height in pixels")
+	cellWidth := flag.Int("cellWidth", 0, "cell width in pixels")
+	cellHeight := flag.Int("cellHeight", 0, "cell height in pixels")
+	margin := flag.Int("margin", 0, "pixels around the grid")
+	spacing := flag.Int("spacing", 0, "pixels between cells")
+	first := flag.Int("first", 1, "first cell of the animation, counting from 1")
+	last := flag.Int("last", 0, "last cell of the animation, 0 for the last cell of the sheet")
+	fps := flag.Int64("fps", 30, "frames per second")
+	constName := flag.String("const", "", "wrap the JSON in a Go constant of this name in package main")
+	out := flag.String("out", "", "output file (defaults to stdout)")
+	flag.Parse()
+
+	if *imageFile != "" {
+		f, err := os.Open(*imageFile)
+
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		config, _, err := image.DecodeConfig(f)
+		f.Close()
+
+		if err != nil {
+			log.Fatalf("%s: %s", *imageFile, err.Error())
+		}
+
+		*width, *height = config.Width, config.Height
+	}
+
+	textureAnimation, err := spritesheet.NewGridAnimation(spritesheet.Grid{
+		SheetWidth:  *width,
+		SheetHeight: *height,
+		CellWidth:   *cellWidth,
+		CellHeight:  *cellHeight,
+		Margin:      *margin,
+		Spacing:     *spacing,
+		FirstFrame:  *first,
+		LastFrame:   *last,
+		FPS:         *fps,
+	})
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	b, err := json.MarshalIndent(textureAnimation, "", "  ")
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	output := string(b) + "\n"
+
+	if *constName != "" {
+		output = fmt.Sprintf("// Code generated by gridsheet. DO NOT EDIT.\n\npackage main\n\nconst %s = `%s`\n", *constName, output)
+	}
+
+	if *out == "" {
+		fmt.Print(output)
+	} else if err = ioutil.WriteFile(*out, []byte(output), 0644); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -2,16 +2,13 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"github.com/go-gl/gl/v3.3-core/gl"
 	"github.com/go-gl/glfw/v3.2/glfw"
-	"image"
-	"image/draw"
-	"image/png"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderinganimatedtextures/spritesheet"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
-	"os"
 	"runtime"
-	"strings"
 	"time"
 )
 
@@ -21,75 +18,14 @@ const (
 	Title = "Rendering animated textures"
 )
 
-func InitGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
+func main() {
+	runtime.LockOSThread()
 
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title, ClearColor: &[4]float32{0, 0, 0, 1}})
 
 	if err != nil {
-		panic(err)
-	}
-
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
-
-	return window
-}
-
-func InitOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
-	}
-
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
-
-	//gl.ClearColor(0, 0.1568627451, 0.2039215686, 1)
-	gl.ClearColor(0, 0,0, 1)
-
-	width, height := window.GetFramebufferSize()
-	//width, height := window.GetSize()
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
-
-func GlStr(str string) *uint8 {
-	if !strings.HasSuffix(str, "\x00") {
-		str = str + "\x00"
-	}
-	return gl.Str(str)
-}
-
-
-func CheckError() {
-
-	err := gl.GetError()
-
-	if err != gl.NO_ERROR {
-		log.Print("CheckError")
-		log.Fatal(err)
+		log.Fatal(err.Error())
 	}
-}
-
-func main() {
-	runtime.LockOSThread()
-
-	window := InitGLFW()
-	InitOpenGL(window)
 
 	points := []float32{
 		-0.15, 0.15, 0.0, 0.0,  // top left
@@ -103,10 +39,10 @@ func main() {
 		1,2,3,
 	}
 
-	var textureAnimation TextureAnimation
+	var textureAnimation spritesheet.TextureAnimation
 
 	bf := []byte(AnimatedTextureFrames)
-	err := json.Unmarshal(bf, &textureAnimation)
+	err = json.Unmarshal(bf, &textureAnimation)
 
 	if err != nil {
 		log.Fatal(err.Error())
@@ -137,51 +73,25 @@ func main() {
 
 	gl.BindVertexArray(0)
 
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../spritesheet.png")
+	diffuse, err := texture.LoadImage("../../spritesheet.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../spritesheet.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err := png.Decode(ioreader)
+	diffuseTexture, err := texture.Upload(diffuse)
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../spritesheet.png")
-	}
-
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+		log.Fatal(err.Error())
 	}
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
-	gl.BindTexture(gl.TEXTURE_2D, texId)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 736, 736, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
-	gl.BindTexture(gl.TEXTURE_2D, 0)
+	texId := diffuseTexture.ID
 
-	animatedTextureCoordinates := []float32{}
+	// load all of the frame uvs into a single array
+	animatedTextureCoordinates, err := textureAnimation.CoordinateBuffer()
 
-	// load all of the model matrices into a single array
-	for i := textureAnimation.StartFrame; i <= textureAnimation.EndFrame; i++ {
-		for j := 0; j < 4; j++ {
-			uvs := textureAnimation.TextureFrames[fmt.Sprintf("%d", i)][fmt.Sprintf("%d", j)] // terrible
-			animatedTextureCoordinates = append(animatedTextureCoordinates, float32(uvs[0]), float32(uvs[1]))
-		}
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
 	var textureCoordinatesBufffer uint32
@@ -237,42 +147,28 @@ void main() {
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
-
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	animationFrameTime := float64(float64(1000.0)/float64(textureAnimation.FPS))
 	previousTick := time.Now()
 	animationCurrentTime := float64(0.0)
 
-	curFrame := int64(1)
+	curFrame := textureAnimation.StartFrame
 	for !window.ShouldClose() {
 
 		timePassed := time.Now().Sub(previousTick)
 		animationCurrentTime += float64(float64(timePassed.Nanoseconds()) / float64(1000000.0))
 		previousTick = time.Now()
 
-		if animationCurrentTime > animationFrameTime {
+		if animationCurrentTime > textureAnimation.FrameDuration(curFrame) {
 			curFrame++
 			animationCurrentTime = 0.0
 
 			if curFrame > textureAnimation.EndFrame {
-				curFrame = 1
+				curFrame = textureAnimation.StartFrame
 			}
 		}
 
@@ -283,18 +179,11 @@ void main() {
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
 
-		gl.UseProgram(shaderProgram)
-
-		var location int32 = -1
+		shaderProgram.Use()
 
-		location = gl.GetUniformLocation(shaderProgram, GlStr("curFrame"))
-		gl.Uniform1f(location, float32(curFrame))
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("textureCoordinates"))
-		gl.Uniform1i(location, 0)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("diffuse"))
-		gl.Uniform1i(location, 1)
+		shaderProgram.SetFloat("curFrame", float32(curFrame))
+		shaderProgram.SetSampler("textureCoordinates", 0)
+		shaderProgram.SetSampler("diffuse", 1)
 
 		gl.ActiveTexture(gl.TEXTURE0)
 		gl.BindTexture(gl.TEXTURE_BUFFER, textureCoordinatesId)
@@ -312,7 +201,9 @@ void main() {
 
 		glfw.PollEvents()
 		window.SwapBuffers()
-		CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
 }
 
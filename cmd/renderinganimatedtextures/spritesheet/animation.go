@@ -0,0 +1,126 @@
+package spritesheet
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Corners of the quad, in the order the vertex shader indexes the texture buffer with in_VertexIndex.
+const (
+	TopLeft = iota
+	TopRight
+	BottomLeft
+	BottomRight
+)
+
+// TextureAnimation holds the uvs of every corner of the quad for every frame, keyed by frame number and corner
+// as strings. Frames are 1 based; v runs down the sheet as the image is uploaded top row first.
+type TextureAnimation struct {
+	EndFrame       int64                           `json:"endFrame"`
+	FPS            int64                           `json:"fps"`
+	StartFrame     int64                           `json:"startFrame"`
+	TextureFrames  map[string]map[string][]float64 `json:"textureFrames"`
+	FrameDurations map[string]float64              `json:"frameDurations,omitempty"`
+	Tags           map[string]*Tag                 `json:"tags,omitempty"`
+}
+
+// Tag names a range of frames, e.g. "walk" or "idle".
+type Tag struct {
+	StartFrame int64  `json:"startFrame"`
+	EndFrame   int64  `json:"endFrame"`
+	Direction  string `json:"direction"`
+}
+
+const (
+	Forward  = "forward"
+	Reverse  = "reverse"
+	PingPong = "pingpong"
+)
+
+func NewTextureAnimation(fps int64) *TextureAnimation {
+	return &TextureAnimation{
+		StartFrame:    1,
+		FPS:           fps,
+		TextureFrames: map[string]map[string][]float64{},
+	}
+}
+
+// Region is a rectangle of the sheet in pixels. A rotated region holds its frame turned 90 degrees clockwise,
+// so the region is as wide as the frame is tall.
+type Region struct {
+	X, Y, W, H int
+	Rotated    bool
+}
+
+// AddFrame appends a frame showing region of a sheet of sheetWidth by sheetHeight pixels. The uvs are inset by
+// half a texel so linear filtering never samples the neighbouring frame.
+func (a *TextureAnimation) AddFrame(region Region, sheetWidth, sheetHeight int) {
+	left := (float64(region.X) + 0.5) / float64(sheetWidth)
+	right := (float64(region.X+region.W) - 0.5) / float64(sheetWidth)
+	top := (float64(region.Y) + 0.5) / float64(sheetHeight)
+	bottom := (float64(region.Y+region.H) - 0.5) / float64(sheetHeight)
+
+	corners := map[string][]float64{
+		strconv.Itoa(TopLeft):     {left, top},
+		strconv.Itoa(TopRight):    {right, top},
+		strconv.Itoa(BottomLeft):  {left, bottom},
+		strconv.Itoa(BottomRight): {right, bottom},
+	}
+
+	if region.Rotated {
+		// the frame's top edge is the region's right edge
+		corners = map[string][]float64{
+			strconv.Itoa(TopLeft):     {right, top},
+			strconv.Itoa(TopRight):    {right, bottom},
+			strconv.Itoa(BottomLeft):  {left, top},
+			strconv.Itoa(BottomRight): {left, bottom},
+		}
+	}
+
+	a.EndFrame = a.StartFrame + int64(len(a.TextureFrames))
+	a.TextureFrames[strconv.FormatInt(a.EndFrame, 10)] = corners
+}
+
+// SetFrameDuration overrides how long a frame is shown, in milliseconds.
+func (a *TextureAnimation) SetFrameDuration(frame int64, milliseconds float64) {
+	if a.FrameDurations == nil {
+		a.FrameDurations = map[string]float64{}
+	}
+
+	a.FrameDurations[strconv.FormatInt(frame, 10)] = milliseconds
+}
+
+// FrameDuration returns how long a frame is shown in milliseconds, 1000 / FPS unless the frame has its own duration.
+func (a *TextureAnimation) FrameDuration(frame int64) float64 {
+	if d, present := a.FrameDurations[strconv.FormatInt(frame, 10)]; present {
+		return d
+	}
+
+	return 1000.0 / float64(a.FPS)
+}
+
+// CoordinateBuffer flattens the uvs for the texture buffer, 8 floats per frame from StartFrame to EndFrame in
+// corner order.
+func (a *TextureAnimation) CoordinateBuffer() ([]float32, error) {
+	buffer := []float32{}
+
+	for i := a.StartFrame; i <= a.EndFrame; i++ {
+		corners, present := a.TextureFrames[strconv.FormatInt(i, 10)]
+
+		if !present {
+			return nil, fmt.Errorf("spritesheet: frame %d is missing", i)
+		}
+
+		for j := 0; j < 4; j++ {
+			uvs := corners[strconv.Itoa(j)]
+
+			if len(uvs) != 2 {
+				return nil, fmt.Errorf("spritesheet: frame %d corner %d needs 2 uvs, got %d", i, j, len(uvs))
+			}
+
+			buffer = append(buffer, float32(uvs[0]), float32(uvs[1]))
+		}
+	}
+
+	return buffer, nil
+}
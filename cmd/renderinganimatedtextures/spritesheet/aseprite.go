@@ -0,0 +1,25 @@
+package spritesheet
+
+import "math"
+
+// ParseAseprite imports an Aseprite JSON export, hash or array, keeping each frame's duration and the frame tags.
+// FPS is taken from the first frame and only applies to frames without a duration.
+func ParseAseprite(data []byte) (*Sheet, error) {
+	s, err := parseSheet(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.asepriteSheet()
+}
+
+func (s *sheetFile) asepriteSheet() (*Sheet, error) {
+	fps := int64(1)
+
+	if len(s.Frames) > 0 && s.Frames[0].Duration > 0 {
+		fps = int64(math.Max(1, math.Round(1000/s.Frames[0].Duration)))
+	}
+
+	return s.sheet(fps)
+}
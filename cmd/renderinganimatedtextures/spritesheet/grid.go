@@ -0,0 +1,78 @@
+package spritesheet
+
+import "fmt"
+
+// Grid describes a sheet of equally sized cells, numbered from 1 left to right then top to bottom. Margin is
+// the space around the grid and Spacing the space between cells, both in pixels.
+type Grid struct {
+	SheetWidth  int
+	SheetHeight int
+	CellWidth   int
+	CellHeight  int
+	Margin      int
+	Spacing     int
+	FirstFrame  int
+	LastFrame   int // 0 takes every cell from FirstFrame on
+	FPS         int64
+}
+
+func (g Grid) Columns() int {
+	return (g.SheetWidth - 2*g.Margin + g.Spacing) / (g.CellWidth + g.Spacing)
+}
+
+func (g Grid) Rows() int {
+	return (g.SheetHeight - 2*g.Margin + g.Spacing) / (g.CellHeight + g.Spacing)
+}
+
+// Cell returns the pixel region of a cell.
+func (g Grid) Cell(frame int) Region {
+	column := (frame - 1) % g.Columns()
+	row := (frame - 1) / g.Columns()
+
+	return Region{
+		X: g.Margin + column*(g.CellWidth+g.Spacing),
+		Y: g.Margin + row*(g.CellHeight+g.Spacing),
+		W: g.CellWidth,
+		H: g.CellHeight,
+	}
+}
+
+// NewGridAnimation builds an animation playing the grid's cells from FirstFrame to LastFrame, with every uv
+// inset by half a texel.
+func NewGridAnimation(g Grid) (*TextureAnimation, error) {
+	if g.CellWidth <= 0 || g.CellHeight <= 0 || g.Margin < 0 || g.Spacing < 0 {
+		return nil, fmt.Errorf("spritesheet: cells must have a positive size and margin and spacing must not be negative")
+	}
+
+	if g.FPS <= 0 {
+		return nil, fmt.Errorf("spritesheet: fps must be positive, got %d", g.FPS)
+	}
+
+	cells := g.Columns() * g.Rows()
+
+	if cells <= 0 {
+		return nil, fmt.Errorf("spritesheet: no %dx%d cell fits a %dx%d sheet", g.CellWidth, g.CellHeight, g.SheetWidth, g.SheetHeight)
+	}
+
+	first, last := g.FirstFrame, g.LastFrame
+
+	if first == 0 {
+		first = 1
+	}
+
+	if last == 0 {
+		last = cells
+	}
+
+	if first < 1 || last < first || last > cells {
+		return nil, fmt.Errorf("spritesheet: frames %d-%d are outside the grid's %d cells", first, last, cells)
+	}
+
+	a := NewTextureAnimation(g.FPS)
+
+	for frame := first; frame <= last; frame++ {
+		a.AddFrame(g.Cell(frame), g.SheetWidth, g.SheetHeight)
+	}
+
+	return a, nil
+}
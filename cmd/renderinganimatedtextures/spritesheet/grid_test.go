@@ -0,0 +1,101 @@
+package spritesheet
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestGridCell(t *testing.T) {
+	tests := []struct {
+		name          string
+		grid          Grid
+		columns, rows int
+		frame         int
+		cell          Region
+	}{
+		{"packed", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16}, 4, 2, 6, Region{X: 16, Y: 16, W: 16, H: 16}},
+		{"first cell", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16}, 4, 2, 1, Region{X: 0, Y: 0, W: 16, H: 16}},
+		{"margin", Grid{SheetWidth: 68, SheetHeight: 36, CellWidth: 16, CellHeight: 16, Margin: 2}, 4, 2, 5, Region{X: 2, Y: 18, W: 16, H: 16}},
+		{"spacing", Grid{SheetWidth: 67, SheetHeight: 33, CellWidth: 16, CellHeight: 16, Spacing: 1}, 4, 2, 4, Region{X: 51, Y: 0, W: 16, H: 16}},
+		{"margin and spacing", Grid{SheetWidth: 37, SheetHeight: 37, CellWidth: 16, CellHeight: 16, Margin: 2, Spacing: 1}, 2, 2, 4, Region{X: 19, Y: 19, W: 16, H: 16}},
+		{"partial cells ignored", Grid{SheetWidth: 40, SheetHeight: 20, CellWidth: 16, CellHeight: 16, Margin: 2, Spacing: 1}, 2, 1, 2, Region{X: 19, Y: 2, W: 16, H: 16}},
+		{"tall cells", Grid{SheetWidth: 30, SheetHeight: 40, CellWidth: 10, CellHeight: 20}, 3, 2, 6, Region{X: 20, Y: 20, W: 10, H: 20}},
+	}
+
+	for _, test := range tests {
+		if columns, rows := test.grid.Columns(), test.grid.Rows(); columns != test.columns || rows != test.rows {
+			t.Errorf("%s: got %dx%d cells, want %dx%d", test.name, columns, rows, test.columns, test.rows)
+			continue
+		}
+
+		if cell := test.grid.Cell(test.frame); cell != test.cell {
+			t.Errorf("%s: cell %d is %+v, want %+v", test.name, test.frame, cell, test.cell)
+		}
+	}
+}
+
+func TestGridAnimationInsets(t *testing.T) {
+	tests := []struct {
+		name                     string
+		grid                     Grid
+		frame                    int64
+		left, right, top, bottom float64
+	}{
+		{"packed", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, FPS: 10}, 6,
+			16.5 / 64, 31.5 / 64, 16.5 / 32, 31.5 / 32},
+		{"margin and spacing", Grid{SheetWidth: 37, SheetHeight: 37, CellWidth: 16, CellHeight: 16, Margin: 2, Spacing: 1, FPS: 10}, 2,
+			19.5 / 37, 34.5 / 37, 2.5 / 37, 17.5 / 37},
+		{"first frame offset", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, FirstFrame: 3, FPS: 10}, 1,
+			32.5 / 64, 47.5 / 64, 0.5 / 32, 15.5 / 32},
+		{"single texel cells", Grid{SheetWidth: 4, SheetHeight: 1, CellWidth: 1, CellHeight: 1, FPS: 10}, 3,
+			2.5 / 4, 2.5 / 4, 0.5, 0.5},
+	}
+
+	for _, test := range tests {
+		a, err := NewGridAnimation(test.grid)
+
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+
+		corners := a.TextureFrames[strconv.FormatInt(test.frame, 10)]
+		want := map[int][]float64{
+			TopLeft:     {test.left, test.top},
+			TopRight:    {test.right, test.top},
+			BottomLeft:  {test.left, test.bottom},
+			BottomRight: {test.right, test.bottom},
+		}
+
+		for corner, uv := range want {
+			got := corners[strconv.Itoa(corner)]
+
+			if len(got) != 2 || got[0] != uv[0] || got[1] != uv[1] {
+				t.Errorf("%s: frame %d corner %d is %v, want %v", test.name, test.frame, corner, got, uv)
+			}
+		}
+	}
+}
+
+func TestGridAnimationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		grid Grid
+	}{
+		{"zero cell size", Grid{SheetWidth: 64, SheetHeight: 32, CellHeight: 16, FPS: 10}},
+		{"negative margin", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, Margin: -1, FPS: 10}},
+		{"negative spacing", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, Spacing: -1, FPS: 10}},
+		{"zero fps", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16}},
+		{"cell larger than sheet", Grid{SheetWidth: 8, SheetHeight: 8, CellWidth: 16, CellHeight: 16, FPS: 10}},
+		{"margin leaves no room", Grid{SheetWidth: 16, SheetHeight: 16, CellWidth: 16, CellHeight: 16, Margin: 1, FPS: 10}},
+		{"last frame past the grid", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, LastFrame: 9, FPS: 10}},
+		{"last frame before first", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, FirstFrame: 4, LastFrame: 3, FPS: 10}},
+		{"negative first frame", Grid{SheetWidth: 64, SheetHeight: 32, CellWidth: 16, CellHeight: 16, FirstFrame: -1, FPS: 10}},
+	}
+
+	for _, test := range tests {
+		if _, err := NewGridAnimation(test.grid); err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package spritesheet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// Sheet is an imported sprite sheet: the animation and the image it indexes, relative to the metadata file when
+// loaded with Load.
+type Sheet struct {
+	Image     string
+	Width     int
+	Height    int
+	Animation *TextureAnimation
+}
+
+type sheetRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type sheetSize struct {
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type sheetFrame struct {
+	Filename         string    `json:"filename"`
+	Frame            sheetRect `json:"frame"`
+	Rotated          bool      `json:"rotated"`
+	Trimmed          bool      `json:"trimmed"`
+	SpriteSourceSize sheetRect `json:"spriteSourceSize"`
+	SourceSize       sheetSize `json:"sourceSize"`
+	Duration         float64   `json:"duration"`
+}
+
+type frameTag struct {
+	Name      string `json:"name"`
+	From      int64  `json:"from"`
+	To        int64  `json:"to"`
+	Direction string `json:"direction"`
+}
+
+type sheetMeta struct {
+	App       string     `json:"app"`
+	Image     string     `json:"image"`
+	Size      sheetSize  `json:"size"`
+	FrameTags []frameTag `json:"frameTags"`
+}
+
+type sheetFile struct {
+	Frames []*sheetFrame
+	Meta   sheetMeta
+}
+
+// parseSheet reads the JSON layout TexturePacker and Aseprite share. Frames are either an array or an object
+// keyed by file name; an object's frames are taken in the order they appear in the file.
+func parseSheet(data []byte) (*sheetFile, error) {
+	var raw struct {
+		Frames json.RawMessage `json:"frames"`
+		Meta   sheetMeta       `json:"meta"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("spritesheet: %s", err.Error())
+	}
+
+	s := &sheetFile{Meta: raw.Meta}
+	frames := bytes.TrimSpace(raw.Frames)
+
+	switch {
+	case len(frames) == 0:
+		return nil, fmt.Errorf("spritesheet: no frames")
+	case frames[0] == '[':
+		if err := json.Unmarshal(frames, &s.Frames); err != nil {
+			return nil, fmt.Errorf("spritesheet: frames: %s", err.Error())
+		}
+	case frames[0] == '{':
+		dec := json.NewDecoder(bytes.NewReader(frames))
+		dec.Token()
+
+		for dec.More() {
+			key, err := dec.Token()
+
+			if err != nil {
+				return nil, fmt.Errorf("spritesheet: frames: %s", err.Error())
+			}
+
+			frame := &sheetFrame{}
+
+			if err := dec.Decode(frame); err != nil {
+				return nil, fmt.Errorf("spritesheet: frame %q: %s", key, err.Error())
+			}
+
+			frame.Filename = key.(string)
+			s.Frames = append(s.Frames, frame)
+		}
+	default:
+		return nil, fmt.Errorf("spritesheet: frames must be an array or an object")
+	}
+
+	if s.Meta.Size.W <= 0 || s.Meta.Size.H <= 0 {
+		return nil, fmt.Errorf("spritesheet: meta.size must be positive, got %dx%d", s.Meta.Size.W, s.Meta.Size.H)
+	}
+
+	return s, nil
+}
+
+func (s *sheetFile) sheet(fps int64) (*Sheet, error) {
+	if fps <= 0 {
+		return nil, fmt.Errorf("spritesheet: fps must be positive, got %d", fps)
+	}
+
+	a := NewTextureAnimation(fps)
+
+	for _, f := range s.Frames {
+		if f.Trimmed && (f.SpriteSourceSize.W != f.SourceSize.W || f.SpriteSourceSize.H != f.SourceSize.H) {
+			return nil, fmt.Errorf("spritesheet: frame %q is trimmed, export the sheet without trimming", f.Filename)
+		}
+
+		region := Region{f.Frame.X, f.Frame.Y, f.Frame.W, f.Frame.H, f.Rotated}
+
+		// frame holds the size of the unrotated sprite
+		if f.Rotated {
+			region.W, region.H = f.Frame.H, f.Frame.W
+		}
+
+		if region.W <= 0 || region.H <= 0 || region.X < 0 || region.Y < 0 || region.X+region.W > s.Meta.Size.W || region.Y+region.H > s.Meta.Size.H {
+			return nil, fmt.Errorf("spritesheet: frame %q lies outside the %dx%d sheet", f.Filename, s.Meta.Size.W, s.Meta.Size.H)
+		}
+
+		a.AddFrame(region, s.Meta.Size.W, s.Meta.Size.H)
+
+		if f.Duration > 0 {
+			a.SetFrameDuration(a.EndFrame, f.Duration)
+		}
+	}
+
+	for _, t := range s.Meta.FrameTags {
+		// tags count frames from 0
+		tag := &Tag{StartFrame: a.StartFrame + t.From, EndFrame: a.StartFrame + t.To, Direction: t.Direction}
+
+		if tag.Direction == "" {
+			tag.Direction = Forward
+		}
+
+		if t.From < 0 || t.To < t.From || tag.EndFrame > a.EndFrame {
+			return nil, fmt.Errorf("spritesheet: tag %q covers frames %d-%d of %d", t.Name, t.From, t.To, len(s.Frames))
+		}
+
+		if a.Tags == nil {
+			a.Tags = map[string]*Tag{}
+		}
+
+		a.Tags[t.Name] = tag
+	}
+
+	return &Sheet{Image: s.Meta.Image, Width: s.Meta.Size.W, Height: s.Meta.Size.H, Animation: a}, nil
+}
+
+// ParseTexturePacker imports TexturePacker JSON, hash or array. TexturePacker stores no timing, so every frame
+// plays at fps.
+func ParseTexturePacker(data []byte, fps int64) (*Sheet, error) {
+	s, err := parseSheet(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sheet(fps)
+}
+
+// Load imports TexturePacker or Aseprite JSON, telling them apart by meta.app. fps is ignored for Aseprite.
+func Load(path string, fps int64) (*Sheet, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := parseSheet(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet *Sheet
+
+	if strings.Contains(strings.ToLower(s.Meta.App), "aseprite") {
+		sheet, err = s.asepriteSheet()
+	} else {
+		sheet, err = s.sheet(fps)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sheet.Image != "" {
+		sheet.Image = filepath.Join(filepath.Dir(path), sheet.Image)
+	}
+
+	return sheet, nil
+}
@@ -1,10 +1,7 @@
 package common
 
 import (
-	"strings"
-	"github.com/go-gl/glfw/v3.2/glfw"
-	"github.com/go-gl/gl/v3.3-core/gl"
-	"log"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 )
 
 const (
@@ -13,65 +10,4 @@ const (
 	Title = "Rendering multiple meshes with textures"
 )
 
-func InitGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
-
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
-
-	if err != nil {
-		panic(err)
-	}
-
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
-
-	return window
-}
-
-func InitOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
-	}
-
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
-
-	gl.ClearColor(0, 0.1568627451, 0.2039215686, 1)
-
-	width, height := window.GetFramebufferSize()
-	//width, height := window.GetSize()
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
-
-func GlStr(str string) *uint8 {
-	if !strings.HasSuffix(str, "\x00") {
-		str = str + "\x00"
-	}
-	return gl.Str(str)
-}
-
-
-func CheckError() {
-
-	err := gl.GetError()
-
-	if err != gl.NO_ERROR {
-		log.Print("CheckError")
-		log.Fatal(err)
-	}
-}
\ No newline at end of file
+var Options = engine.Options{Width: Width, Height: Height, Title: Title}
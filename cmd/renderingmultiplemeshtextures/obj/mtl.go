@@ -0,0 +1,112 @@
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func loadMaterialLibrary(path string, materials map[string]*Material) error {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	library, err := ParseMaterials(f, filepath.Dir(path))
+
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err.Error())
+	}
+
+	for name, material := range library {
+		materials[name] = material
+	}
+
+	return nil
+}
+
+// ParseMaterials reads MTL data; texture paths are resolved relative to baseDir.
+func ParseMaterials(r io.Reader, baseDir string) (map[string]*Material, error) {
+	materials := map[string]*Material{}
+
+	var current *Material
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+
+		if comment := strings.Index(text, "#"); comment >= 0 {
+			text = text[:comment]
+		}
+
+		fields := strings.Fields(text)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] == "newmtl" {
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("mtl: line %d: newmtl needs a name", line)
+			}
+
+			current = &Material{Name: fields[1], Diffuse: [3]float32{1, 1, 1}, Opacity: 1}
+			materials[current.Name] = current
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		switch fields[0] {
+		case "Kd":
+			v, err := parseFloats(fields[1:], 3)
+
+			if err != nil {
+				return nil, fmt.Errorf("mtl: line %d: %s", line, err.Error())
+			}
+
+			copy(current.Diffuse[:], v)
+		case "d":
+			v, err := parseFloats(fields[1:], 1)
+
+			if err != nil {
+				return nil, fmt.Errorf("mtl: line %d: %s", line, err.Error())
+			}
+
+			current.Opacity = v[0]
+		case "Tr":
+			v, err := parseFloats(fields[1:], 1)
+
+			if err != nil {
+				return nil, fmt.Errorf("mtl: line %d: %s", line, err.Error())
+			}
+
+			current.Opacity = 1 - v[0]
+		case "map_Kd":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("mtl: line %d: map_Kd needs a file name", line)
+			}
+
+			// options such as -s or -o come before the file name, which is always last
+			current.DiffuseTexture = filepath.Join(baseDir, filepath.FromSlash(fields[len(fields)-1]))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return materials, nil
+}
@@ -0,0 +1,334 @@
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// VertexStride is the number of floats per vertex in Mesh.Points: 3 position, 2 texture and 3 normal.
+const VertexStride = 8
+
+const (
+	PositionOffset = 0
+	TextureOffset  = 3 * 4
+	NormalOffset   = 5 * 4
+)
+
+type Material struct {
+	Name           string
+	Diffuse        [3]float32
+	Opacity        float32
+	DiffuseTexture string // resolved relative to the .mtl file, empty if there is no map_Kd
+}
+
+// Mesh holds every face drawn with one material as indexed, interleaved vertices that can be handed straight
+// to gl.BufferData with a stride of VertexStride*4 bytes.
+type Mesh struct {
+	Material *Material
+	Points   []float32
+	Indices  []uint32
+}
+
+func (m *Mesh) VertexCount() int {
+	return len(m.Points) / VertexStride
+}
+
+type Model struct {
+	Meshes    []*Mesh
+	Materials map[string]*Material
+}
+
+// Load reads an .obj file along with any .mtl files it references.
+func Load(path string) (*Model, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Parse(f, filepath.Dir(path))
+}
+
+type vertexKey struct {
+	position, texture, normal int
+}
+
+type builder struct {
+	mesh      *Mesh
+	vertices  map[vertexKey]uint32
+	generated map[uint32]bool // vertices without a normal in the file, given the average of their faces
+}
+
+// Parse reads OBJ data; baseDir is used to resolve mtllib files.
+func Parse(r io.Reader, baseDir string) (*Model, error) {
+	model := &Model{
+		Meshes:    []*Mesh{},
+		Materials: map[string]*Material{},
+	}
+
+	positions := [][3]float32{}
+	textures := [][2]float32{}
+	normals := [][3]float32{}
+
+	builders := map[string]*builder{}
+	order := []string{}
+	current := ""
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+
+		if comment := strings.Index(text, "#"); comment >= 0 {
+			text = text[:comment]
+		}
+
+		fields := strings.Fields(text)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "v":
+			v, err := parseFloats(fields[1:], 3)
+
+			if err != nil {
+				return nil, fmt.Errorf("obj: line %d: %s", line, err.Error())
+			}
+
+			positions = append(positions, [3]float32{v[0], v[1], v[2]})
+		case "vt":
+			v, err := parseFloats(fields[1:], 2)
+
+			if err != nil {
+				return nil, fmt.Errorf("obj: line %d: %s", line, err.Error())
+			}
+
+			textures = append(textures, [2]float32{v[0], v[1]})
+		case "vn":
+			v, err := parseFloats(fields[1:], 3)
+
+			if err != nil {
+				return nil, fmt.Errorf("obj: line %d: %s", line, err.Error())
+			}
+
+			normals = append(normals, [3]float32{v[0], v[1], v[2]})
+		case "mtllib":
+			for _, name := range fields[1:] {
+				if err := loadMaterialLibrary(filepath.Join(baseDir, name), model.Materials); err != nil {
+					return nil, err
+				}
+			}
+		case "usemtl":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("obj: line %d: usemtl needs a material name", line)
+			}
+
+			current = fields[1]
+		case "f":
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("obj: line %d: a face needs at least 3 vertices", line)
+			}
+
+			b := builders[current]
+
+			if b == nil {
+				material := model.Materials[current]
+
+				// faces before any usemtl, or naming a material no library defines, get a plain white material
+				if material == nil {
+					material = &Material{Name: current, Diffuse: [3]float32{1, 1, 1}, Opacity: 1}
+					model.Materials[current] = material
+				}
+
+				b = &builder{
+					mesh:      &Mesh{Material: material, Points: []float32{}, Indices: []uint32{}},
+					vertices:  map[vertexKey]uint32{},
+					generated: map[uint32]bool{},
+				}
+
+				builders[current] = b
+				order = append(order, current)
+			}
+
+			face := []uint32{}
+
+			for _, field := range fields[1:] {
+				key, err := parseFaceVertex(field, len(positions), len(textures), len(normals))
+
+				if err != nil {
+					return nil, fmt.Errorf("obj: line %d: %s", line, err.Error())
+				}
+
+				face = append(face, b.vertex(key, positions, textures, normals))
+			}
+
+			// fan triangulation, which is correct for the convex polygons exporters write
+			for i := 1; i+1 < len(face); i++ {
+				b.mesh.Indices = append(b.mesh.Indices, face[0], face[i], face[i+1])
+				b.accumulateNormal(face[0], face[i], face[i+1])
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range order {
+		b := builders[name]
+		b.normalizeGenerated()
+		model.Meshes = append(model.Meshes, b.mesh)
+	}
+
+	return model, nil
+}
+
+func parseFloats(fields []string, count int) ([]float32, error) {
+	if len(fields) < count {
+		return nil, fmt.Errorf("expected %d values, got %d", count, len(fields))
+	}
+
+	values := make([]float32, count)
+
+	for i := range values {
+		v, err := strconv.ParseFloat(fields[i], 32)
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = float32(v)
+	}
+
+	return values, nil
+}
+
+// parseFaceVertex reads a v, v/vt, v//vn or v/vt/vn reference into zero based indices, -1 for a missing part.
+func parseFaceVertex(field string, positionCount, textureCount, normalCount int) (vertexKey, error) {
+	key := vertexKey{-1, -1, -1}
+	parts := strings.Split(field, "/")
+
+	if len(parts) > 3 {
+		return key, fmt.Errorf("invalid face vertex %q", field)
+	}
+
+	counts := []int{positionCount, textureCount, normalCount}
+	indices := []*int{&key.position, &key.texture, &key.normal}
+
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+
+		index, err := strconv.Atoi(part)
+
+		if err != nil {
+			return key, fmt.Errorf("invalid face vertex %q", field)
+		}
+
+		// negative indices count back from the most recent element
+		if index < 0 {
+			index = counts[i] + index
+		} else {
+			index--
+		}
+
+		if index < 0 || index >= counts[i] {
+			return key, fmt.Errorf("face vertex %q is out of range", field)
+		}
+
+		*indices[i] = index
+	}
+
+	if key.position < 0 {
+		return key, fmt.Errorf("face vertex %q has no position", field)
+	}
+
+	return key, nil
+}
+
+func (b *builder) vertex(key vertexKey, positions [][3]float32, textures [][2]float32, normals [][3]float32) uint32 {
+	if index, present := b.vertices[key]; present {
+		return index
+	}
+
+	index := uint32(b.mesh.VertexCount())
+	p := positions[key.position]
+
+	b.mesh.Points = append(b.mesh.Points, p[0], p[1], p[2])
+
+	// images are uploaded top row first, so v is flipped to match the OBJ convention of v=0 at the bottom
+	if key.texture >= 0 {
+		t := textures[key.texture]
+		b.mesh.Points = append(b.mesh.Points, t[0], 1-t[1])
+	} else {
+		b.mesh.Points = append(b.mesh.Points, 0, 0)
+	}
+
+	if key.normal >= 0 {
+		n := normals[key.normal]
+		b.mesh.Points = append(b.mesh.Points, n[0], n[1], n[2])
+	} else {
+		b.mesh.Points = append(b.mesh.Points, 0, 0, 0)
+		b.generated[index] = true
+	}
+
+	b.vertices[key] = index
+
+	return index
+}
+
+func (b *builder) position(index uint32) [3]float32 {
+	p := b.mesh.Points[int(index)*VertexStride:]
+	return [3]float32{p[0], p[1], p[2]}
+}
+
+func (b *builder) accumulateNormal(i0, i1, i2 uint32) {
+	p0, p1, p2 := b.position(i0), b.position(i1), b.position(i2)
+
+	e1 := [3]float32{p1[0] - p0[0], p1[1] - p0[1], p1[2] - p0[2]}
+	e2 := [3]float32{p2[0] - p0[0], p2[1] - p0[1], p2[2] - p0[2]}
+
+	// the unnormalised cross product weights each face's contribution by its area
+	n := [3]float32{
+		e1[1]*e2[2] - e1[2]*e2[1],
+		e1[2]*e2[0] - e1[0]*e2[2],
+		e1[0]*e2[1] - e1[1]*e2[0],
+	}
+
+	for _, index := range []uint32{i0, i1, i2} {
+		if !b.generated[index] {
+			continue
+		}
+
+		offset := int(index)*VertexStride + NormalOffset/4
+
+		for c := 0; c < 3; c++ {
+			b.mesh.Points[offset+c] += n[c]
+		}
+	}
+}
+
+func (b *builder) normalizeGenerated() {
+	for index := range b.generated {
+		n := b.mesh.Points[int(index)*VertexStride+NormalOffset/4:][:3]
+		length := float32(math.Sqrt(float64(n[0]*n[0] + n[1]*n[1] + n[2]*n[2])))
+
+		if length > 0 {
+			n[0], n[1], n[2] = n[0]/length, n[1]/length, n[2]/length
+		}
+	}
+}
@@ -1,23 +1,23 @@
 package main
 
 import (
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingmultiplemeshtextures"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
 	"runtime"
-	"fmt"
-	"image"
-	"image/png"
-	"image/draw"
-	"os"
 )
 
 func main() {
 	runtime.LockOSThread()
 
-	window := common.InitGLFW()
-	common.InitOpenGL(window)
+	window, err := engine.Init(common.Options)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	points := []float32{
 		0.0, 0.5, 0.0,  	0.5, 1.0,
@@ -59,45 +59,24 @@ func main() {
 
 	gl.BindVertexArray(0)
 
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../../grid.png")
-
-	if err != nil {
-		log.Fatal("Error opening image ../../../grid.png")
-	}
-
-	im, err := png.Decode(ioreader)
+	diffuse, err := texture.LoadImage("../../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../../grid.png")
+		log.Fatal(err.Error())
 	}
 
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	common.CheckError()
 
+	diffuseTexture, err := texture.Upload(diffuse)
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
-	gl.BindTexture(gl.TEXTURE_2D, texId)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	gl.BindTexture(gl.TEXTURE_2D, 0)
+	texId := diffuseTexture.ID
 
 	vertexSourceAsString := `#version 330
 
@@ -125,26 +104,15 @@ void main() {
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	common.CheckError()
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
+	}
 
 	for !window.ShouldClose() {
 		width, height := window.GetFramebufferSize()
@@ -153,11 +121,9 @@ void main() {
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
-		gl.UseProgram(shaderProgram)
+		shaderProgram.Use()
 
-		var location int32 = -1
-		location = gl.GetUniformLocation(shaderProgram, common.GlStr("diffuse"))
-		gl.Uniform1i(location, 0)
+		shaderProgram.SetSampler("diffuse", 0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
@@ -172,7 +138,9 @@ void main() {
 
 		glfw.PollEvents()
 		window.SwapBuffers()
-		common.CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
 }
 
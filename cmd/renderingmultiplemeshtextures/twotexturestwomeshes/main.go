@@ -1,23 +1,23 @@
 package main
 
 import (
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingmultiplemeshtextures"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
 	"runtime"
-	"fmt"
-	"image"
-	"image/png"
-	"image/draw"
-	"os"
 )
 
 func main() {
 	runtime.LockOSThread()
 
-	window := common.InitGLFW()
-	common.InitOpenGL(window)
+	window, err := engine.Init(common.Options)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	points := []float32{
 		0.0, 0.5, 0.0,  	0.5, 1.0,
@@ -59,79 +59,33 @@ func main() {
 
 	gl.BindVertexArray(0)
 
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../../grid.png")
+	diffuse, err := texture.LoadImage("../../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../../grid.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err := png.Decode(ioreader)
+	diffuseTexture, err := texture.Upload(diffuse)
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../../grid.png")
+		log.Fatal(err.Error())
 	}
 
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
-	}
-
-	var texId uint32
-	gl.GenTextures(1, &texId)
-	gl.BindTexture(gl.TEXTURE_2D, texId)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
-	gl.BindTexture(gl.TEXTURE_2D, 0)
+	texId := diffuseTexture.ID
 
-	var diffuse2 *image.RGBA
-
-	ioreader, err = os.Open("../../../grid2.png")
+	diffuse2, err := texture.LoadImage("../../../grid2.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../../grid2.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err = png.Decode(ioreader)
+	diffuse2Texture, err := texture.Upload(diffuse2)
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../../grid2.png")
-	}
-
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse2 = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse2 = copy
+		log.Fatal(err.Error())
 	}
 
-	var texId2 uint32
-	gl.GenTextures(1, &texId2)
-	gl.BindTexture(gl.TEXTURE_2D, texId2)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse2.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
-	gl.BindTexture(gl.TEXTURE_2D, 0)
+	texId2 := diffuse2Texture.ID
 
 	vertexSourceAsString := `#version 330
 
@@ -159,24 +113,11 @@ void main() {
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
-
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 
 	for !window.ShouldClose() {
@@ -186,11 +127,9 @@ void main() {
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
-		gl.UseProgram(shaderProgram)
+		shaderProgram.Use()
 
-		var location int32 = -1
-		location = gl.GetUniformLocation(shaderProgram, common.GlStr("diffuse"))
-		gl.Uniform1i(location, 0)
+		shaderProgram.SetSampler("diffuse", 0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
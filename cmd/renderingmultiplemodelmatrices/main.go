@@ -1,23 +1,22 @@
 package main
 
 import (
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
 	"runtime"
-	"fmt"
-	"image"
-	"image/png"
-	"image/draw"
-	"os"
-	"math"
 )
 
 func main() {
 	runtime.LockOSThread()
 
-	window := initGLFW()
-	initOpenGL(window)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title})
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	points := []float32{
 		0.0, 0.1, 0.0,  	0.5, 1.0,   0.0,
@@ -90,77 +89,25 @@ func main() {
 
 	gl.BindVertexArray(0)
 
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../grid.png")
-
-	if err != nil {
-		log.Fatal("Error opening image ../../grid.png")
-	}
-
-	im, err := png.Decode(ioreader)
+	diffuse, err := texture.LoadImage("../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../grid.png")
-	}
-
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+		log.Fatal(err.Error())
 	}
 
-	var diffuse2 *image.RGBA
-
-	ioreader, err = os.Open("../../grid2.png")
+	diffuse2, err := texture.LoadImage("../../grid2.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../grid2.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err = png.Decode(ioreader)
+	diffuseArray, err := texture.UploadArray([]*texture.Container{diffuse, diffuse2})
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../grid2.png")
-	}
-
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse2 = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse2 = copy
+		log.Fatal(err.Error())
 	}
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
-
-	gl.BindTexture(gl.TEXTURE_2D_ARRAY, texId)
-
-	levels := math.Max(math.Log(float64(1024)), math.Log(float64(1024)))
-
-	if levels < 1 {
-		levels = 1
-	}
-
-	gl.TexStorage3D(gl.TEXTURE_2D_ARRAY, int32(levels), gl.RGBA8, 1024, 1024,2)
-
-	gl.TexSubImage3D(gl.TEXTURE_2D_ARRAY, 0, 0, 0, 0, 1024,1024, 1, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-	gl.TexSubImage3D(gl.TEXTURE_2D_ARRAY, 0, 0, 0, 1, 1024,1024, 1, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse2.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D_ARRAY)
-
-	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D_ARRAY, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
-	gl.BindTexture(gl.TEXTURE_2D_ARRAY, 0)
+	texId := diffuseArray.ID
 
 
 
@@ -244,26 +191,15 @@ void main() {
   out_Colour = vec4(texture(diffuse,vec3(out_Texture, out_Offset)).rgb , 255.0);
 }`
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	CheckError()
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
+	}
 
 	for !window.ShouldClose() {
 		w,h := window.GetFramebufferSize()
@@ -272,14 +208,10 @@ void main() {
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
-		gl.UseProgram(shaderProgram)
-
-		var location int32 = -1
-		location = gl.GetUniformLocation(shaderProgram, glStr("modelMatrices"))
-		gl.Uniform1i(location, 0)
+		shaderProgram.Use()
 
-		location = gl.GetUniformLocation(shaderProgram, glStr("diffuse"))
-		gl.Uniform1i(location, 1)
+		shaderProgram.SetSampler("modelMatrices", 0)
+		shaderProgram.SetSampler("diffuse", 1)
 
 		gl.ActiveTexture(gl.TEXTURE0)
 		gl.BindTexture(gl.TEXTURE_BUFFER, modelMatrixId)
@@ -2,33 +2,25 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"github.com/go-gl/gl/v3.3-core/gl"
 	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingobjectanimation"
-	"image"
-	"image/draw"
-	"image/png"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
-	"os"
 	"runtime"
 	"time"
 )
 
-type Frames struct {
-	Frames []Frame `json:"frames"`
-}
-
-type Frame struct {
-	Frame int64 `json:"frame"`
-	Matrix []float32 `json:"matrix"`
-}
-
 func main() {
 	runtime.LockOSThread()
 
-	window := common.InitGLFW()
-	common.InitOpenGL(window)
+	window, err := engine.Init(common.Options)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	points := []float32{
 		0.0, 0.5, 0.0,  	0.5, 1.0,
@@ -40,141 +32,30 @@ func main() {
 		0,1,2,
 	}
 
-	modelMatricesJSON := `{
-  "frames": [
-    {
-      "frame": 1,
-      "matrix": [
-        1,
-        0,
-        0,
-        -0.5,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    },
-    {
-      "frame": 2,
-      "matrix": [
-        1,
-        0,
-        0,
-        -0.3,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    },
-    {
-      "frame": 3,
-      "matrix": [
-        1,
-        0,
-        0,
-        -0.1,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    },
-    {
-      "frame": 4,
-      "matrix": [
-        1,
-        0,
-        0,
-        0.1,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    },
-    {
-      "frame": 5,
-      "matrix": [
-        1,
-        0,
-        0,
-        0.3,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    },
-    {
-      "frame": 6,
-      "matrix": [
-        1,
-        0,
-        0,
-        0.5,
-        0,
-        1,
-        0,
-        -0.1,
-        0,
-        0,
-        1,
-        0,
-        0,
-        0,
-        0,
-        1
-      ]
-    }
+	// slide across and back, easing at either end, turning a quarter on the way and growing at the far side
+	motionJSON := `{
+  "duration": 4,
+  "loop": true,
+  "translation": [
+    {"time": 0, "value": [-0.5, -0.1, 0], "interpolation": "easeInOut"},
+    {"time": 2, "value": [0.5, -0.1, 0], "interpolation": "easeInOut"},
+    {"time": 4, "value": [-0.5, -0.1, 0]}
+  ],
+  "rotation": [
+    {"time": 0, "value": [0, 0, 0, 1], "interpolation": "cubic", "outTangent": [0, 0, 0.5, 0]},
+    {"time": 2, "value": [0, 0, 0.7071068, 0.7071068], "interpolation": "cubic"},
+    {"time": 4, "value": [0, 0, 0, 1], "inTangent": [0, 0, -0.5, 0]}
+  ],
+  "scale": [
+    {"time": 0, "value": [1, 1, 1], "interpolation": "step"},
+    {"time": 2, "value": [1.5, 1.5, 1], "interpolation": "step"},
+    {"time": 3, "value": [1, 1, 1]}
   ]
 }`
 
-	var frames Frames
+	var motion animation.TransformAnimation
 
-	bf := []byte(modelMatricesJSON)
-	err := json.Unmarshal(bf, &frames)
+	err = json.Unmarshal([]byte(motionJSON), &motion)
 
 	if err != nil {
 		log.Fatal(err.Error())
@@ -205,110 +86,36 @@ func main() {
 
 	gl.BindVertexArray(0)
 
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../../grid.png")
+	diffuse, err := texture.LoadImage("../../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../../grid.png")
-	}
-
-	im, err := png.Decode(ioreader)
-
-	if err != nil {
-		log.Fatal("Error decoding image ../../../grid.png")
+		log.Fatal(err.Error())
 	}
 
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	common.CheckError()
-
-	var texId uint32
-	gl.GenTextures(1, &texId)
-	gl.BindTexture(gl.TEXTURE_2D, texId)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+	diffuseTexture, err := texture.Upload(diffuse)
 
-	gl.BindTexture(gl.TEXTURE_2D, 0)
-
-	curFrame := 0
-
-	currentModelMatrixElements := []float32{}
-
-	// load all of the model matrices into a single array
-	for _, v := range frames.Frames {
-		currentModelMatrixElements = append(currentModelMatrixElements, v.Matrix...)
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
-	var modelBuffer uint32
-
-	gl.GenBuffers(1, &modelBuffer)
-
-	gl.BindBuffer(gl.TEXTURE_BUFFER, modelBuffer)
-	gl.BufferData(gl.TEXTURE_BUFFER, len(currentModelMatrixElements)*4, gl.Ptr(currentModelMatrixElements), gl.STATIC_DRAW)
-	gl.BindBuffer(gl.TEXTURE_BUFFER, 0)
-
-	var modelMatrixId uint32
-
-	gl.GenTextures(1, &modelMatrixId)
-
-	gl.BindTexture(gl.TEXTURE_BUFFER, modelMatrixId)
-	gl.TexBuffer(gl.TEXTURE_BUFFER, gl.R32F, modelBuffer)
-	gl.BindTexture(gl.TEXTURE_BUFFER, 0)
-
+	texId := diffuseTexture.ID
 
 	vertexSourceAsString := `#version 330
 
-uniform samplerBuffer modelMatrices;
-uniform float curFrame;
+uniform mat4 modelMatrix;
 
 layout (location = 0) in vec3 in_Position;
 layout (location = 1) in vec2 in_Texture;
 
 out vec2 out_Texture;
 
-mat4 getModelMatrix(){
-  int index = int(curFrame*16);
-  float m00 = texelFetch(modelMatrices, index + 0).r;
-  float m01 = texelFetch(modelMatrices, index + 1).r;
-  float m02 = texelFetch(modelMatrices, index + 2).r;
-  float m03 = texelFetch(modelMatrices, index + 3).r;
-  float m10 = texelFetch(modelMatrices, index + 4).r;
-  float m11 = texelFetch(modelMatrices, index + 5).r;
-  float m12 = texelFetch(modelMatrices, index + 6).r;
-  float m13 = texelFetch(modelMatrices, index + 7).r;
-  float m20 = texelFetch(modelMatrices, index + 8).r;
-  float m21 = texelFetch(modelMatrices, index + 9).r;
-  float m22 = texelFetch(modelMatrices, index + 10).r;
-  float m23 = texelFetch(modelMatrices, index + 11).r;
-  float m30 = texelFetch(modelMatrices, index + 12).r;
-  float m31 = texelFetch(modelMatrices, index + 13).r;
-  float m32 = texelFetch(modelMatrices, index + 14).r;
-  float m33 = texelFetch(modelMatrices, index + 15).r;
-  
- return mat4(	m00, m10, m20, m30, 
- 				m01, m11, m21, m31, 
- 				m02, m12, m22, m32, 
- 				m03, m13, m23, m33);
-}
-
 void main() {
   out_Texture = in_Texture;
-  mat4 mm = getModelMatrix();
-  gl_Position = mm * vec4(in_Position, 1.0);
+  gl_Position = modelMatrix * vec4(in_Position, 1.0);
 }
 `
 
@@ -325,45 +132,26 @@ void main() {
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	common.CheckError()
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
+	}
 
-	animationFrameTime := float64(float64(1000.0)/float64(common.FPS))
 	previousTick := time.Now()
 	animationCurrentTime := float64(0.0)
 
 	for !window.ShouldClose() {
 
 		timePassed := time.Now().Sub(previousTick)
-		animationCurrentTime += float64(float64(timePassed.Nanoseconds()) / float64(1000000.0))
+		animationCurrentTime += timePassed.Seconds()
 		previousTick = time.Now()
 
-		if animationCurrentTime > animationFrameTime {
-			curFrame++
-			animationCurrentTime = 0.0
-
-			if curFrame == len(frames.Frames) {
-				curFrame = 0
-			}
-		}
+		modelMatrix := motion.ModelMatrix(float32(animationCurrentTime))
 
 		width, height := window.GetFramebufferSize()
 		gl.Viewport(0, 0, int32(width), int32(height))
@@ -371,25 +159,12 @@ void main() {
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
-		gl.UseProgram(shaderProgram)
+		shaderProgram.Use()
 
-		samplerIndex := int32(0)
-		var location int32 = -1
-		location = gl.GetUniformLocation(shaderProgram, common.GlStr("modelMatrices"))
-		gl.Uniform1i(location, samplerIndex)
-		samplerIndex++
-
-		location = gl.GetUniformLocation(shaderProgram, common.GlStr("curFrame"))
-		gl.Uniform1f(location, float32(curFrame))
-
-		location = gl.GetUniformLocation(shaderProgram, common.GlStr("diffuse"))
-		gl.Uniform1i(location, samplerIndex)
-		samplerIndex++
+		shaderProgram.SetMat4("modelMatrix", modelMatrix.Get1D())
+		shaderProgram.SetSampler("diffuse", 0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
-		gl.BindTexture(gl.TEXTURE_BUFFER, modelMatrixId)
-
-		gl.ActiveTexture(gl.TEXTURE1)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
 
 		gl.BindVertexArray(vaoId)
@@ -397,13 +172,12 @@ void main() {
 		gl.BindVertexArray(0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
-		gl.BindTexture(gl.TEXTURE_BUFFER, 0)
-		gl.ActiveTexture(gl.TEXTURE1)
 		gl.BindTexture(gl.TEXTURE_2D, 0)
 
 		glfw.PollEvents()
 		window.SwapBuffers()
-		common.CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
 }
-
@@ -2,6 +2,10 @@ package animation
 
 import "time"
 
+// MaxFrames bounds the frames an imported clip may have, an hour at 60 frames per second. Every frame of every
+// bone is kept in memory, so a corrupt duration would otherwise exhaust it.
+const MaxFrames = 60 * 60 * 60
+
 type SkinnedAnimation struct {
 	AllBindPoseTransformations map[string]*IntToMatrix4fMap
 	InvertedMatrices           map[string]*Matrix4f
@@ -44,6 +48,17 @@ func NewSkinnedAnimation(armature *Armature, keyframes map[string]*IntToMatrix4f
 	return sa
 }
 
+// FramePose returns the model space matrix of every bone at the given frame.
+func (a *SkinnedAnimation) FramePose(frame int) map[string]*Matrix4f {
+	pose := map[string]*Matrix4f{}
+
+	for boneName, frames := range a.BindMatrices {
+		pose[boneName] = frames.Get(frame)
+	}
+
+	return pose
+}
+
 func (a *SkinnedAnimation) generateFrames(exportedKeyframes map[string]*IntToMatrix4fMap) {
 
 	for boneName, frameToMatrix := range exportedKeyframes {
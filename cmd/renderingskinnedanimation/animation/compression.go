@@ -0,0 +1,343 @@
+package animation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// sizes used to estimate how large the keys would be if written out as binary
+const (
+	uncompressedKeySize = 16 * 4
+	compressedKeySize   = 2 + 3*4 + 7 + 3*4
+	quaternionRange     = 32767 * math.Sqrt2
+)
+
+type CompressionSettings struct {
+	PositionTolerance float32
+	RotationTolerance float32 // radians
+	ScaleTolerance    float32
+}
+
+func DefaultCompressionSettings() CompressionSettings {
+	return CompressionSettings{
+		PositionTolerance: 0.001,
+		RotationTolerance: 0.001,
+		ScaleTolerance:    0.001,
+	}
+}
+
+// QuantizedQuaternion stores a unit quaternion using the "smallest three" encoding: the index of the
+// largest component is kept and the other three are stored as 16 bit integers.
+type QuantizedQuaternion struct {
+	Largest uint8
+	A       int16
+	B       int16
+	C       int16
+}
+
+func QuantizeQuaternion(q Quaternion) QuantizedQuaternion {
+	q = q.Normalize()
+	c := []float32{q.X, q.Y, q.Z, q.W}
+
+	largest := 0
+	for i := 1; i < 4; i++ {
+		if math.Abs(float64(c[i])) > math.Abs(float64(c[largest])) {
+			largest = i
+		}
+	}
+
+	// q and -q are the same rotation, so flip the sign to keep the dropped component positive
+	if c[largest] < 0 {
+		for i := range c {
+			c[i] = -c[i]
+		}
+	}
+
+	rest := []int16{}
+	for i := 0; i < 4; i++ {
+		if i != largest {
+			rest = append(rest, int16(math.Round(float64(c[i])*quaternionRange)))
+		}
+	}
+
+	return QuantizedQuaternion{uint8(largest), rest[0], rest[1], rest[2]}
+}
+
+func (q QuantizedQuaternion) Quaternion() Quaternion {
+	a := float64(q.A) / quaternionRange
+	b := float64(q.B) / quaternionRange
+	c := float64(q.C) / quaternionRange
+	d := math.Sqrt(math.Max(0, 1-a*a-b*b-c*c))
+
+	var v []float64
+	switch q.Largest {
+	case 0:
+		v = []float64{d, a, b, c}
+	case 1:
+		v = []float64{a, d, b, c}
+	case 2:
+		v = []float64{a, b, d, c}
+	default:
+		v = []float64{a, b, c, d}
+	}
+
+	return Quaternion{float32(v[0]), float32(v[1]), float32(v[2]), float32(v[3])}.Normalize()
+}
+
+func (q QuantizedQuaternion) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]int{int(q.Largest), int(q.A), int(q.B), int(q.C)})
+}
+
+func (q *QuantizedQuaternion) UnmarshalJSON(b []byte) error {
+	c := []int{}
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return err
+	}
+
+	if len(c) != 4 || c[0] < 0 || c[0] > 3 {
+		return fmt.Errorf("quantized quaternion: expected [largest, a, b, c], got %v", c)
+	}
+
+	*q = QuantizedQuaternion{uint8(c[0]), int16(c[1]), int16(c[2]), int16(c[3])}
+
+	return nil
+}
+
+type CompressedKey struct {
+	Frame       int                 `json:"f"`
+	Translation Vector3f            `json:"t"`
+	Rotation    QuantizedQuaternion `json:"r"`
+	Scale       Vector3f            `json:"s"`
+}
+
+func (k *CompressedKey) transform() Transform {
+	return Transform{k.Translation, k.Rotation.Quaternion(), k.Scale}
+}
+
+type CompressedTrack struct {
+	Keys []CompressedKey `json:"keys"`
+}
+
+// Sample returns the interpolated transform at a (possibly fractional) frame, clamping outside the keyed range.
+func (t *CompressedTrack) Sample(frame float32) Transform {
+	keys := t.Keys
+
+	if len(keys) == 0 {
+		return IdentityTransform()
+	}
+
+	i := sort.Search(len(keys), func(i int) bool {
+		return float32(keys[i].Frame) >= frame
+	})
+
+	if i == 0 {
+		return keys[0].transform()
+	}
+
+	if i == len(keys) {
+		return keys[len(keys)-1].transform()
+	}
+
+	a := keys[i-1]
+	b := keys[i]
+	f := (frame - float32(a.Frame)) / float32(b.Frame-a.Frame)
+
+	return a.transform().Lerp(b.transform(), f)
+}
+
+type CompressedClip struct {
+	StartFrame int                         `json:"startFrame"`
+	EndFrame   int                         `json:"endFrame"`
+	Tracks     map[string]*CompressedTrack `json:"tracks"`
+}
+
+// Sample returns the bone's matrix at the given frame, or nil if the clip has no track for the bone.
+func (c *CompressedClip) Sample(boneName string, frame float32) *Matrix4f {
+	track := c.Tracks[boneName]
+
+	if track == nil {
+		return nil
+	}
+
+	return track.Sample(frame).Matrix()
+}
+
+// BoneMatrixBuffer fills the skinning shader's bone matrix texture buffer: for each of boneNames in order, the
+// model space matrix at every frame from StartFrame to EndFrame. Each frame decodes the keys either side of it
+// straight from the tracks, so only the buffer itself is ever held as matrices.
+func (c *CompressedClip) BoneMatrixBuffer(armature *Armature, boneNames []string) ([]float32, error) {
+	frameCount := int64(c.EndFrame) - int64(c.StartFrame) + 1
+
+	if frameCount < 1 {
+		return nil, fmt.Errorf("compressed clip ends at frame %d before it starts at %d", c.EndFrame, c.StartFrame)
+	}
+
+	if frameCount > MaxFrames {
+		return nil, fmt.Errorf("compressed clip has %d frames, more than %d", frameCount, MaxFrames)
+	}
+
+	frames := int(frameCount)
+	buffer := make([]float32, len(boneNames)*frames*16)
+	pose := NewPose(armature)
+
+	for f := 0; f < frames; f++ {
+		pose.SetFromCompressed(c, float32(c.StartFrame+f))
+		palette := pose.Palette(boneNames)
+
+		for b := range boneNames {
+			copy(buffer[(b*frames+f)*16:], palette[b*16:b*16+16])
+		}
+	}
+
+	return buffer, nil
+}
+
+type BoneCompressionStats struct {
+	OriginalKeys     int
+	KeptKeys         int
+	MaxPositionError float32
+	MaxRotationError float32
+	MaxScaleError    float32
+}
+
+type CompressionReport struct {
+	OriginalBytes   int
+	CompressedBytes int
+	Bones           map[string]*BoneCompressionStats
+}
+
+func (r *CompressionReport) Ratio() float64 {
+	if r.CompressedBytes == 0 {
+		return 0
+	}
+
+	return float64(r.OriginalBytes) / float64(r.CompressedBytes)
+}
+
+func (r *CompressionReport) String() string {
+	names := []string{}
+	for name := range r.Bones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%d bytes -> %d bytes (%.2fx)\n", r.OriginalBytes, r.CompressedBytes, r.Ratio())
+
+	for _, name := range names {
+		s := r.Bones[name]
+		fmt.Fprintf(&sb, "%s: %d/%d keys, position %.6f, rotation %.6f rad, scale %.6f\n",
+			name, s.KeptKeys, s.OriginalKeys, s.MaxPositionError, s.MaxRotationError, s.MaxScaleError)
+	}
+
+	return sb.String()
+}
+
+// CompressKeyframes removes every key that can be rebuilt by interpolating its neighbours within the
+// given tolerances, and stores the remaining rotations as quantized quaternions.
+func CompressKeyframes(keyframes map[string]*IntToMatrix4fMap, settings CompressionSettings) (*CompressedClip, *CompressionReport) {
+	clip := &CompressedClip{
+		StartFrame: math.MaxInt32,
+		EndFrame:   math.MinInt32,
+		Tracks:     map[string]*CompressedTrack{},
+	}
+
+	report := &CompressionReport{
+		Bones: map[string]*BoneCompressionStats{},
+	}
+
+	for boneName, frames := range keyframes {
+		keys := []CompressedKey{}
+		originals := []Transform{}
+
+		for _, frame := range frames.Keys() {
+			t := DecomposeMatrix(frames.Get(frame))
+			originals = append(originals, t)
+			keys = append(keys, CompressedKey{frame, t.Translation, QuantizeQuaternion(t.Rotation), t.Scale})
+
+			if frame < clip.StartFrame {
+				clip.StartFrame = frame
+			}
+			if frame > clip.EndFrame {
+				clip.EndFrame = frame
+			}
+		}
+
+		track := &CompressedTrack{reduceKeys(keys, originals, settings)}
+		clip.Tracks[boneName] = track
+
+		stats := &BoneCompressionStats{
+			OriginalKeys: len(keys),
+			KeptKeys:     len(track.Keys),
+		}
+
+		for i, key := range keys {
+			p, r, s := transformError(track.Sample(float32(key.Frame)), originals[i])
+			stats.MaxPositionError = float32(math.Max(float64(stats.MaxPositionError), float64(p)))
+			stats.MaxRotationError = float32(math.Max(float64(stats.MaxRotationError), float64(r)))
+			stats.MaxScaleError = float32(math.Max(float64(stats.MaxScaleError), float64(s)))
+		}
+
+		report.Bones[boneName] = stats
+		report.OriginalBytes += len(keys) * uncompressedKeySize
+		report.CompressedBytes += len(track.Keys) * compressedKeySize
+	}
+
+	if len(clip.Tracks) == 0 {
+		clip.StartFrame = 0
+		clip.EndFrame = 0
+	}
+
+	return clip, report
+}
+
+// reduceKeys greedily extends each segment from the last kept key for as long as every original key
+// it spans can be rebuilt within tolerance; the first and last keys are always kept.
+func reduceKeys(keys []CompressedKey, originals []Transform, settings CompressionSettings) []CompressedKey {
+	if len(keys) <= 2 {
+		return keys
+	}
+
+	kept := []CompressedKey{keys[0]}
+	anchor := 0
+
+	for i := 1; i < len(keys)-1; i++ {
+		if !segmentWithinTolerance(keys, originals, anchor, i+1, settings) {
+			kept = append(kept, keys[i])
+			anchor = i
+		}
+	}
+
+	return append(kept, keys[len(keys)-1])
+}
+
+func segmentWithinTolerance(keys []CompressedKey, originals []Transform, from, to int, settings CompressionSettings) bool {
+	a := keys[from]
+	b := keys[to]
+	segment := &CompressedTrack{[]CompressedKey{a, b}}
+
+	for i := from + 1; i < to; i++ {
+		p, r, s := transformError(segment.Sample(float32(keys[i].Frame)), originals[i])
+
+		if p > settings.PositionTolerance || r > settings.RotationTolerance || s > settings.ScaleTolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+func transformError(a, b Transform) (position, rotation, scale float32) {
+	position = a.Translation.Sub(b.Translation).Length()
+	rotation = a.Rotation.Angle(b.Rotation)
+
+	d := a.Scale.Sub(b.Scale)
+	scale = float32(math.Max(math.Abs(float64(d.X)), math.Max(math.Abs(float64(d.Y)), math.Abs(float64(d.Z)))))
+
+	return position, rotation, scale
+}
@@ -0,0 +1,65 @@
+package animation_test
+
+import (
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/internal/trumpdata"
+	"math"
+	"testing"
+)
+
+func TestBoneMatrixBufferMatchesUncompressed(t *testing.T) {
+	data := trumpdata.Load(t)
+	skeleton, err := NewSkeleton(data.Armature)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clip, _ := CompressKeyframes(data.Keyframes, DefaultCompressionSettings())
+	buffer, err := clip.BoneMatrixBuffer(data.Armature, skeleton.Names)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frameCount := trumpdata.EndFrame - trumpdata.StartFrame + 1
+
+	if clip.StartFrame != trumpdata.StartFrame || clip.EndFrame != trumpdata.EndFrame || len(buffer) != len(skeleton.Names)*frameCount*16 {
+		t.Fatalf("got frames %d-%d and %d floats, want frames %d-%d and %d floats", clip.StartFrame, clip.EndFrame,
+			len(buffer), trumpdata.StartFrame, trumpdata.EndFrame, len(skeleton.Names)*frameCount*16)
+	}
+
+	bindMatrices := NewSkinnedAnimation(data.Armature, data.Keyframes, trumpdata.EndFrame, 30).BindMatrices
+
+	for b, boneName := range skeleton.Names {
+		for f := 0; f < frameCount; f++ {
+			want := bindMatrices[boneName].Get(trumpdata.StartFrame + f).Get1D()
+			got := buffer[(b*frameCount+f)*16 : (b*frameCount+f+1)*16]
+
+			for i := range want {
+				if math.Abs(float64(got[i]-want[i])) > 0.01 {
+					t.Fatalf("bone %q frame %d is %v, want %v", boneName, trumpdata.StartFrame+f, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestBoneMatrixBufferErrors(t *testing.T) {
+	armature := &Armature{Name: "Armature", Bones: map[string]*Bone{}}
+
+	tests := []struct {
+		name string
+		clip *CompressedClip
+	}{
+		{"ends before it starts", &CompressedClip{StartFrame: 5, EndFrame: 4}},
+		{"too many frames", &CompressedClip{StartFrame: 1, EndFrame: MaxFrames + 1}},
+		{"frame range overflows", &CompressedClip{StartFrame: math.MinInt32, EndFrame: math.MaxInt32}},
+	}
+
+	for _, test := range tests {
+		if _, err := test.clip.BoneMatrixBuffer(armature, nil); err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package animation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Interpolation is how a key blends into the next one.
+type Interpolation int
+
+const (
+	Step Interpolation = iota
+	Linear
+	Cubic // a Bezier through the key values, shaped by the keys' tangents
+	EaseIn
+	EaseOut
+	EaseInOut
+)
+
+var interpolationNames = []string{"step", "linear", "cubic", "easeIn", "easeOut", "easeInOut"}
+
+func (i Interpolation) String() string {
+	if i < 0 || int(i) >= len(interpolationNames) {
+		return fmt.Sprintf("Interpolation(%d)", int(i))
+	}
+
+	return interpolationNames[i]
+}
+
+func (i *Interpolation) UnmarshalJSON(b []byte) error {
+	var name string
+
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+
+	for value, n := range interpolationNames {
+		if n == name {
+			*i = Interpolation(value)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown interpolation %q", name)
+}
+
+func (i Interpolation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// ease remaps the fraction of the way between two keys.
+func (i Interpolation) ease(t float32) float32 {
+	switch i {
+	case Step:
+		return 0
+	case EaseIn:
+		return t * t
+	case EaseOut:
+		return 1 - (1-t)*(1-t)
+	case EaseInOut:
+		return t * t * (3 - 2*t)
+	}
+
+	return t
+}
+
+// VectorKey is a translation or scale key. Tangents are rates of change per second and only shape Cubic
+// segments: OutTangent leaves this key, InTangent arrives at it.
+type VectorKey struct {
+	Time          float32
+	Value         Vector3f
+	Interpolation Interpolation
+	InTangent     Vector3f
+	OutTangent    Vector3f
+}
+
+// RotationKey is a rotation key. Cubic segments interpolate the quaternion components and normalize.
+type RotationKey struct {
+	Time          float32
+	Value         Quaternion
+	Interpolation Interpolation
+	InTangent     Quaternion
+	OutTangent    Quaternion
+}
+
+type keyJSON struct {
+	Time          float32        `json:"time"`
+	Value         []float32      `json:"value"`
+	Interpolation *Interpolation `json:"interpolation,omitempty"`
+	InTangent     []float32      `json:"inTangent,omitempty"`
+	OutTangent    []float32      `json:"outTangent,omitempty"`
+}
+
+// decode reads the key's arrays into size long slices; missing tangents are zero and the default
+// interpolation is linear.
+func (k *keyJSON) decode(b []byte, size int) (value, in, out []float32, interpolation Interpolation, err error) {
+	if err = json.Unmarshal(b, k); err != nil {
+		return
+	}
+
+	interpolation = Linear
+
+	if k.Interpolation != nil {
+		interpolation = *k.Interpolation
+	}
+
+	if k.InTangent == nil {
+		k.InTangent = make([]float32, size)
+	}
+
+	if k.OutTangent == nil {
+		k.OutTangent = make([]float32, size)
+	}
+
+	for name, values := range map[string][]float32{"value": k.Value, "inTangent": k.InTangent, "outTangent": k.OutTangent} {
+		if len(values) != size {
+			err = fmt.Errorf("key at %g: %s needs %d values, got %d", k.Time, name, size, len(values))
+			return
+		}
+	}
+
+	return k.Value, k.InTangent, k.OutTangent, interpolation, nil
+}
+
+func (k *VectorKey) UnmarshalJSON(b []byte) error {
+	var raw keyJSON
+	value, in, out, interpolation, err := raw.decode(b, 3)
+
+	if err != nil {
+		return err
+	}
+
+	*k = VectorKey{raw.Time, Vector3f{value[0], value[1], value[2]}, interpolation,
+		Vector3f{in[0], in[1], in[2]}, Vector3f{out[0], out[1], out[2]}}
+
+	return nil
+}
+
+func (k VectorKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyJSON{k.Time, []float32{k.Value.X, k.Value.Y, k.Value.Z}, &k.Interpolation,
+		[]float32{k.InTangent.X, k.InTangent.Y, k.InTangent.Z}, []float32{k.OutTangent.X, k.OutTangent.Y, k.OutTangent.Z}})
+}
+
+func (k *RotationKey) UnmarshalJSON(b []byte) error {
+	var raw keyJSON
+	value, in, out, interpolation, err := raw.decode(b, 4)
+
+	if err != nil {
+		return err
+	}
+
+	*k = RotationKey{raw.Time, Quaternion{value[0], value[1], value[2], value[3]}.Normalize(), interpolation,
+		Quaternion{in[0], in[1], in[2], in[3]}, Quaternion{out[0], out[1], out[2], out[3]}}
+
+	return nil
+}
+
+func (k RotationKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyJSON{k.Time, []float32{k.Value.X, k.Value.Y, k.Value.Z, k.Value.W}, &k.Interpolation,
+		[]float32{k.InTangent.X, k.InTangent.Y, k.InTangent.Z, k.InTangent.W},
+		[]float32{k.OutTangent.X, k.OutTangent.Y, k.OutTangent.Z, k.OutTangent.W}})
+}
+
+// TransformAnimation moves an object with separate translation, rotation and scale tracks, each a list of keys
+// in time order. An empty track leaves its component at the identity. Times are in seconds.
+type TransformAnimation struct {
+	Duration    float32       `json:"duration"` // 0 ends the animation at its last key
+	Loop        bool          `json:"loop"`
+	Translation []VectorKey   `json:"translation"`
+	Rotation    []RotationKey `json:"rotation"`
+	Scale       []VectorKey   `json:"scale"`
+}
+
+func (a *TransformAnimation) UnmarshalJSON(b []byte) error {
+	type plain TransformAnimation
+	var x plain
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	tracks := map[string][]float32{}
+
+	for _, k := range x.Translation {
+		tracks["translation"] = append(tracks["translation"], k.Time)
+	}
+	for _, k := range x.Rotation {
+		tracks["rotation"] = append(tracks["rotation"], k.Time)
+	}
+	for _, k := range x.Scale {
+		tracks["scale"] = append(tracks["scale"], k.Time)
+	}
+
+	for name, times := range tracks {
+		for i := 1; i < len(times); i++ {
+			if times[i] <= times[i-1] {
+				return fmt.Errorf("%s key %d at %g must come after the previous key at %g", name, i, times[i], times[i-1])
+			}
+		}
+	}
+
+	if x.Duration < 0 {
+		return fmt.Errorf("duration must not be negative, got %g", x.Duration)
+	}
+
+	*a = TransformAnimation(x)
+
+	return nil
+}
+
+// Length returns the duration, or the time of the last key when no duration is set.
+func (a *TransformAnimation) Length() float32 {
+	if a.Duration > 0 {
+		return a.Duration
+	}
+
+	var length float32
+
+	if n := len(a.Translation); n > 0 && a.Translation[n-1].Time > length {
+		length = a.Translation[n-1].Time
+	}
+	if n := len(a.Rotation); n > 0 && a.Rotation[n-1].Time > length {
+		length = a.Rotation[n-1].Time
+	}
+	if n := len(a.Scale); n > 0 && a.Scale[n-1].Time > length {
+		length = a.Scale[n-1].Time
+	}
+
+	return length
+}
+
+// Sample evaluates every track at a time in seconds. Looping animations wrap the time into their length, others
+// hold their first and last keys.
+func (a *TransformAnimation) Sample(time float32) Transform {
+	if length := a.Length(); a.Loop && length > 0 {
+		time = float32(math.Mod(float64(time), float64(length)))
+
+		if time < 0 {
+			time += length
+		}
+	}
+
+	t := IdentityTransform()
+
+	if len(a.Translation) > 0 {
+		t.Translation = sampleVectors(a.Translation, time)
+	}
+
+	if len(a.Rotation) > 0 {
+		t.Rotation = sampleRotations(a.Rotation, time)
+	}
+
+	if len(a.Scale) > 0 {
+		t.Scale = sampleVectors(a.Scale, time)
+	}
+
+	return t
+}
+
+// ModelMatrix returns the row-major model matrix at a time in seconds.
+func (a *TransformAnimation) ModelMatrix(time float32) *Matrix4f {
+	return a.Sample(time).Matrix()
+}
+
+// segment finds the key at or before time and how far time is towards the next key. ok is false when time is
+// outside the keys, in which case index is the key to hold.
+func segment(count int, keyTime func(int) float32, time float32) (index int, fraction float32, ok bool) {
+	if time <= keyTime(0) {
+		return 0, 0, false
+	}
+
+	if time >= keyTime(count-1) {
+		return count - 1, 0, false
+	}
+
+	low, high := 0, count-1
+
+	for high-low > 1 {
+		middle := (low + high) / 2
+
+		if keyTime(middle) <= time {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return low, (time - keyTime(low)) / (keyTime(low+1) - keyTime(low)), true
+}
+
+func sampleVectors(keys []VectorKey, time float32) Vector3f {
+	i, f, ok := segment(len(keys), func(i int) float32 { return keys[i].Time }, time)
+
+	if !ok {
+		return keys[i].Value
+	}
+
+	from, to := keys[i], keys[i+1]
+
+	if from.Interpolation == Cubic {
+		dt := to.Time - from.Time
+
+		return Vector3f{
+			bezier(from.Value.X, from.OutTangent.X, to.InTangent.X, to.Value.X, dt, f),
+			bezier(from.Value.Y, from.OutTangent.Y, to.InTangent.Y, to.Value.Y, dt, f),
+			bezier(from.Value.Z, from.OutTangent.Z, to.InTangent.Z, to.Value.Z, dt, f),
+		}
+	}
+
+	return from.Value.Lerp(to.Value, from.Interpolation.ease(f))
+}
+
+func sampleRotations(keys []RotationKey, time float32) Quaternion {
+	i, f, ok := segment(len(keys), func(i int) float32 { return keys[i].Time }, time)
+
+	if !ok {
+		return keys[i].Value
+	}
+
+	from, to := keys[i], keys[i+1]
+
+	if from.Interpolation == Cubic {
+		dt := to.Time - from.Time
+
+		return Quaternion{
+			bezier(from.Value.X, from.OutTangent.X, to.InTangent.X, to.Value.X, dt, f),
+			bezier(from.Value.Y, from.OutTangent.Y, to.InTangent.Y, to.Value.Y, dt, f),
+			bezier(from.Value.Z, from.OutTangent.Z, to.InTangent.Z, to.Value.Z, dt, f),
+			bezier(from.Value.W, from.OutTangent.W, to.InTangent.W, to.Value.W, dt, f),
+		}.Normalize()
+	}
+
+	return from.Value.Slerp(to.Value, from.Interpolation.ease(f))
+}
+
+// bezier evaluates the cubic Bezier from p0 to p3 whose inner control points sit a third of the segment along
+// the tangents, the same curve as a Hermite spline with those tangents.
+func bezier(p0, outTangent, inTangent, p3, dt, t float32) float32 {
+	p1 := p0 + outTangent*dt/3
+	p2 := p3 - inTangent*dt/3
+	u := 1 - t
+
+	return u*u*u*p0 + 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t*p3
+}
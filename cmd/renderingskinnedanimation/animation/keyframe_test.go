@@ -0,0 +1,76 @@
+package animation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		name     string
+		times    []float32
+		time     float32
+		index    int
+		fraction float32
+		ok       bool
+	}{
+		{"before the first key", []float32{0, 1, 3}, -1, 0, 0, false},
+		{"on the first key", []float32{0, 1, 3}, 0, 0, 0, false},
+		{"inside the first segment", []float32{0, 1, 3}, 0.25, 0, 0.25, true},
+		{"on an inner key", []float32{0, 1, 3}, 1, 1, 0, true},
+		{"inside the last segment", []float32{0, 1, 3}, 2.5, 1, 0.75, true},
+		{"on the last key", []float32{0, 1, 3}, 3, 2, 0, false},
+		{"after the last key", []float32{0, 1, 3}, 10, 2, 0, false},
+		{"single key before", []float32{2}, 1, 0, 0, false},
+		{"single key after", []float32{2}, 3, 0, 0, false},
+		{"two keys", []float32{1, 2}, 1.5, 0, 0.5, true},
+		{"many keys", []float32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 6.25, 6, 0.25, true},
+		{"uneven keys", []float32{-2, -1, 0.5, 4, 8}, 6, 3, 0.5, true},
+	}
+
+	for _, test := range tests {
+		index, fraction, ok := segment(len(test.times), func(i int) float32 { return test.times[i] }, test.time)
+
+		if index != test.index || fraction != test.fraction || ok != test.ok {
+			t.Errorf("%s: time %g gave key %d fraction %g %v, want key %d fraction %g %v",
+				test.name, test.time, index, fraction, ok, test.index, test.fraction, test.ok)
+		}
+	}
+}
+
+// hermite is the cubic Hermite spline bezier claims to match, with tangents scaled from per second to the segment.
+func hermite(p0, outTangent, inTangent, p3, dt, t float32) float32 {
+	t2, t3 := t*t, t*t*t
+
+	return (2*t3-3*t2+1)*p0 + (t3-2*t2+t)*outTangent*dt + (-2*t3+3*t2)*p3 + (t3-t2)*inTangent*dt
+}
+
+func TestBezier(t *testing.T) {
+	tests := []struct {
+		name                              string
+		p0, outTangent, inTangent, p3, dt float32
+		t                                 float32
+		want                              float32
+	}{
+		{"start", 1, 5, -3, 4, 2, 0, 1},
+		{"end", 1, 5, -3, 4, 2, 1, 4},
+		{"flat tangents ease", 0, 0, 0, 1, 1, 0.5, 0.5},
+		{"flat tangents ease in", 0, 0, 0, 1, 1, 0.25, 0.15625},
+		{"constant", 5, 0, 0, 5, 3, 0.7, 5},
+		{"tangents along the line are linear", 0, 1, 1, 2, 2, 0.25, 0.5},
+		{"tangents scale with the segment", 0, 1, 1, 4, 4, 0.75, 3},
+		{"overshoot", 0, 6, 6, 1, 1, 0.25, 0.71875},
+	}
+
+	for _, test := range tests {
+		got := bezier(test.p0, test.outTangent, test.inTangent, test.p3, test.dt, test.t)
+
+		if math.Abs(float64(got-test.want)) > 1e-6 {
+			t.Errorf("%s: got %g, want %g", test.name, got, test.want)
+		}
+
+		if h := hermite(test.p0, test.outTangent, test.inTangent, test.p3, test.dt, test.t); math.Abs(float64(got-h)) > 1e-5 {
+			t.Errorf("%s: got %g, the Hermite spline gives %g", test.name, got, h)
+		}
+	}
+}
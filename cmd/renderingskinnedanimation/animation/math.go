@@ -2,8 +2,10 @@ package animation
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/go-gl/gl/v3.3-core/gl"
 	"math"
+	"unsafe"
 )
 
 type Matrix4f struct {
@@ -29,22 +31,28 @@ type Matrix4f struct {
 }
 
 func (e *Matrix4f) UnmarshalJSON(b []byte) error {
-
 	c := []float32{}
 
-	if err := json.Unmarshal(b, &c); err == nil {
-		x := &Matrix4f{c[0], c[1], c[2], c[3],
-			c[4], c[5], c[6], c[7],
-			c[8], c[9], c[10], c[11],
-			c[12], c[13], c[14], c[15]}
-		*e = *x
-	} else {
+	if err := json.Unmarshal(b, &c); err != nil {
 		return err
 	}
 
+	if len(c) != 16 {
+		return fmt.Errorf("matrix needs 16 values, got %d", len(c))
+	}
+
+	*e = Matrix4f{c[0], c[1], c[2], c[3],
+		c[4], c[5], c[6], c[7],
+		c[8], c[9], c[10], c[11],
+		c[12], c[13], c[14], c[15]}
+
 	return nil
 }
 
+func (e *Matrix4f) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Get1D())
+}
+
 func (m *Matrix4f) Mul(r *Matrix4f) *Matrix4f {
 	res := new(Matrix4f)
 
@@ -71,6 +79,75 @@ func (m *Matrix4f) Mul(r *Matrix4f) *Matrix4f {
 	return res
 }
 
+// Inverse returns the inverse of the matrix, or nil if it is singular.
+func (m *Matrix4f) Inverse() *Matrix4f {
+	a := m.Get1D()
+
+	c00 := a[0]*a[5] - a[1]*a[4]
+	c01 := a[0]*a[6] - a[2]*a[4]
+	c02 := a[0]*a[7] - a[3]*a[4]
+	c03 := a[1]*a[6] - a[2]*a[5]
+	c04 := a[1]*a[7] - a[3]*a[5]
+	c05 := a[2]*a[7] - a[3]*a[6]
+	c06 := a[8]*a[13] - a[9]*a[12]
+	c07 := a[8]*a[14] - a[10]*a[12]
+	c08 := a[8]*a[15] - a[11]*a[12]
+	c09 := a[9]*a[14] - a[10]*a[13]
+	c10 := a[9]*a[15] - a[11]*a[13]
+	c11 := a[10]*a[15] - a[11]*a[14]
+
+	det := c00*c11 - c01*c10 + c02*c09 + c03*c08 - c04*c07 + c05*c06
+
+	if det == 0 {
+		return nil
+	}
+
+	d := 1 / det
+
+	return &Matrix4f{
+		(a[5]*c11 - a[6]*c10 + a[7]*c09) * d,
+		(-a[1]*c11 + a[2]*c10 - a[3]*c09) * d,
+		(a[13]*c05 - a[14]*c04 + a[15]*c03) * d,
+		(-a[9]*c05 + a[10]*c04 - a[11]*c03) * d,
+
+		(-a[4]*c11 + a[6]*c08 - a[7]*c07) * d,
+		(a[0]*c11 - a[2]*c08 + a[3]*c07) * d,
+		(-a[12]*c05 + a[14]*c02 - a[15]*c01) * d,
+		(a[8]*c05 - a[10]*c02 + a[11]*c01) * d,
+
+		(a[4]*c10 - a[5]*c08 + a[7]*c06) * d,
+		(-a[0]*c10 + a[1]*c08 - a[3]*c06) * d,
+		(a[12]*c04 - a[13]*c02 + a[15]*c00) * d,
+		(-a[8]*c04 + a[9]*c02 - a[11]*c00) * d,
+
+		(-a[4]*c09 + a[5]*c07 - a[6]*c06) * d,
+		(a[0]*c09 - a[1]*c07 + a[2]*c06) * d,
+		(-a[12]*c03 + a[13]*c01 - a[14]*c00) * d,
+		(a[8]*c03 - a[9]*c01 + a[10]*c00) * d,
+	}
+}
+
+func (m *Matrix4f) TransformPoint(v Vector3f) Vector3f {
+	return Vector3f{
+		m.M00*v.X + m.M01*v.Y + m.M02*v.Z + m.M03,
+		m.M10*v.X + m.M11*v.Y + m.M12*v.Z + m.M13,
+		m.M20*v.X + m.M21*v.Y + m.M22*v.Z + m.M23,
+	}
+}
+
+func (m *Matrix4f) Translation() Vector3f {
+	return Vector3f{m.M03, m.M13, m.M23}
+}
+
+func NewTranslationMatrix(v Vector3f) *Matrix4f {
+	return &Matrix4f{
+		1, 0, 0, v.X,
+		0, 1, 0, v.Y,
+		0, 0, 1, v.Z,
+		0, 0, 0, 1,
+	}
+}
+
 func (m *Matrix4f) Get1D() []float32 {
 	return []float32{m.M00, m.M01, m.M02, m.M03, m.M10, m.M11, m.M12, m.M13, m.M20, m.M21, m.M22, m.M23, m.M30, m.M31, m.M32, m.M33}
 }
@@ -84,32 +161,40 @@ func DegreesToRadians(degrees float32) float32 {
 }
 
 func NewProjectionMatrix(width, height int) *Matrix4f {
-	projectionMatrix := new(Matrix4f)
-
-	projectionMatrix.M00 = 1
-	projectionMatrix.M11 = 1
-	projectionMatrix.M22 = 1
-	projectionMatrix.M33 = 1
+	return NewPerspectiveMatrix(80, float32(width)/float32(height), 0.1, 300)
+}
 
-	var fieldOfView = float32(80.0)
-	var aspectRatio = float32(float32(width) / float32(height))
-	near_plane := 0.1
-	far_plane := 300.0
+// NewPerspectiveMatrix returns a projection with a vertical field of view in degrees.
+func NewPerspectiveMatrix(fieldOfView, aspectRatio, near, far float32) *Matrix4f {
+	projectionMatrix := new(Matrix4f)
 
 	y_scale := CoTangent(DegreesToRadians(fieldOfView / 2.0))
 	x_scale := y_scale / aspectRatio
-	frustum_length := far_plane - near_plane
+	frustum_length := far - near
 
 	projectionMatrix.M00 = x_scale
 	projectionMatrix.M11 = y_scale
-	projectionMatrix.M22 = float32(-((far_plane + near_plane) / frustum_length))
+	projectionMatrix.M22 = -((far + near) / frustum_length)
 	projectionMatrix.M32 = -1
-	projectionMatrix.M23 = float32(-((2 * near_plane * far_plane) / frustum_length))
-	projectionMatrix.M33 = 0
+	projectionMatrix.M23 = -((2 * near * far) / frustum_length)
 
 	return projectionMatrix
 }
 
+// NewLookAtMatrix returns the view matrix of a camera at eye looking towards target.
+func NewLookAtMatrix(eye, target, up Vector3f) *Matrix4f {
+	forward := target.Sub(eye).Normalize()
+	side := forward.Cross(up).Normalize()
+	up = side.Cross(forward)
+
+	return &Matrix4f{
+		side.X, side.Y, side.Z, -side.Dot(eye),
+		up.X, up.Y, up.Z, -up.Dot(eye),
+		-forward.X, -forward.Y, -forward.Z, forward.Dot(eye),
+		0, 0, 0, 1,
+	}
+}
+
 func ArrayToTexture(modelMatrixElements []float32) *TextureAndBufferIds {
 
 	var modelMatrixId uint32
@@ -123,7 +208,7 @@ func ArrayToTexture(modelMatrixElements []float32) *TextureAndBufferIds {
 	gl.GenBuffers(1, &modelBuffer)
 
 	gl.BindBuffer(gl.TEXTURE_BUFFER, modelBuffer)
-	gl.BufferData(gl.TEXTURE_BUFFER, len(modelMatrixElements)*4, gl.Ptr(modelMatrixElements), gl.STATIC_DRAW)
+	gl.BufferData(gl.TEXTURE_BUFFER, len(modelMatrixElements)*4, floatsPtr(modelMatrixElements), gl.STATIC_DRAW)
 
 	gl.TexBuffer(gl.TEXTURE_BUFFER, gl.R32F, modelBuffer)
 	gl.BindTexture(gl.TEXTURE_BUFFER, 0)
@@ -138,7 +223,16 @@ func ArrayToTexture(modelMatrixElements []float32) *TextureAndBufferIds {
 
 func UpdateArrayToTexture(modelBuffer uint32, modelMatrixElements []float32) {
 	gl.BindBuffer(gl.TEXTURE_BUFFER, modelBuffer)
-	gl.BufferData(gl.TEXTURE_BUFFER, len(modelMatrixElements)*4, gl.Ptr(modelMatrixElements), gl.STATIC_DRAW)
+	gl.BufferData(gl.TEXTURE_BUFFER, len(modelMatrixElements)*4, floatsPtr(modelMatrixElements), gl.STATIC_DRAW)
 	gl.TexBuffer(gl.TEXTURE_BUFFER, gl.R32F, modelBuffer)
 	gl.BindBuffer(gl.TEXTURE_BUFFER, 0)
 }
+
+// floatsPtr is gl.Ptr for float slices that may be empty, e.g. the morph buffers of a mesh without targets
+func floatsPtr(elements []float32) unsafe.Pointer {
+	if len(elements) == 0 {
+		return nil
+	}
+
+	return gl.Ptr(elements)
+}
@@ -0,0 +1,180 @@
+package animation
+
+import (
+	"fmt"
+	"sort"
+)
+
+// number of floats stored per vertex per target in the delta buffer: xyz position delta + xyz normal delta
+const morphDeltaStride = 6
+
+type MorphTarget struct {
+	Name   string       `json:"name"`
+	Deltas []MorphDelta `json:"deltas"`
+}
+
+// MorphDelta is the offset a target applies to a single coordinate; coordinates without a delta are left untouched.
+type MorphDelta struct {
+	Index    int       `json:"index"`
+	Position []float32 `json:"xyz"`
+	Normal   []float32 `json:"normal"`
+}
+
+// MorphDeltaBuffer lays the targets' deltas out densely as target -> vertex -> (xyz position, xyz normal),
+// ready to be uploaded as a texture buffer and indexed in the shader with gl_VertexID.
+func (m *Mesh) MorphDeltaBuffer() []float32 {
+	vertexCount := len(m.Coordinates)
+	buffer := make([]float32, len(m.MorphTargets)*vertexCount*morphDeltaStride)
+
+	for t, target := range m.MorphTargets {
+		for _, delta := range target.Deltas {
+			if delta.Index < 0 || delta.Index >= vertexCount {
+				continue
+			}
+
+			offset := (t*vertexCount + delta.Index) * morphDeltaStride
+			copy(buffer[offset:offset+3], delta.Position)
+			copy(buffer[offset+3:offset+6], delta.Normal)
+		}
+	}
+
+	return buffer
+}
+
+// VertexNormals averages the normals of the triangles around each vertex, weighted by their area, giving xyz per
+// vertex. positions holds stride floats per vertex starting with xyz, so MESH chunk points can be passed as they are.
+func VertexNormals(positions []float32, stride int, indices []uint32) []float32 {
+	vertexCount := len(positions) / stride
+	sums := make([]Vector3f, vertexCount)
+
+	position := func(i uint32) Vector3f {
+		return Vector3f{positions[int(i)*stride], positions[int(i)*stride+1], positions[int(i)*stride+2]}
+	}
+
+	for t := 0; t+2 < len(indices); t += 3 {
+		a, b, c := indices[t], indices[t+1], indices[t+2]
+
+		if int(a) >= vertexCount || int(b) >= vertexCount || int(c) >= vertexCount {
+			continue
+		}
+
+		// the cross product's length is twice the triangle's area
+		normal := position(b).Sub(position(a)).Cross(position(c).Sub(position(a)))
+
+		for _, i := range []uint32{a, b, c} {
+			sums[i] = sums[i].Add(normal)
+		}
+	}
+
+	normals := make([]float32, 0, vertexCount*3)
+
+	for _, sum := range sums {
+		n := sum.Normalize()
+		normals = append(normals, n.X, n.Y, n.Z)
+	}
+
+	return normals
+}
+
+// MorphWeights holds the current weight of each of a mesh's morph targets, in the same order as the delta buffer.
+type MorphWeights struct {
+	names   []string
+	indices map[string]int
+	weights []float32
+}
+
+func NewMorphWeights(mesh *Mesh) *MorphWeights {
+	names := []string{}
+
+	for _, target := range mesh.MorphTargets {
+		names = append(names, target.Name)
+	}
+
+	return NewNamedMorphWeights(names)
+}
+
+// NewNamedMorphWeights creates zero weights for targets given by name, such as those of an asset file's MRPH chunk.
+func NewNamedMorphWeights(names []string) *MorphWeights {
+	w := &MorphWeights{
+		names:   []string{},
+		indices: map[string]int{},
+		weights: make([]float32, len(names)),
+	}
+
+	for i, name := range names {
+		w.names = append(w.names, name)
+		w.indices[name] = i
+	}
+
+	return w
+}
+
+func (w *MorphWeights) Set(name string, weight float32) error {
+	i, present := w.indices[name]
+
+	if !present {
+		return fmt.Errorf("morph target %q does not exist", name)
+	}
+
+	w.weights[i] = weight
+
+	return nil
+}
+
+func (w *MorphWeights) Get(name string) float32 {
+	i, present := w.indices[name]
+
+	if !present {
+		return 0
+	}
+
+	return w.weights[i]
+}
+
+func (w *MorphWeights) Names() []string {
+	return w.names
+}
+
+// Values returns the weights in target order, for uploading to the morph weight texture buffer.
+func (w *MorphWeights) Values() []float32 {
+	return w.weights
+}
+
+// Sample linearly interpolates between the keys either side of frame, clamping outside the keyed range.
+func (m *IntToFloat32Map) Sample(frame float32) float32 {
+	keys := m.keys
+
+	if len(keys) == 0 {
+		return 0
+	}
+
+	i := sort.Search(len(keys), func(i int) bool {
+		return float32(keys[i]) >= frame
+	})
+
+	if i == 0 {
+		return m.values[keys[0]]
+	}
+
+	if i == len(keys) {
+		return m.values[keys[len(keys)-1]]
+	}
+
+	a := keys[i-1]
+	b := keys[i]
+	f := (frame - float32(a)) / float32(b-a)
+
+	return m.values[a] + (m.values[b]-m.values[a])*f
+}
+
+// MorphAnimation is a set of weight tracks keyed by morph target name, in the target -> frame -> weight
+// shape of the exported morph weight data.
+type MorphAnimation map[string]*IntToFloat32Map
+
+// Apply samples every track at frame and writes the result into weights; tracks for targets the mesh
+// does not have are ignored.
+func (a MorphAnimation) Apply(frame float32, weights *MorphWeights) {
+	for name, track := range a {
+		weights.Set(name, track.Sample(frame))
+	}
+}
@@ -0,0 +1,48 @@
+package animation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVertexNormals(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []float32
+		stride    int
+		indices   []uint32
+		normals   []float32
+	}{
+		{"triangle", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, 3, []uint32{0, 1, 2},
+			[]float32{0, 0, 1, 0, 0, 1, 0, 0, 1}},
+		{"winding flips the normal", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, 3, []uint32{0, 2, 1},
+			[]float32{0, 0, -1, 0, 0, -1, 0, 0, -1}},
+		{"stride skips the other attributes", []float32{0, 0, 0, 9, 9, 1, 0, 0, 9, 9, 0, 0, 1, 9, 9}, 5, []uint32{0, 1, 2},
+			[]float32{0, -1, 0, 0, -1, 0, 0, -1, 0}},
+		// a right angled fold, the shared edge gets the average of both faces
+		{"shared edge", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 1}, 3, []uint32{0, 1, 2, 0, 3, 1},
+			[]float32{0, 0.70710677, 0.70710677, 0, 0.70710677, 0.70710677, 0, 0, 1, 0, 1, 0}},
+		{"larger faces weigh more", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 3}, 3, []uint32{0, 1, 2, 0, 3, 1},
+			[]float32{0, 0.94868326, 0.31622776, 0, 0.94868326, 0.31622776, 0, 0, 1, 0, 1, 0}},
+		{"unused vertex", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0, 5, 5, 5}, 3, []uint32{0, 1, 2},
+			[]float32{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 0}},
+		{"out of range triangle skipped", []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}, 3, []uint32{0, 1, 2, 0, 1, 7},
+			[]float32{0, 0, 1, 0, 0, 1, 0, 0, 1}},
+	}
+
+	for _, test := range tests {
+		normals := VertexNormals(test.positions, test.stride, test.indices)
+
+		if len(normals) != len(test.normals) {
+			t.Errorf("%s: got %d floats, want %d", test.name, len(normals), len(test.normals))
+			continue
+		}
+
+		for i := range normals {
+			if math.Abs(float64(normals[i]-test.normals[i])) > 1e-6 {
+				t.Errorf("%s: got %v, want %v", test.name, normals, test.normals)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package animation
+
+import "fmt"
+
+// Pose holds a local transform for every bone of an armature, relative to the bone's rest pose in the same
+// way as the exported keyframes, so an empty pose is the rest pose.
+type Pose struct {
+	Armature *Armature
+	Locals   map[string]Transform
+}
+
+func NewPose(armature *Armature) *Pose {
+	p := &Pose{
+		Armature: armature,
+		Locals:   map[string]Transform{},
+	}
+
+	for boneName := range armature.Bones {
+		p.Locals[boneName] = IdentityTransform()
+	}
+
+	return p
+}
+
+func (p *Pose) SetLocal(boneName string, t Transform) error {
+	if p.Armature.Bones[boneName] == nil {
+		return fmt.Errorf("bone %q does not exist in armature %q", boneName, p.Armature.Name)
+	}
+
+	p.Locals[boneName] = t
+
+	return nil
+}
+
+func (p *Pose) SetLocalMatrix(boneName string, m *Matrix4f) error {
+	return p.SetLocal(boneName, DecomposeMatrix(m))
+}
+
+func (p *Pose) Local(boneName string) Transform {
+	t, present := p.Locals[boneName]
+
+	if !present {
+		return IdentityTransform()
+	}
+
+	return t
+}
+
+func (p *Pose) Copy() *Pose {
+	c := &Pose{
+		Armature: p.Armature,
+		Locals:   map[string]Transform{},
+	}
+
+	for boneName, t := range p.Locals {
+		c.Locals[boneName] = t
+	}
+
+	return c
+}
+
+// Blend moves every bone of the pose towards other by weight, 0 leaving it unchanged and 1 matching other.
+func (p *Pose) Blend(other *Pose, weight float32) {
+	for boneName := range p.Armature.Bones {
+		p.Locals[boneName] = p.Local(boneName).Lerp(other.Local(boneName), weight)
+	}
+}
+
+func BlendPoses(a, b *Pose, weight float32) *Pose {
+	p := a.Copy()
+	p.Blend(b, weight)
+	return p
+}
+
+// SetFromKeyframes copies the keyed frame of a clip, in the bone -> frame -> matrix shape of the export,
+// into the pose; bones without a key at that frame are reset to their rest pose.
+func (p *Pose) SetFromKeyframes(keyframes map[string]*IntToMatrix4fMap, frame int) {
+	for boneName := range p.Armature.Bones {
+		p.Locals[boneName] = IdentityTransform()
+
+		if frames := keyframes[boneName]; frames != nil {
+			if m := frames.Get(frame); m != nil {
+				p.Locals[boneName] = DecomposeMatrix(m)
+			}
+		}
+	}
+}
+
+// SetFromCompressed samples a compressed clip at a (possibly fractional) frame into the pose.
+func (p *Pose) SetFromCompressed(clip *CompressedClip, frame float32) {
+	for boneName := range p.Armature.Bones {
+		p.Locals[boneName] = IdentityTransform()
+
+		if track := clip.Tracks[boneName]; track != nil {
+			p.Locals[boneName] = track.Sample(frame)
+		}
+	}
+}
+
+// ModelMatrix returns the bone's matrix in model space, the same matrix SkinnedAnimation stores in BindMatrices.
+func (p *Pose) ModelMatrix(boneName string) *Matrix4f {
+	return p.modelMatrix(boneName, map[string]*Matrix4f{}, 0)
+}
+
+func (p *Pose) ModelMatrices() map[string]*Matrix4f {
+	matrices := map[string]*Matrix4f{}
+
+	for boneName := range p.Armature.Bones {
+		p.modelMatrix(boneName, matrices, 0)
+	}
+
+	return matrices
+}
+
+func (p *Pose) modelMatrix(boneName string, cache map[string]*Matrix4f, depth int) *Matrix4f {
+	if m, present := cache[boneName]; present {
+		return m
+	}
+
+	bone := p.Armature.Bones[boneName]
+
+	if bone == nil {
+		return nil
+	}
+
+	local := p.Local(boneName).Matrix()
+	parent := p.Armature.Bones[bone.ParentName]
+
+	var m *Matrix4f
+
+	// the depth check stops a parent cycle in broken data from recursing forever
+	if parent == nil || depth > len(p.Armature.Bones) {
+		m = bone.MatrixLocal.Mul(local)
+	} else {
+		parentMatrix := p.modelMatrix(parent.Name, cache, depth+1)
+		m = parentMatrix.Mul(parent.MatrixLocalInverted.Mul(bone.MatrixLocal).Mul(local))
+	}
+
+	cache[boneName] = m
+
+	return m
+}
+
+// Palette flattens the model space matrices of the given bones, in that order, into the layout the skinning
+// shader reads from its bone matrix texture buffer.
+func (p *Pose) Palette(boneNames []string) []float32 {
+	matrices := p.ModelMatrices()
+	palette := make([]float32, 0, len(boneNames)*16)
+
+	for _, boneName := range boneNames {
+		m := matrices[boneName]
+
+		if m == nil {
+			m = &Matrix4f{M00: 1, M11: 1, M22: 1, M33: 1}
+		}
+
+		palette = append(palette, m.Get1D()...)
+	}
+
+	return palette
+}
+
+// UploadPalette writes the pose's palette into an existing texture buffer created with ArrayToTexture.
+func (p *Pose) UploadPalette(buffer *TextureAndBufferIds, boneNames []string) {
+	UpdateArrayToTexture(buffer.BufferID, p.Palette(boneNames))
+}
+
+// SamplePose returns the pose of the animation at one of its keyed frames.
+func (a *SkinnedAnimation) SamplePose(frame int) *Pose {
+	p := NewPose(a.Armature)
+	p.SetFromKeyframes(a.AllBindPoseTransformations, frame)
+	return p
+}
@@ -0,0 +1,464 @@
+package animation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// The exporter writes three JSON files, and a fourth for meshes with animated morph targets. This is version SchemaVersion of their shape; fields not listed are
+// ignored so the exporter can add data without breaking older readers. The files do not record the version,
+// as their top level keys are mesh and armature names, so the validate command is told it with -schema.
+//
+// Vertex data, an object of meshes by name:
+//
+//	indices       required, integers into coordinates, a multiple of 3 long (triangles)
+//	coordinates   required, objects of
+//	                index        required integer, the coordinate's position in the array
+//	                xyz          required, 3 numbers
+//	                uvs          required, 2 numbers
+//	                skin         required, object of bone name to weight, empty for unskinned meshes
+//	                totalWeight  required number, the sum of the skin weights
+//	morphTargets  optional, objects of
+//	                name         required string
+//	                deltas       required, objects of index (integer into coordinates), xyz (3 numbers) and
+//	                             optional normal (3 numbers)
+//
+// Armature data, an object of armatures by name:
+//
+//	name          required string, the armature's key
+//	matrix_world  optional, 16 numbers
+//	bones         required, object of bones by name, each with
+//	                name                   required string, the bone's key
+//	                parentName             optional string, missing or empty for root bones
+//	                matrix_local           required, 16 numbers
+//	                matrix_local_inverted  required, 16 numbers
+//
+// Animation matrices, an object of mesh name to action name to bone name to frames, each frame an integer key
+// holding 16 numbers relative to the bone's rest pose.
+//
+// Morph weights, an object of mesh name to action name to morph target name to frames, each frame an integer
+// key holding the target's weight. Frames between keys are interpolated.
+//
+// Matrices are row major, in Matrix4f.Get1D order.
+
+// SchemaVersion is the version of the shape above. It changes whenever a field is added, removed or changes
+// meaning, and the validators only check files against this version. Version 2 added the morph delta normals
+// and the morph weights file.
+const SchemaVersion = 2
+
+const (
+	InvalidJSON     ValidationErrorKind = "invalid JSON"
+	MissingField    ValidationErrorKind = "missing field"
+	WrongType       ValidationErrorKind = "wrong type"
+	WrongLength     ValidationErrorKind = "wrong length"
+	IndexOutOfRange ValidationErrorKind = "index out of range"
+	NameMismatch    ValidationErrorKind = "name does not match key"
+)
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := []string{}
+
+	for k := range object {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (errs *ValidationErrors) object(path string, v interface{}) (map[string]interface{}, bool) {
+	object, ok := v.(map[string]interface{})
+
+	if !ok {
+		errs.add(WrongType, path, "expected an object, got %s", jsonType(v))
+	}
+
+	return object, ok
+}
+
+func (errs *ValidationErrors) array(path string, v interface{}) ([]interface{}, bool) {
+	array, ok := v.([]interface{})
+
+	if !ok {
+		errs.add(WrongType, path, "expected an array, got %s", jsonType(v))
+	}
+
+	return array, ok
+}
+
+func (errs *ValidationErrors) str(path string, v interface{}) (string, bool) {
+	s, ok := v.(string)
+
+	if !ok {
+		errs.add(WrongType, path, "expected a string, got %s", jsonType(v))
+	}
+
+	return s, ok
+}
+
+func (errs *ValidationErrors) number(path string, v interface{}) bool {
+	if _, ok := v.(float64); !ok {
+		errs.add(WrongType, path, "expected a number, got %s", jsonType(v))
+		return false
+	}
+
+	return true
+}
+
+func (errs *ValidationErrors) integer(path string, v interface{}) (int, bool) {
+	n, ok := v.(float64)
+
+	if !ok || n != math.Trunc(n) {
+		errs.add(WrongType, path, "expected an integer, got %s", jsonType(v))
+		return 0, false
+	}
+
+	return int(n), true
+}
+
+// index checks an integer that refers into an array of count elements.
+func (errs *ValidationErrors) index(path string, v interface{}, count int) {
+	i, ok := errs.integer(path, v)
+
+	if ok && (i < 0 || i >= count) {
+		errs.add(IndexOutOfRange, path, "%d is outside 0-%d", i, count-1)
+	}
+}
+
+// numbers checks an array of exactly length numbers.
+func (errs *ValidationErrors) numbers(path string, v interface{}, length int) {
+	array, ok := errs.array(path, v)
+
+	if !ok {
+		return
+	}
+
+	if len(array) != length {
+		errs.add(WrongLength, path, "expected %d numbers, got %d", length, len(array))
+	}
+
+	for i, element := range array {
+		errs.number(fmt.Sprintf("%s[%d]", path, i), element)
+	}
+}
+
+func (errs *ValidationErrors) required(path string, object map[string]interface{}, field string) (interface{}, bool) {
+	v, present := object[field]
+
+	if !present {
+		errs.add(MissingField, path+"."+field, "field is required")
+	}
+
+	return v, present
+}
+
+func jsonType(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case string:
+		return "a string"
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case []interface{}:
+		return "an array"
+	}
+
+	return "an object"
+}
+
+func decodeForSchema(data []byte) (map[string]interface{}, ValidationErrors) {
+	errs := ValidationErrors{}
+
+	var v interface{}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		errs.add(InvalidJSON, "document", "%s", err.Error())
+		return nil, errs
+	}
+
+	object, _ := errs.object("document", v)
+
+	return object, errs
+}
+
+// ValidateVertexDataSchema checks exported vertex data against the schema and reports every problem, including
+// indices outside the coordinates and coordinates without exactly 3 positions and 2 uvs.
+func ValidateVertexDataSchema(data []byte) ValidationErrors {
+	meshes, errs := decodeForSchema(data)
+
+	for _, meshName := range sortedKeys(meshes) {
+		path := fmt.Sprintf("meshes[%s]", meshName)
+		mesh, ok := errs.object(path, meshes[meshName])
+
+		if !ok {
+			continue
+		}
+
+		coordinateCount := 0
+
+		if v, ok := errs.required(path, mesh, "coordinates"); ok {
+			if coordinates, ok := errs.array(path+".coordinates", v); ok {
+				coordinateCount = len(coordinates)
+
+				for i, c := range coordinates {
+					errs.coordinate(fmt.Sprintf("%s.coordinates[%d]", path, i), c, i)
+				}
+			}
+		}
+
+		if v, ok := errs.required(path, mesh, "indices"); ok {
+			if indices, ok := errs.array(path+".indices", v); ok {
+				if len(indices)%3 != 0 {
+					errs.add(WrongLength, path+".indices", "%d indices do not make whole triangles", len(indices))
+				}
+
+				for i, index := range indices {
+					errs.index(fmt.Sprintf("%s.indices[%d]", path, i), index, coordinateCount)
+				}
+			}
+		}
+
+		if v, present := mesh["morphTargets"]; present {
+			if targets, ok := errs.array(path+".morphTargets", v); ok {
+				for i, t := range targets {
+					errs.morphTarget(fmt.Sprintf("%s.morphTargets[%d]", path, i), t, coordinateCount)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func (errs *ValidationErrors) coordinate(path string, v interface{}, position int) {
+	coordinate, ok := errs.object(path, v)
+
+	if !ok {
+		return
+	}
+
+	if v, ok := errs.required(path, coordinate, "index"); ok {
+		if index, ok := errs.integer(path+".index", v); ok && index != position {
+			errs.add(IndexOutOfRange, path+".index", "coordinate %d has index %d", position, index)
+		}
+	}
+
+	if v, ok := errs.required(path, coordinate, "xyz"); ok {
+		errs.numbers(path+".xyz", v, 3)
+	}
+
+	if v, ok := errs.required(path, coordinate, "uvs"); ok {
+		errs.numbers(path+".uvs", v, 2)
+	}
+
+	if v, ok := errs.required(path, coordinate, "skin"); ok {
+		if skin, ok := errs.object(path+".skin", v); ok {
+			for _, boneName := range sortedKeys(skin) {
+				errs.number(fmt.Sprintf("%s.skin[%s]", path, boneName), skin[boneName])
+			}
+		}
+	}
+
+	if v, ok := errs.required(path, coordinate, "totalWeight"); ok {
+		errs.number(path+".totalWeight", v)
+	}
+}
+
+func (errs *ValidationErrors) morphTarget(path string, v interface{}, coordinateCount int) {
+	target, ok := errs.object(path, v)
+
+	if !ok {
+		return
+	}
+
+	if v, ok := errs.required(path, target, "name"); ok {
+		errs.str(path+".name", v)
+	}
+
+	if v, ok := errs.required(path, target, "deltas"); ok {
+		if deltas, ok := errs.array(path+".deltas", v); ok {
+			for i, d := range deltas {
+				deltaPath := fmt.Sprintf("%s.deltas[%d]", path, i)
+				delta, ok := errs.object(deltaPath, d)
+
+				if !ok {
+					continue
+				}
+
+				if v, ok := errs.required(deltaPath, delta, "index"); ok {
+					errs.index(deltaPath+".index", v, coordinateCount)
+				}
+
+				if v, ok := errs.required(deltaPath, delta, "xyz"); ok {
+					errs.numbers(deltaPath+".xyz", v, 3)
+				}
+
+				if v, present := delta["normal"]; present {
+					errs.numbers(deltaPath+".normal", v, 3)
+				}
+			}
+		}
+	}
+}
+
+// ValidateArmatureDataSchema checks exported armature data against the schema and reports every problem.
+func ValidateArmatureDataSchema(data []byte) ValidationErrors {
+	armatures, errs := decodeForSchema(data)
+
+	for _, armatureName := range sortedKeys(armatures) {
+		path := fmt.Sprintf("armatures[%s]", armatureName)
+		armature, ok := errs.object(path, armatures[armatureName])
+
+		if !ok {
+			continue
+		}
+
+		errs.name(path, armature, armatureName)
+
+		if v, present := armature["matrix_world"]; present {
+			errs.numbers(path+".matrix_world", v, 16)
+		}
+
+		v, ok := errs.required(path, armature, "bones")
+
+		if !ok {
+			continue
+		}
+
+		bones, ok := errs.object(path+".bones", v)
+
+		if !ok {
+			continue
+		}
+
+		for _, boneName := range sortedKeys(bones) {
+			bonePath := fmt.Sprintf("%s.bones[%s]", path, boneName)
+			bone, ok := errs.object(bonePath, bones[boneName])
+
+			if !ok {
+				continue
+			}
+
+			errs.name(bonePath, bone, boneName)
+
+			if v, present := bone["parentName"]; present {
+				errs.str(bonePath+".parentName", v)
+			}
+
+			if v, ok := errs.required(bonePath, bone, "matrix_local"); ok {
+				errs.numbers(bonePath+".matrix_local", v, 16)
+			}
+
+			if v, ok := errs.required(bonePath, bone, "matrix_local_inverted"); ok {
+				errs.numbers(bonePath+".matrix_local_inverted", v, 16)
+			}
+		}
+	}
+
+	return errs
+}
+
+// name checks the required name field of an object keyed by that name.
+func (errs *ValidationErrors) name(path string, object map[string]interface{}, key string) {
+	v, ok := errs.required(path, object, "name")
+
+	if !ok {
+		return
+	}
+
+	if name, ok := errs.str(path+".name", v); ok && name != key {
+		errs.add(NameMismatch, path+".name", "%q is stored under %q", name, key)
+	}
+}
+
+// ValidateAnimationMatricesSchema checks exported animation matrices against the schema and reports every
+// problem.
+func ValidateAnimationMatricesSchema(data []byte) ValidationErrors {
+	meshes, errs := decodeForSchema(data)
+
+	for _, meshName := range sortedKeys(meshes) {
+		actions, ok := errs.object(fmt.Sprintf("animations[%s]", meshName), meshes[meshName])
+
+		if !ok {
+			continue
+		}
+
+		for _, action := range sortedKeys(actions) {
+			bones, ok := errs.object(fmt.Sprintf("animations[%s][%s]", meshName, action), actions[action])
+
+			if !ok {
+				continue
+			}
+
+			for _, boneName := range sortedKeys(bones) {
+				path := fmt.Sprintf("animations[%s][%s][%s]", meshName, action, boneName)
+				frames, ok := errs.object(path, bones[boneName])
+
+				if !ok {
+					continue
+				}
+
+				for _, frame := range sortedKeys(frames) {
+					framePath := fmt.Sprintf("%s[%s]", path, frame)
+
+					if _, err := strconv.Atoi(frame); err != nil {
+						errs.add(WrongType, framePath, "frame %q is not an integer", frame)
+					}
+
+					errs.numbers(framePath, frames[frame], 16)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// ValidateMorphWeightsSchema checks exported morph weights against the schema and reports every problem.
+func ValidateMorphWeightsSchema(data []byte) ValidationErrors {
+	meshes, errs := decodeForSchema(data)
+
+	for _, meshName := range sortedKeys(meshes) {
+		actions, ok := errs.object(fmt.Sprintf("morphWeights[%s]", meshName), meshes[meshName])
+
+		if !ok {
+			continue
+		}
+
+		for _, action := range sortedKeys(actions) {
+			targets, ok := errs.object(fmt.Sprintf("morphWeights[%s][%s]", meshName, action), actions[action])
+
+			if !ok {
+				continue
+			}
+
+			for _, target := range sortedKeys(targets) {
+				path := fmt.Sprintf("morphWeights[%s][%s][%s]", meshName, action, target)
+				frames, ok := errs.object(path, targets[target])
+
+				if !ok {
+					continue
+				}
+
+				for _, frame := range sortedKeys(frames) {
+					framePath := fmt.Sprintf("%s[%s]", path, frame)
+
+					if _, err := strconv.Atoi(frame); err != nil {
+						errs.add(WrongType, framePath, "frame %q is not an integer", frame)
+					}
+
+					errs.number(framePath, frames[frame])
+				}
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,141 @@
+package animation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Skeleton is a flat, ordered view of an Armature. Bones are ordered depth first with parents before their
+// children and siblings sorted by name, so the index of a bone is the same every time the armature is loaded.
+type Skeleton struct {
+	Name                string      `json:"name"`
+	Names               []string    `json:"names"`
+	ParentIndices       []int       `json:"parents"` // -1 for root bones
+	MatrixLocal         []*Matrix4f `json:"matrix_local"`
+	MatrixLocalInverted []*Matrix4f `json:"matrix_local_inverted"`
+
+	indices map[string]int
+}
+
+func NewSkeleton(armature *Armature) (*Skeleton, error) {
+	if err := ValidateArmature(armature).Err(); err != nil {
+		return nil, err
+	}
+
+	children := map[string][]string{}
+
+	for _, boneName := range sortedBoneNames(armature) {
+		parentName := armature.Bones[boneName].ParentName
+		children[parentName] = append(children[parentName], boneName)
+	}
+
+	s := &Skeleton{
+		Name:                armature.Name,
+		Names:               []string{},
+		ParentIndices:       []int{},
+		MatrixLocal:         []*Matrix4f{},
+		MatrixLocalInverted: []*Matrix4f{},
+		indices:             map[string]int{},
+	}
+
+	var visit func(boneName string, parentIndex int)
+	visit = func(boneName string, parentIndex int) {
+		bone := armature.Bones[boneName]
+
+		s.indices[boneName] = len(s.Names)
+		s.Names = append(s.Names, boneName)
+		s.ParentIndices = append(s.ParentIndices, parentIndex)
+		s.MatrixLocal = append(s.MatrixLocal, bone.MatrixLocal)
+		s.MatrixLocalInverted = append(s.MatrixLocalInverted, bone.MatrixLocalInverted)
+
+		index := s.indices[boneName]
+
+		for _, child := range children[boneName] {
+			visit(child, index)
+		}
+	}
+
+	// root bones are the children of the empty parent name
+	for _, root := range children[""] {
+		visit(root, -1)
+	}
+
+	return s, nil
+}
+
+func (s *Skeleton) Len() int {
+	return len(s.Names)
+}
+
+func (s *Skeleton) Index(boneName string) (int, bool) {
+	i, present := s.indices[boneName]
+	return i, present
+}
+
+// Parent returns the index of the bone's parent, or -1 for a root bone.
+func (s *Skeleton) Parent(index int) int {
+	return s.ParentIndices[index]
+}
+
+// Armature rebuilds the map based armature the skeleton was created from.
+func (s *Skeleton) Armature() *Armature {
+	a := &Armature{
+		Name:  s.Name,
+		Bones: map[string]*Bone{},
+	}
+
+	for i, boneName := range s.Names {
+		bone := &Bone{
+			Name:                boneName,
+			MatrixLocal:         s.MatrixLocal[i],
+			MatrixLocalInverted: s.MatrixLocalInverted[i],
+		}
+
+		if s.ParentIndices[i] >= 0 {
+			bone.ParentName = s.Names[s.ParentIndices[i]]
+		}
+
+		a.Bones[boneName] = bone
+	}
+
+	return a
+}
+
+func (s *Skeleton) UnmarshalJSON(b []byte) error {
+	// the alias drops the methods so decoding into it does not recurse back into UnmarshalJSON
+	type skeleton Skeleton
+
+	var x skeleton
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	count := len(x.Names)
+
+	if len(x.ParentIndices) != count || len(x.MatrixLocal) != count || len(x.MatrixLocalInverted) != count {
+		return fmt.Errorf("skeleton %q: names, parents and matrices must all have %d entries", x.Name, count)
+	}
+
+	x.indices = map[string]int{}
+
+	for i, boneName := range x.Names {
+		if _, present := x.indices[boneName]; present {
+			return fmt.Errorf("skeleton %q: bone %q appears more than once", x.Name, boneName)
+		}
+
+		if x.ParentIndices[i] < -1 {
+			return fmt.Errorf("skeleton %q: bone %q has parent index %d, roots use -1", x.Name, boneName, x.ParentIndices[i])
+		}
+
+		if x.ParentIndices[i] >= i {
+			return fmt.Errorf("skeleton %q: bone %q must come after its parent", x.Name, boneName)
+		}
+
+		x.indices[boneName] = i
+	}
+
+	*s = Skeleton(x)
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package animation
+
+import "fmt"
+
+const DefaultSpringTimeStep = 1.0 / 60.0
+
+type SpringBoneSettings struct {
+	Stiffness float32  // how strongly each joint is pulled back towards its animated position
+	Damping   float32  // fraction of velocity lost every step, 0 keeps all velocity, 1 removes it
+	Gravity   Vector3f // model space acceleration
+	Radius    float32  // collision radius of each joint
+}
+
+func DefaultSpringBoneSettings() SpringBoneSettings {
+	return SpringBoneSettings{
+		Stiffness: 100,
+		Damping:   0.1,
+		Gravity:   Vector3f{0, -9.8, 0},
+		Radius:    0.02,
+	}
+}
+
+// SphereCollider is a sphere that follows a bone and pushes spring joints out of it.
+type SphereCollider struct {
+	BoneName string
+	Offset   Vector3f // bone space
+	Radius   float32
+}
+
+type springJoint struct {
+	position         Vector3f
+	previousPosition Vector3f
+}
+
+type SpringChain struct {
+	BoneNames []string
+	Settings  SpringBoneSettings
+
+	// one joint per bone except the last, tracking the tail of that bone (the head of the next)
+	joints []springJoint
+	stale  bool // the joints are snapped to the animated pose on the next update the chain is posed in
+}
+
+// SpringBoneSimulation adds secondary motion to bone chains on top of a sampled pose. It advances in fixed
+// steps so the same sequence of poses and frame times always produces the same result.
+type SpringBoneSimulation struct {
+	Armature  *Armature
+	Chains    []*SpringChain
+	Colliders []SphereCollider
+	TimeStep  float64
+
+	accumulator float64
+}
+
+func NewSpringBoneSimulation(armature *Armature) *SpringBoneSimulation {
+	return &SpringBoneSimulation{
+		Armature:  armature,
+		Chains:    []*SpringChain{},
+		Colliders: []SphereCollider{},
+		TimeStep:  DefaultSpringTimeStep,
+	}
+}
+
+// AddChain registers a chain of at least two bones, ordered from root to tip, where each bone is the parent of the next.
+func (s *SpringBoneSimulation) AddChain(boneNames []string, settings SpringBoneSettings) error {
+	if len(boneNames) < 2 {
+		return fmt.Errorf("spring chain needs at least two bones, got %d", len(boneNames))
+	}
+
+	for i, boneName := range boneNames {
+		bone := s.Armature.Bones[boneName]
+
+		if bone == nil {
+			return fmt.Errorf("spring chain bone %q does not exist in armature %q", boneName, s.Armature.Name)
+		}
+
+		if i > 0 && bone.ParentName != boneNames[i-1] {
+			return fmt.Errorf("spring chain bone %q is not a child of %q", boneName, boneNames[i-1])
+		}
+	}
+
+	s.Chains = append(s.Chains, &SpringChain{
+		BoneNames: boneNames,
+		Settings:  settings,
+		joints:    make([]springJoint, len(boneNames)-1),
+		stale:     true,
+	})
+
+	return nil
+}
+
+func (s *SpringBoneSimulation) AddCollider(collider SphereCollider) error {
+	if s.Armature.Bones[collider.BoneName] == nil {
+		return fmt.Errorf("collider bone %q does not exist in armature %q", collider.BoneName, s.Armature.Name)
+	}
+
+	s.Colliders = append(s.Colliders, collider)
+
+	return nil
+}
+
+// Reset snaps every joint back to the animated pose on the next update, e.g. after a teleport or when starting a replay.
+func (s *SpringBoneSimulation) Reset() {
+	s.accumulator = 0
+
+	for _, chain := range s.Chains {
+		chain.stale = true
+	}
+}
+
+// Update advances the simulation by elapsed seconds and returns a copy of pose, a map of bone name to model
+// space matrix such as SkinnedAnimation.FramePose, with the chains and their descendants replaced by the simulated result.
+// A chain with a bone that is missing from pose or has a singular matrix is skipped and keeps its animated pose.
+func (s *SpringBoneSimulation) Update(elapsed float64, pose map[string]*Matrix4f) map[string]*Matrix4f {
+	chains := []*SpringChain{}
+
+	for _, chain := range s.Chains {
+		if chainPosed(chain, pose) {
+			chains = append(chains, chain)
+		}
+	}
+
+	for _, chain := range chains {
+		if chain.stale {
+			s.resetChain(chain, pose)
+			chain.stale = false
+		}
+	}
+
+	s.accumulator += elapsed
+
+	for s.accumulator >= s.TimeStep {
+		for _, chain := range chains {
+			s.stepChain(chain, pose, float32(s.TimeStep))
+		}
+		s.accumulator -= s.TimeStep
+	}
+
+	return s.apply(chains, pose)
+}
+
+// chainPosed reports whether every bone of the chain has an invertible matrix in pose.
+func chainPosed(chain *SpringChain, pose map[string]*Matrix4f) bool {
+	for _, boneName := range chain.BoneNames {
+		if matrix := pose[boneName]; matrix == nil || matrix.Inverse() == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *SpringBoneSimulation) resetChain(chain *SpringChain, pose map[string]*Matrix4f) {
+	for i := range chain.joints {
+		tail := pose[chain.BoneNames[i+1]].Translation()
+		chain.joints[i] = springJoint{tail, tail}
+	}
+}
+
+func (s *SpringBoneSimulation) stepChain(chain *SpringChain, pose map[string]*Matrix4f, dt float32) {
+	settings := chain.Settings
+	base := pose[chain.BoneNames[0]]
+
+	for i := range chain.joints {
+		joint := &chain.joints[i]
+		animated := pose[chain.BoneNames[i]]
+		head := base.Translation()
+
+		// where the tail would be if the bone kept its animated rotation relative to its (simulated) parent
+		localTail := animated.Inverse().TransformPoint(pose[chain.BoneNames[i+1]].Translation())
+		target := base.TransformPoint(localTail)
+		length := localTail.Length()
+
+		velocity := joint.position.Sub(joint.previousPosition).Scale(1 - settings.Damping)
+		acceleration := target.Sub(joint.position).Scale(settings.Stiffness).Add(settings.Gravity)
+		next := joint.position.Add(velocity).Add(acceleration.Scale(dt * dt))
+
+		next = head.Add(next.Sub(head).Normalize().Scale(length))
+
+		for _, collider := range s.Colliders {
+			if pose[collider.BoneName] == nil {
+				continue
+			}
+
+			centre := pose[collider.BoneName].TransformPoint(collider.Offset)
+			offset := next.Sub(centre)
+			minimum := collider.Radius + settings.Radius
+
+			if offset.Length() < minimum {
+				next = centre.Add(offset.Normalize().Scale(minimum))
+				next = head.Add(next.Sub(head).Normalize().Scale(length))
+			}
+		}
+
+		joint.previousPosition = joint.position
+		joint.position = next
+
+		// the next bone inherits this one's correction before its own joint is stepped
+		base = aimBone(base, target, next).Mul(animated.Inverse()).Mul(pose[chain.BoneNames[i+1]])
+	}
+}
+
+// simulateChain returns the model space matrix of every bone in the chain, rotated so each bone points at its joint.
+func (s *SpringBoneSimulation) simulateChain(chain *SpringChain, pose map[string]*Matrix4f) []*Matrix4f {
+	simulated := make([]*Matrix4f, len(chain.BoneNames))
+	simulated[0] = pose[chain.BoneNames[0]]
+
+	for i, joint := range chain.joints {
+		animated := pose[chain.BoneNames[i]]
+		localTail := animated.Inverse().TransformPoint(pose[chain.BoneNames[i+1]].Translation())
+		target := simulated[i].TransformPoint(localTail)
+
+		simulated[i] = aimBone(simulated[i], target, joint.position)
+		simulated[i+1] = simulated[i].Mul(animated.Inverse()).Mul(pose[chain.BoneNames[i+1]])
+	}
+
+	return simulated
+}
+
+// aimBone rotates a bone matrix around its head so that the point target ends up in the direction of actual.
+func aimBone(bone *Matrix4f, target, actual Vector3f) *Matrix4f {
+	head := bone.Translation()
+	rotation := Transform{Vector3f{}, QuaternionFromTo(target.Sub(head), actual.Sub(head)), Vector3f{1, 1, 1}}.Matrix()
+
+	return NewTranslationMatrix(head).Mul(rotation).Mul(NewTranslationMatrix(head.Scale(-1))).Mul(bone)
+}
+
+// apply propagates the corrections made to the simulated chains down to every descendant bone.
+func (s *SpringBoneSimulation) apply(chains []*SpringChain, pose map[string]*Matrix4f) map[string]*Matrix4f {
+	corrections := map[string]*Matrix4f{}
+
+	for _, chain := range chains {
+		simulated := s.simulateChain(chain, pose)
+
+		for i, boneName := range chain.BoneNames {
+			corrections[boneName] = simulated[i].Mul(pose[boneName].Inverse())
+		}
+	}
+
+	result := map[string]*Matrix4f{}
+
+	for boneName, matrix := range pose {
+		correction := s.correction(boneName, corrections)
+
+		if correction == nil {
+			result[boneName] = matrix
+		} else {
+			result[boneName] = correction.Mul(matrix)
+		}
+	}
+
+	return result
+}
+
+func (s *SpringBoneSimulation) correction(boneName string, corrections map[string]*Matrix4f) *Matrix4f {
+	for bone := s.Armature.Bones[boneName]; bone != nil; bone = s.Armature.Bones[bone.ParentName] {
+		if correction, present := corrections[bone.Name]; present {
+			return correction
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,451 @@
+package animation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// FlatMesh is a mesh decoded straight into flat arrays. Vertex i's skin is SkinCounts[i] entries starting at
+// SkinOffsets[i] in SkinBones and SkinWeights, with bones referring to BoneNames.
+type FlatMesh struct {
+	Positions    []float32 // 3 per vertex
+	UVs          []float32 // 2 per vertex
+	TotalWeights []float32
+	Indices      []uint32
+	SkinCounts   []uint32
+	SkinOffsets  []uint32
+	SkinBones    []uint32
+	SkinWeights  []float32
+	BoneNames    []string
+
+	boneIndices map[string]uint32
+}
+
+func (m *FlatMesh) VertexCount() int {
+	return len(m.TotalWeights)
+}
+
+// SkinBuffer builds the (bone index, weight) pairs of the skin texture buffer, with weights normalised by each
+// vertex's total weight and bones indexed in skeleton order.
+func (m *FlatMesh) SkinBuffer(skeleton *Skeleton) ([]float32, error) {
+	skeletonIndices := make([]float32, len(m.BoneNames))
+
+	for i, boneName := range m.BoneNames {
+		index, present := skeleton.Index(boneName)
+
+		if !present {
+			return nil, fmt.Errorf("mesh is weighted to bone %q which is not in armature %q", boneName, skeleton.Name)
+		}
+
+		skeletonIndices[i] = float32(index)
+	}
+
+	buffer := make([]float32, 0, len(m.SkinWeights)*2)
+
+	for v, count := range m.SkinCounts {
+		offset := m.SkinOffsets[v]
+
+		for k := offset; k < offset+count; k++ {
+			weight := m.SkinWeights[k]
+
+			if m.TotalWeights[v] != 0 {
+				weight /= m.TotalWeights[v]
+			}
+
+			buffer = append(buffer, skeletonIndices[m.SkinBones[k]], weight)
+		}
+	}
+
+	return buffer, nil
+}
+
+func (m *FlatMesh) boneIndex(boneName string) uint32 {
+	index, present := m.boneIndices[boneName]
+
+	if !present {
+		index = uint32(len(m.BoneNames))
+		m.BoneNames = append(m.BoneNames, boneName)
+		m.boneIndices[boneName] = index
+	}
+
+	return index
+}
+
+// FlatTrack holds one bone's keyframes, 16 floats per frame in Matrix4f.Get1D order, sorted by frame.
+type FlatTrack struct {
+	Bone     string
+	Frames   []int
+	Matrices []float32
+}
+
+type FlatClip struct {
+	Tracks []*FlatTrack
+}
+
+// Keyframes converts the clip to the bone -> frame -> matrix maps NewSkinnedAnimation consumes.
+func (c *FlatClip) Keyframes() map[string]*IntToMatrix4fMap {
+	keyframes := map[string]*IntToMatrix4fMap{}
+
+	for _, track := range c.Tracks {
+		frames := NewIntToMatrix4fMap()
+
+		for i, frame := range track.Frames {
+			v := track.Matrices[i*16:]
+
+			frames.Set(frame, &Matrix4f{
+				v[0], v[1], v[2], v[3],
+				v[4], v[5], v[6], v[7],
+				v[8], v[9], v[10], v[11],
+				v[12], v[13], v[14], v[15],
+			})
+		}
+
+		keyframes[track.Bone] = frames
+	}
+
+	return keyframes
+}
+
+func (t *FlatTrack) Len() int {
+	return len(t.Frames)
+}
+
+func (t *FlatTrack) Less(i, j int) bool {
+	return t.Frames[i] < t.Frames[j]
+}
+
+func (t *FlatTrack) Swap(i, j int) {
+	t.Frames[i], t.Frames[j] = t.Frames[j], t.Frames[i]
+
+	for k := 0; k < 16; k++ {
+		t.Matrices[i*16+k], t.Matrices[j*16+k] = t.Matrices[j*16+k], t.Matrices[i*16+k]
+	}
+}
+
+type tokenReader struct {
+	dec     *json.Decoder
+	path    []string
+	scratch []float32
+	value   float64
+}
+
+func (t *tokenReader) errorf(format string, args ...interface{}) error {
+	path := ""
+
+	for _, p := range t.path {
+		path += "[" + p + "]"
+	}
+
+	return fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+func (t *tokenReader) delim(expected json.Delim) error {
+	token, err := t.dec.Token()
+
+	if err != nil {
+		return t.errorf("%s", err.Error())
+	}
+
+	if d, ok := token.(json.Delim); !ok || d != expected {
+		return t.errorf("expected %q, got %v", string(expected), token)
+	}
+
+	return nil
+}
+
+// key returns the next object key, or false at the end of the object.
+func (t *tokenReader) key() (string, bool, error) {
+	token, err := t.dec.Token()
+
+	if err != nil {
+		return "", false, t.errorf("%s", err.Error())
+	}
+
+	switch v := token.(type) {
+	case string:
+		return v, true, nil
+	case json.Delim:
+		if v == '}' {
+			return "", false, nil
+		}
+	}
+
+	return "", false, t.errorf("expected an object key, got %v", token)
+}
+
+func (t *tokenReader) number() (float64, error) {
+	if err := t.dec.Decode(&t.value); err != nil {
+		return 0, t.errorf("%s", err.Error())
+	}
+
+	return t.value, nil
+}
+
+// floats appends the numbers of an array to dst. Decoding the array as one value avoids boxing each number
+// into a token.
+func (t *tokenReader) floats(dst []float32) ([]float32, error) {
+	t.scratch = t.scratch[:0]
+
+	if err := t.dec.Decode(&t.scratch); err != nil {
+		return nil, t.errorf("%s", err.Error())
+	}
+
+	return append(dst, t.scratch...), nil
+}
+
+// skip reads past the next value, however deeply nested.
+func (t *tokenReader) skip() error {
+	depth := 0
+
+	for {
+		token, err := t.dec.Token()
+
+		if err != nil {
+			return t.errorf("%s", err.Error())
+		}
+
+		if d, ok := token.(json.Delim); ok {
+			switch d {
+			case '{', '[':
+				depth++
+			default:
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// objectEntries calls f for every key of an object, with the key on the error path.
+func (t *tokenReader) objectEntries(f func(key string) error) error {
+	if err := t.delim('{'); err != nil {
+		return err
+	}
+
+	for {
+		key, ok, err := t.key()
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return nil
+		}
+
+		t.path = append(t.path, key)
+		err = f(key)
+		t.path = t.path[:len(t.path)-1]
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// DecodeFlatMeshes decodes an exported vertex data file (mesh name -> mesh) token by token, without building
+// a Coordinate or skin map per vertex. Morph targets are skipped. Decoding takes about as long and allocates
+// about as much as json.Unmarshal; the saving is in the result, which holds no map per vertex and takes a
+// fraction of the memory of the Mesh it replaces.
+func DecodeFlatMeshes(r io.Reader) (map[string]*FlatMesh, error) {
+	t := &tokenReader{dec: json.NewDecoder(r)}
+	meshes := map[string]*FlatMesh{}
+
+	err := t.objectEntries(func(name string) error {
+		m := &FlatMesh{boneIndices: map[string]uint32{}}
+		meshes[name] = m
+
+		return t.objectEntries(func(field string) error {
+			switch field {
+			case "indices":
+				if err := t.dec.Decode(&m.Indices); err != nil {
+					return t.errorf("%s", err.Error())
+				}
+
+				return nil
+			case "coordinates":
+				if err := t.delim('['); err != nil {
+					return err
+				}
+
+				for i := 0; t.dec.More(); i++ {
+					t.path = append(t.path, strconv.Itoa(i))
+
+					if err := t.coordinate(m); err != nil {
+						return err
+					}
+
+					t.path = t.path[:len(t.path)-1]
+				}
+
+				return t.delim(']')
+			}
+
+			return t.skip()
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for name, m := range meshes {
+		for _, index := range m.Indices {
+			if int(index) >= m.VertexCount() {
+				return nil, fmt.Errorf("[%s][indices]: index %d is out of range for %d coordinates", name, index, m.VertexCount())
+			}
+		}
+	}
+
+	return meshes, nil
+}
+
+// coordinate reads one vertex. It walks the object itself instead of going through objectEntries, as closures
+// per vertex would cost more than the flat arrays save.
+func (t *tokenReader) coordinate(m *FlatMesh) error {
+	positions, uvs := len(m.Positions), len(m.UVs)
+	totalWeight := float32(0)
+
+	m.SkinOffsets = append(m.SkinOffsets, uint32(len(m.SkinWeights)))
+
+	if err := t.delim('{'); err != nil {
+		return err
+	}
+
+	for {
+		field, ok, err := t.key()
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			break
+		}
+
+		switch field {
+		case "xyz":
+			m.Positions, err = t.floats(m.Positions)
+		case "uvs":
+			m.UVs, err = t.floats(m.UVs)
+		case "totalWeight":
+			var v float64
+			v, err = t.number()
+			totalWeight = float32(v)
+		case "skin":
+			err = t.skin(m)
+		default:
+			err = t.skip()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(m.Positions)-positions != 3 {
+		return t.errorf("xyz must have 3 values, got %d", len(m.Positions)-positions)
+	}
+
+	if len(m.UVs)-uvs != 2 {
+		return t.errorf("uvs must have 2 values, got %d", len(m.UVs)-uvs)
+	}
+
+	m.TotalWeights = append(m.TotalWeights, totalWeight)
+	m.SkinCounts = append(m.SkinCounts, uint32(len(m.SkinWeights))-m.SkinOffsets[len(m.SkinOffsets)-1])
+
+	return nil
+}
+
+func (t *tokenReader) skin(m *FlatMesh) error {
+	if err := t.delim('{'); err != nil {
+		return err
+	}
+
+	for {
+		boneName, ok, err := t.key()
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return nil
+		}
+
+		v, err := t.number()
+
+		if err != nil {
+			return err
+		}
+
+		m.SkinBones = append(m.SkinBones, m.boneIndex(boneName))
+		m.SkinWeights = append(m.SkinWeights, float32(v))
+	}
+}
+
+// DecodeFlatAnimations decodes an exported animation matrices file (mesh -> action -> bone -> frame -> matrix)
+// token by token into one flat matrix array per bone.
+func DecodeFlatAnimations(r io.Reader) (map[string]map[string]*FlatClip, error) {
+	t := &tokenReader{dec: json.NewDecoder(r)}
+	animations := map[string]map[string]*FlatClip{}
+
+	err := t.objectEntries(func(mesh string) error {
+		animations[mesh] = map[string]*FlatClip{}
+
+		return t.objectEntries(func(action string) error {
+			clip := &FlatClip{Tracks: []*FlatTrack{}}
+			animations[mesh][action] = clip
+
+			return t.objectEntries(func(boneName string) error {
+				track := &FlatTrack{Bone: boneName}
+				clip.Tracks = append(clip.Tracks, track)
+
+				err := t.objectEntries(func(key string) error {
+					frame, err := strconv.Atoi(key)
+
+					if err != nil {
+						return t.errorf("frame %q is not an integer", key)
+					}
+
+					matrices := len(track.Matrices)
+
+					if track.Matrices, err = t.floats(track.Matrices); err != nil {
+						return err
+					}
+
+					if len(track.Matrices)-matrices != 16 {
+						return t.errorf("matrix must have 16 values, got %d", len(track.Matrices)-matrices)
+					}
+
+					track.Frames = append(track.Frames, frame)
+
+					return nil
+				})
+
+				if err != nil {
+					return err
+				}
+
+				// frames keep the file's order while decoding, IntToMatrix4fMap keeps them sorted
+				if !sort.IsSorted(track) {
+					sort.Sort(track)
+				}
+
+				return nil
+			})
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return animations, nil
+}
@@ -0,0 +1,180 @@
+package animation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/internal/trumpdata"
+	"testing"
+)
+
+func TestDecodeFlatMeshesMatchesUnmarshal(t *testing.T) {
+	data := trumpdata.Read(t, trumpdata.Vertices)
+
+	var want map[string]Mesh
+
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := DecodeFlatMeshes(bytes.NewReader(data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d meshes, want %d", len(got), len(want))
+	}
+
+	for name, mesh := range want {
+		flat := got[name]
+
+		if flat == nil {
+			t.Fatalf("mesh %q is missing", name)
+		}
+
+		if flat.VertexCount() != len(mesh.Coordinates) || len(flat.Indices) != len(mesh.Indices) {
+			t.Fatalf("mesh %q has %d vertices and %d indices, want %d and %d",
+				name, flat.VertexCount(), len(flat.Indices), len(mesh.Coordinates), len(mesh.Indices))
+		}
+
+		for i, index := range mesh.Indices {
+			if flat.Indices[i] != index {
+				t.Fatalf("mesh %q index %d is %d, want %d", name, i, flat.Indices[i], index)
+			}
+		}
+
+		for i, coordinate := range mesh.Coordinates {
+			if !equalFloats(flat.Positions[i*3:i*3+3], coordinate.Vertices) || !equalFloats(flat.UVs[i*2:i*2+2], coordinate.Textures) {
+				t.Fatalf("mesh %q coordinate %d is %v %v, want %v %v",
+					name, i, flat.Positions[i*3:i*3+3], flat.UVs[i*2:i*2+2], coordinate.Vertices, coordinate.Textures)
+			}
+
+			if flat.TotalWeights[i] != coordinate.TotalWeight || int(flat.SkinCounts[i]) != len(coordinate.Skin) {
+				t.Fatalf("mesh %q coordinate %d has total weight %g over %d bones, want %g over %d",
+					name, i, flat.TotalWeights[i], flat.SkinCounts[i], coordinate.TotalWeight, len(coordinate.Skin))
+			}
+
+			for k := flat.SkinOffsets[i]; k < flat.SkinOffsets[i]+flat.SkinCounts[i]; k++ {
+				boneName := flat.BoneNames[flat.SkinBones[k]]
+
+				if weight, present := coordinate.Skin[boneName]; !present || weight != flat.SkinWeights[k] {
+					t.Fatalf("mesh %q coordinate %d weight for %q is %g, want %g", name, i, boneName, flat.SkinWeights[k], weight)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeFlatAnimationsMatchesUnmarshal(t *testing.T) {
+	data := trumpdata.Read(t, trumpdata.Animation)
+
+	var want ExportedAnimations
+
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := DecodeFlatAnimations(bytes.NewReader(data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for mesh, actions := range want {
+		for action, bones := range actions {
+			clip := got[mesh][action]
+
+			if clip == nil {
+				t.Fatalf("action %q of mesh %q is missing", action, mesh)
+			}
+
+			keyframes := clip.Keyframes()
+
+			if len(keyframes) != len(bones) {
+				t.Fatalf("action %q has %d bones, want %d", action, len(keyframes), len(bones))
+			}
+
+			for boneName, frames := range bones {
+				flat := keyframes[boneName]
+
+				if flat == nil || len(flat.Keys()) != len(frames.Keys()) {
+					t.Fatalf("action %q bone %q has %v, want frames %v", action, boneName, flat, frames.Keys())
+				}
+
+				for _, frame := range frames.Keys() {
+					if m := flat.Get(frame); m == nil || *m != *frames.Get(frame) {
+						t.Fatalf("action %q bone %q frame %d is %v, want %v", action, boneName, frame, m, frames.Get(frame))
+					}
+				}
+			}
+		}
+	}
+}
+
+func equalFloats(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func BenchmarkDecodeFlatMeshes(b *testing.B) {
+	data := trumpdata.Read(b, trumpdata.Vertices)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeFlatMeshes(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalVertexData(b *testing.B) {
+	data := trumpdata.Read(b, trumpdata.Vertices)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		var vertexData map[string]Mesh
+
+		if err := json.Unmarshal(data, &vertexData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeFlatAnimations(b *testing.B) {
+	data := trumpdata.Read(b, trumpdata.Animation)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeFlatAnimations(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalAnimationMatrices(b *testing.B) {
+	data := trumpdata.Read(b, trumpdata.Animation)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+
+	for i := 0; i < b.N; i++ {
+		var animationData ExportedAnimations
+
+		if err := json.Unmarshal(data, &animationData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,15 +1,17 @@
 package animation
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
+	"strconv"
 )
 
 type Mesh struct {
-	Indices     []uint32     `json:"indices"`
-	Coordinates []Coordinate `json:"coordinates"`
+	Indices      []uint32      `json:"indices"`
+	Coordinates  []Coordinate  `json:"coordinates"`
+	MorphTargets []MorphTarget `json:"morphTargets"`
 }
 
 type Coordinate struct {
@@ -71,25 +73,153 @@ func (m *IntToMatrix4fMap) Keys() []int {
 }
 
 func (e *IntToMatrix4fMap) UnmarshalJSON(b []byte) error {
+	c := map[string]json.RawMessage{}
 
-	c := map[int]*Matrix4f{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return err
+	}
+
+	x := NewIntToMatrix4fMap()
+
+	for k, v := range c {
+		frame, err := strconv.Atoi(k)
+
+		if err != nil {
+			return fmt.Errorf("frame %q is not an integer", k)
+		}
+
+		var m *Matrix4f
+
+		if err := json.Unmarshal(v, &m); err != nil {
+			return fmt.Errorf("frame %d: %s", frame, err.Error())
+		}
+
+		if m == nil {
+			return fmt.Errorf("frame %d: matrix is null", frame)
+		}
+
+		x.Set(frame, m)
+	}
+
+	*e = *x
+
+	return nil
+}
+
+// MarshalJSON writes the frames in ascending order so the output is stable.
+func (m *IntToMatrix4fMap) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, k := range m.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		v, err := json.Marshal(m.values[k])
+
+		if err != nil {
+			return nil, fmt.Errorf("frame %d: %s", k, err.Error())
+		}
+
+		b.WriteString(strconv.Quote(strconv.Itoa(k)))
+		b.WriteByte(':')
+		b.Write(v)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// ExportedAnimations is the exported animation matrices file, mesh -> action -> bone -> frames. Decoding it
+// reports which mesh, action and bone a bad frame belongs to.
+type ExportedAnimations map[string]map[string]map[string]*IntToMatrix4fMap
+
+func (e *ExportedAnimations) UnmarshalJSON(b []byte) error {
+	meshes := map[string]map[string]map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(b, &meshes); err != nil {
+		return err
+	}
+
+	x := ExportedAnimations{}
+
+	for meshName, actions := range meshes {
+		x[meshName] = map[string]map[string]*IntToMatrix4fMap{}
 
-	if err := json.Unmarshal(b, &c); err == nil {
-		x := NewIntToMatrix4fMap()
+		for action, bones := range actions {
+			x[meshName][action] = map[string]*IntToMatrix4fMap{}
 
-		for k, v := range c {
-			x.Set(k, v)
+			for boneName, v := range bones {
+				frames := NewIntToMatrix4fMap()
+
+				if err := json.Unmarshal(v, frames); err != nil {
+					return fmt.Errorf("mesh %q action %q bone %q: %s", meshName, action, boneName, err.Error())
+				}
+
+				x[meshName][action][boneName] = frames
+			}
 		}
+	}
+
+	*e = x
+
+	return nil
+}
+
+// ExportedMorphWeights is the exported morph weights file, mesh -> action -> morph target -> frame -> weight.
+type ExportedMorphWeights map[string]map[string]MorphAnimation
+
+type IntToFloat32Map struct {
+	values map[int]float32
+	keys   []int
+}
+
+func NewIntToFloat32Map() *IntToFloat32Map {
+	s := new(IntToFloat32Map)
+
+	s.values = make(map[int]float32)
+	s.keys = []int{}
+
+	return s
+}
+
+func (m *IntToFloat32Map) Set(key int, value float32) {
+	_, present := m.values[key]
+
+	if !present {
+		m.keys = append(m.keys, key)
+		sort.Ints(m.keys)
+	}
+
+	m.values[key] = value
+}
+
+func (m *IntToFloat32Map) Get(key int) float32 {
+	return m.values[key]
+}
+
+func (m *IntToFloat32Map) Keys() []int {
+	return m.keys
+}
 
-		*e = *x
+func (e *IntToFloat32Map) UnmarshalJSON(b []byte) error {
+	c := map[int]float32{}
 
+	if err := json.Unmarshal(b, &c); err != nil {
 		return err
-	} else {
-		fmt.Println("TESTTTSTSTST")
-		fmt.Println(err)
-		os.Exit(0)
 	}
 
+	x := NewIntToFloat32Map()
+
+	for k, v := range c {
+		x.Set(k, v)
+	}
+
+	*e = *x
+
 	return nil
 }
 
@@ -0,0 +1,184 @@
+package animation_test
+
+import (
+	"encoding/json"
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/internal/trumpdata"
+	"sort"
+	"testing"
+)
+
+// trumpFrames returns the first frames of a few of the trump export's bones as they appear in the file. They are
+// kept short because the fuzzer minimises every new input it finds, which is slow for long ones.
+func trumpFrames(t testing.TB) [][]byte {
+	var animations map[string]map[string]map[string]map[string]json.RawMessage
+
+	if err := json.Unmarshal(trumpdata.Read(t, trumpdata.Animation), &animations); err != nil {
+		t.Fatal(err)
+	}
+
+	boneNames := []string{}
+	for boneName := range animations[trumpdata.MeshName][trumpdata.ActionName] {
+		boneNames = append(boneNames, boneName)
+	}
+	sort.Strings(boneNames)
+
+	frames := [][]byte{}
+
+	for _, boneName := range boneNames[:4] {
+		bone := animations[trumpdata.MeshName][trumpdata.ActionName][boneName]
+		frames = append(frames, []byte(`{"1": `+string(bone["1"])+`, "2": `+string(bone["2"])+`, "3": `+string(bone["3"])+`}`))
+	}
+
+	return frames
+}
+
+// checkFrames fails unless the map's keys are sorted and unique, every key has a matrix, and the map survives
+// a MarshalJSON and UnmarshalJSON round trip unchanged.
+func checkFrames(t *testing.T, frames *IntToMatrix4fMap) {
+	keys := frames.Keys()
+
+	for i, key := range keys {
+		if i > 0 && keys[i-1] >= key {
+			t.Fatalf("keys %v are not sorted and unique", keys)
+		}
+
+		if frames.Get(key) == nil {
+			t.Fatalf("frame %d has no matrix", key)
+		}
+	}
+
+	b, err := json.Marshal(frames)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again := NewIntToMatrix4fMap()
+
+	if err := json.Unmarshal(b, again); err != nil {
+		t.Fatalf("%s does not read back: %s", b, err)
+	}
+
+	if len(again.Keys()) != len(keys) {
+		t.Fatalf("%s reads back as frames %v, want %v", b, again.Keys(), keys)
+	}
+
+	for i, key := range keys {
+		if again.Keys()[i] != key || *again.Get(key) != *frames.Get(key) {
+			t.Fatalf("frame %d reads back as %v, want %v", key, again.Get(again.Keys()[i]), frames.Get(key))
+		}
+	}
+}
+
+func TestIntToMatrix4fMapMarshalRoundTrip(t *testing.T) {
+	for _, data := range trumpFrames(t) {
+		frames := NewIntToMatrix4fMap()
+
+		if err := json.Unmarshal(data, frames); err != nil {
+			t.Fatal(err)
+		}
+
+		checkFrames(t, frames)
+
+		first, _ := json.Marshal(frames)
+		second, _ := json.Marshal(frames)
+
+		if string(first) != string(second) {
+			t.Fatalf("marshalling the same frames twice gave different output")
+		}
+	}
+
+	empty, err := json.Marshal(NewIntToMatrix4fMap())
+
+	if err != nil || string(empty) != "{}" {
+		t.Fatalf("empty frames marshal to %s, %v", empty, err)
+	}
+}
+
+func FuzzIntToMatrix4fMap(f *testing.F) {
+	for _, data := range trumpFrames(f) {
+		f.Add(data)
+	}
+
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"-1": [1,0,0,0,0,1,0,0,0,0,1,0,0,0,0,1], "01": [1,0,0,0,0,1,0,0,0,0,1,0,0,0,0,1]}`))
+	f.Add([]byte(`{"x": []}`))
+	f.Add([]byte(`{"1": null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		frames := NewIntToMatrix4fMap()
+
+		if err := json.Unmarshal(data, frames); err != nil {
+			return
+		}
+
+		checkFrames(t, frames)
+	})
+}
+
+func FuzzMatrix4f(f *testing.F) {
+	for _, data := range trumpFrames(f) {
+		var frames map[string]json.RawMessage
+
+		if err := json.Unmarshal(data, &frames); err != nil {
+			f.Fatal(err)
+		}
+
+		f.Add([]byte(frames["1"]))
+	}
+
+	f.Add([]byte(`[]`))
+	f.Add([]byte(`[1,2,3]`))
+	f.Add([]byte(`[1e39,0,0,0,0,1,0,0,0,0,1,0,0,0,0,1]`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Matrix4f
+
+		if err := json.Unmarshal(data, &m); err != nil {
+			return
+		}
+
+		b, err := json.Marshal(&m)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var again Matrix4f
+
+		if err := json.Unmarshal(b, &again); err != nil || again != m {
+			t.Fatalf("%s reads back as %v, %v, want %v", b, again, err, m)
+		}
+	})
+}
+
+func FuzzExportedAnimations(f *testing.F) {
+	for _, data := range trumpFrames(f) {
+		f.Add([]byte(`{"Cube": {"ArmatureAction": {"mixamorig:Hips": ` + string(data) + `}}}`))
+	}
+
+	f.Add([]byte(`{"Cube": {"ArmatureAction": {"mixamorig:Hips": {"1": [1]}}}}`))
+	f.Add([]byte(`{"Cube": {"ArmatureAction": {"mixamorig:Hips": null}}}`))
+	f.Add([]byte(`{"Cube": null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var animations ExportedAnimations
+
+		if err := json.Unmarshal(data, &animations); err != nil {
+			return
+		}
+
+		for _, actions := range animations {
+			for _, bones := range actions {
+				for _, frames := range bones {
+					if frames == nil {
+						t.Fatal("a bone decoded to nil frames")
+					}
+
+					checkFrames(t, frames)
+				}
+			}
+		}
+	})
+}
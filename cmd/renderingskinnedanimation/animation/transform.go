@@ -0,0 +1,285 @@
+package animation
+
+import "math"
+
+type Vector3f struct {
+	X float32
+	Y float32
+	Z float32
+}
+
+func (v Vector3f) Add(r Vector3f) Vector3f {
+	return Vector3f{v.X + r.X, v.Y + r.Y, v.Z + r.Z}
+}
+
+func (v Vector3f) Sub(r Vector3f) Vector3f {
+	return Vector3f{v.X - r.X, v.Y - r.Y, v.Z - r.Z}
+}
+
+func (v Vector3f) Scale(s float32) Vector3f {
+	return Vector3f{v.X * s, v.Y * s, v.Z * s}
+}
+
+func (v Vector3f) Dot(r Vector3f) float32 {
+	return v.X*r.X + v.Y*r.Y + v.Z*r.Z
+}
+
+func (v Vector3f) Cross(r Vector3f) Vector3f {
+	return Vector3f{
+		v.Y*r.Z - v.Z*r.Y,
+		v.Z*r.X - v.X*r.Z,
+		v.X*r.Y - v.Y*r.X,
+	}
+}
+
+func (v Vector3f) Length() float32 {
+	return float32(math.Sqrt(float64(v.Dot(v))))
+}
+
+func (v Vector3f) Normalize() Vector3f {
+	l := v.Length()
+
+	if l == 0 {
+		return v
+	}
+
+	return v.Scale(1 / l)
+}
+
+func (v Vector3f) Lerp(r Vector3f, t float32) Vector3f {
+	return v.Add(r.Sub(v).Scale(t))
+}
+
+type Quaternion struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
+
+func IdentityQuaternion() Quaternion {
+	return Quaternion{0, 0, 0, 1}
+}
+
+func QuaternionFromAxisAngle(axis Vector3f, angle float32) Quaternion {
+	axis = axis.Normalize()
+	s := float32(math.Sin(float64(angle) / 2))
+	c := float32(math.Cos(float64(angle) / 2))
+	return Quaternion{axis.X * s, axis.Y * s, axis.Z * s, c}
+}
+
+// QuaternionFromTo returns the shortest rotation that turns direction from onto direction to.
+func QuaternionFromTo(from, to Vector3f) Quaternion {
+	from = from.Normalize()
+	to = to.Normalize()
+	d := from.Dot(to)
+
+	if d >= 1 {
+		return IdentityQuaternion()
+	}
+
+	// opposite directions have no unique axis, so rotate half a turn around any perpendicular one
+	if d <= -0.999999 {
+		axis := Vector3f{1, 0, 0}.Cross(from)
+
+		if axis.Length() < 0.000001 {
+			axis = Vector3f{0, 1, 0}.Cross(from)
+		}
+
+		return QuaternionFromAxisAngle(axis, math.Pi)
+	}
+
+	c := from.Cross(to)
+
+	return Quaternion{c.X, c.Y, c.Z, 1 + d}.Normalize()
+}
+
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+	}
+}
+
+func (q Quaternion) Dot(r Quaternion) float32 {
+	return q.X*r.X + q.Y*r.Y + q.Z*r.Z + q.W*r.W
+}
+
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{-q.X, -q.Y, -q.Z, q.W}
+}
+
+func (q Quaternion) Normalize() Quaternion {
+	l := float32(math.Sqrt(float64(q.Dot(q))))
+
+	if l == 0 {
+		return IdentityQuaternion()
+	}
+
+	return Quaternion{q.X / l, q.Y / l, q.Z / l, q.W / l}
+}
+
+func (q Quaternion) Rotate(v Vector3f) Vector3f {
+	u := Vector3f{q.X, q.Y, q.Z}
+	t := u.Cross(v).Scale(2)
+	return v.Add(t.Scale(q.W)).Add(u.Cross(t))
+}
+
+// Angle returns the angle in radians between two rotations, taking the shortest path.
+func (q Quaternion) Angle(r Quaternion) float32 {
+	// atan2 of the relative rotation stays accurate for tiny angles where acos of the dot product does not
+	d := q.Normalize().Mul(r.Normalize().Conjugate())
+	v := math.Sqrt(float64(d.X*d.X + d.Y*d.Y + d.Z*d.Z))
+
+	return float32(2 * math.Atan2(v, math.Abs(float64(d.W))))
+}
+
+func (q Quaternion) Nlerp(r Quaternion, t float32) Quaternion {
+	if q.Dot(r) < 0 {
+		r = Quaternion{-r.X, -r.Y, -r.Z, -r.W}
+	}
+
+	return Quaternion{
+		q.X + (r.X-q.X)*t,
+		q.Y + (r.Y-q.Y)*t,
+		q.Z + (r.Z-q.Z)*t,
+		q.W + (r.W-q.W)*t,
+	}.Normalize()
+}
+
+func (q Quaternion) Slerp(r Quaternion, t float32) Quaternion {
+	d := q.Dot(r)
+
+	if d < 0 {
+		r = Quaternion{-r.X, -r.Y, -r.Z, -r.W}
+		d = -d
+	}
+
+	// fall back to a normalised lerp when the rotations are nearly identical to avoid dividing by ~0
+	if d > 0.9995 {
+		return q.Nlerp(r, t)
+	}
+
+	theta := math.Acos(float64(d))
+	sinTheta := math.Sin(theta)
+	a := float32(math.Sin((1-float64(t))*theta) / sinTheta)
+	b := float32(math.Sin(float64(t)*theta) / sinTheta)
+
+	return Quaternion{
+		q.X*a + r.X*b,
+		q.Y*a + r.Y*b,
+		q.Z*a + r.Z*b,
+		q.W*a + r.W*b,
+	}
+}
+
+// Transform is a matrix split into its translation, rotation and scale components.
+type Transform struct {
+	Translation Vector3f
+	Rotation    Quaternion
+	Scale       Vector3f
+}
+
+func IdentityTransform() Transform {
+	return Transform{Vector3f{}, IdentityQuaternion(), Vector3f{1, 1, 1}}
+}
+
+// DecomposeMatrix splits a row-major matrix (translation in M03, M13, M23) into a Transform.
+// Shear is not representable and is discarded.
+func DecomposeMatrix(m *Matrix4f) Transform {
+	t := Transform{}
+
+	t.Translation = Vector3f{m.M03, m.M13, m.M23}
+
+	xAxis := Vector3f{m.M00, m.M10, m.M20}
+	yAxis := Vector3f{m.M01, m.M11, m.M21}
+	zAxis := Vector3f{m.M02, m.M12, m.M22}
+
+	t.Scale = Vector3f{xAxis.Length(), yAxis.Length(), zAxis.Length()}
+
+	// a negative determinant means the basis is mirrored, fold the mirror into the x scale
+	if xAxis.Cross(yAxis).Dot(zAxis) < 0 {
+		t.Scale.X = -t.Scale.X
+	}
+
+	if t.Scale.X != 0 {
+		xAxis = xAxis.Scale(1 / t.Scale.X)
+	}
+	if t.Scale.Y != 0 {
+		yAxis = yAxis.Scale(1 / t.Scale.Y)
+	}
+	if t.Scale.Z != 0 {
+		zAxis = zAxis.Scale(1 / t.Scale.Z)
+	}
+
+	t.Rotation = quaternionFromBasis(xAxis, yAxis, zAxis)
+
+	return t
+}
+
+func quaternionFromBasis(x, y, z Vector3f) Quaternion {
+	m00, m01, m02 := x.X, y.X, z.X
+	m10, m11, m12 := x.Y, y.Y, z.Y
+	m20, m21, m22 := x.Z, y.Z, z.Z
+
+	var q Quaternion
+
+	trace := m00 + m11 + m22
+
+	if trace > 0 {
+		s := float32(math.Sqrt(float64(trace+1))) * 2
+		q = Quaternion{(m21 - m12) / s, (m02 - m20) / s, (m10 - m01) / s, 0.25 * s}
+	} else if m00 > m11 && m00 > m22 {
+		s := float32(math.Sqrt(float64(1+m00-m11-m22))) * 2
+		q = Quaternion{0.25 * s, (m01 + m10) / s, (m02 + m20) / s, (m21 - m12) / s}
+	} else if m11 > m22 {
+		s := float32(math.Sqrt(float64(1+m11-m00-m22))) * 2
+		q = Quaternion{(m01 + m10) / s, 0.25 * s, (m12 + m21) / s, (m02 - m20) / s}
+	} else {
+		s := float32(math.Sqrt(float64(1+m22-m00-m11))) * 2
+		q = Quaternion{(m02 + m20) / s, (m12 + m21) / s, 0.25 * s, (m10 - m01) / s}
+	}
+
+	return q.Normalize()
+}
+
+// Matrix composes the transform back into a row-major matrix, applying scale, then rotation, then translation.
+func (t Transform) Matrix() *Matrix4f {
+	q := t.Rotation.Normalize()
+
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+
+	m := new(Matrix4f)
+
+	m.M00 = (1 - 2*(yy+zz)) * t.Scale.X
+	m.M01 = 2 * (xy - wz) * t.Scale.Y
+	m.M02 = 2 * (xz + wy) * t.Scale.Z
+	m.M03 = t.Translation.X
+
+	m.M10 = 2 * (xy + wz) * t.Scale.X
+	m.M11 = (1 - 2*(xx+zz)) * t.Scale.Y
+	m.M12 = 2 * (yz - wx) * t.Scale.Z
+	m.M13 = t.Translation.Y
+
+	m.M20 = 2 * (xz - wy) * t.Scale.X
+	m.M21 = 2 * (yz + wx) * t.Scale.Y
+	m.M22 = (1 - 2*(xx+yy)) * t.Scale.Z
+	m.M23 = t.Translation.Z
+
+	m.M33 = 1
+
+	return m
+}
+
+// Lerp interpolates translation and scale linearly and rotation spherically.
+func (t Transform) Lerp(r Transform, f float32) Transform {
+	return Transform{
+		t.Translation.Lerp(r.Translation, f),
+		t.Rotation.Slerp(r.Rotation, f),
+		t.Scale.Lerp(r.Scale, f),
+	}
+}
@@ -0,0 +1,246 @@
+package animation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const skinWeightTolerance = 0.001
+
+type ValidationErrorKind string
+
+const (
+	MissingBone     ValidationErrorKind = "missing bone"
+	MissingParent   ValidationErrorKind = "missing parent"
+	ParentCycle     ValidationErrorKind = "parent cycle"
+	MissingMatrix   ValidationErrorKind = "missing matrix"
+	UnknownSkinBone ValidationErrorKind = "unknown skin bone"
+	SkinWeightSum   ValidationErrorKind = "skin weights do not sum to 1"
+	UnknownKeyBone  ValidationErrorKind = "keyframes for unknown bone"
+	FrameOutOfRange ValidationErrorKind = "frame out of range"
+	NotANumber      ValidationErrorKind = "not a number"
+)
+
+// ValidationError describes a single problem in loaded data; Path locates it, e.g. "bones[Bone.001].parentName".
+type ValidationError struct {
+	Kind    ValidationErrorKind
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Path, e.Kind, e.Message)
+}
+
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := []string{}
+
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Err returns nil when there are no errors, so a validation result can be returned as a plain error.
+func (errs ValidationErrors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+func (errs *ValidationErrors) add(kind ValidationErrorKind, path, format string, args ...interface{}) {
+	*errs = append(*errs, &ValidationError{kind, path, fmt.Sprintf(format, args...)})
+}
+
+func (errs *ValidationErrors) checkMatrix(path string, m *Matrix4f) {
+	if m == nil {
+		errs.add(MissingMatrix, path, "matrix is missing")
+		return
+	}
+
+	for i, v := range m.Get1D() {
+		if isNaNOrInf(v) {
+			errs.add(NotANumber, fmt.Sprintf("%s[%d]", path, i), "value is %v", v)
+		}
+	}
+}
+
+func isNaNOrInf(v float32) bool {
+	return math.IsNaN(float64(v)) || math.IsInf(float64(v), 0)
+}
+
+func sortedBoneNames(armature *Armature) []string {
+	names := []string{}
+
+	for boneName := range armature.Bones {
+		names = append(names, boneName)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ValidateArmature checks that every bone's parent exists, that parents never loop back on themselves and
+// that the bone matrices are present and finite.
+func ValidateArmature(armature *Armature) ValidationErrors {
+	errs := ValidationErrors{}
+
+	for _, boneName := range sortedBoneNames(armature) {
+		bone := armature.Bones[boneName]
+		path := fmt.Sprintf("bones[%s]", boneName)
+
+		if bone == nil {
+			errs.add(MissingBone, path, "bone is null")
+			continue
+		}
+
+		if len(bone.ParentName) > 0 && armature.Bones[bone.ParentName] == nil {
+			errs.add(MissingParent, path+".parentName", "parent %q does not exist", bone.ParentName)
+		}
+
+		errs.checkMatrix(path+".matrix_local", bone.MatrixLocal)
+		errs.checkMatrix(path+".matrix_local_inverted", bone.MatrixLocalInverted)
+	}
+
+	reported := map[string]bool{}
+
+	for _, boneName := range sortedBoneNames(armature) {
+		visited := map[string]bool{}
+		chain := []string{}
+
+		for name := boneName; len(name) > 0 && armature.Bones[name] != nil; name = armature.Bones[name].ParentName {
+			if visited[name] {
+				cycle := chain[indexOf(chain, name):]
+
+				// several walks can run into the same cycle, only report it the first time
+				if !reported[name] {
+					for _, n := range cycle {
+						reported[n] = true
+					}
+					errs.add(ParentCycle, fmt.Sprintf("bones[%s].parentName", name), "%s -> %s", strings.Join(cycle, " -> "), name)
+				}
+				break
+			}
+
+			visited[name] = true
+			chain = append(chain, name)
+		}
+	}
+
+	return errs
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ValidateSkin checks that every skin entry of the mesh names a bone of the armature, that each coordinate's
+// weights add up to 1 and that positions, uvs and weights are finite.
+func ValidateSkin(mesh *Mesh, armature *Armature) ValidationErrors {
+	errs := ValidationErrors{}
+
+	for i, coordinate := range mesh.Coordinates {
+		path := fmt.Sprintf("coordinates[%d]", i)
+
+		for j, v := range coordinate.Vertices {
+			if isNaNOrInf(v) {
+				errs.add(NotANumber, fmt.Sprintf("%s.xyz[%d]", path, j), "value is %v", v)
+			}
+		}
+
+		for j, v := range coordinate.Textures {
+			if isNaNOrInf(v) {
+				errs.add(NotANumber, fmt.Sprintf("%s.uvs[%d]", path, j), "value is %v", v)
+			}
+		}
+
+		boneNames := []string{}
+		for boneName := range coordinate.Skin {
+			boneNames = append(boneNames, boneName)
+		}
+		sort.Strings(boneNames)
+
+		sum := float32(0)
+
+		for _, boneName := range boneNames {
+			weight := coordinate.Skin[boneName]
+			weightPath := fmt.Sprintf("%s.skin[%s]", path, boneName)
+
+			if armature.Bones[boneName] == nil {
+				errs.add(UnknownSkinBone, weightPath, "bone %q does not exist in armature %q", boneName, armature.Name)
+			}
+
+			if isNaNOrInf(weight) {
+				errs.add(NotANumber, weightPath, "value is %v", weight)
+			}
+
+			sum += weight
+		}
+
+		if len(coordinate.Skin) > 0 && !isNaNOrInf(sum) && math.Abs(float64(sum-1)) > skinWeightTolerance {
+			errs.add(SkinWeightSum, path+".skin", "weights sum to %v", sum)
+		}
+	}
+
+	return errs
+}
+
+// ValidateKeyframes checks that keyframes only exist for bones of the armature, lie between startFrame and
+// endFrame inclusive and hold finite matrices.
+func ValidateKeyframes(keyframes map[string]*IntToMatrix4fMap, armature *Armature, startFrame, endFrame int64) ValidationErrors {
+	errs := ValidationErrors{}
+
+	boneNames := []string{}
+	for boneName := range keyframes {
+		boneNames = append(boneNames, boneName)
+	}
+	sort.Strings(boneNames)
+
+	for _, boneName := range boneNames {
+		path := fmt.Sprintf("keyframes[%s]", boneName)
+
+		if armature.Bones[boneName] == nil {
+			errs.add(UnknownKeyBone, path, "bone %q does not exist in armature %q", boneName, armature.Name)
+		}
+
+		frames := keyframes[boneName]
+
+		if frames == nil {
+			continue
+		}
+
+		for _, frame := range frames.Keys() {
+			framePath := fmt.Sprintf("%s[%d]", path, frame)
+
+			if int64(frame) < startFrame || int64(frame) > endFrame {
+				errs.add(FrameOutOfRange, framePath, "frame is outside %d-%d", startFrame, endFrame)
+			}
+
+			errs.checkMatrix(framePath, frames.Get(frame))
+		}
+	}
+
+	return errs
+}
+
+// ValidateSkinnedModel runs every check needed before the data is handed to NewSkinnedAnimation.
+func ValidateSkinnedModel(mesh *Mesh, armature *Armature, keyframes map[string]*IntToMatrix4fMap, startFrame, endFrame int64) error {
+	errs := ValidateArmature(armature)
+	errs = append(errs, ValidateSkin(mesh, armature)...)
+	errs = append(errs, ValidateKeyframes(keyframes, armature, startFrame, endFrame)...)
+	return errs.Err()
+}
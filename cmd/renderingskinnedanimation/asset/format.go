@@ -0,0 +1,52 @@
+package asset
+
+// The asset format is a little-endian container:
+//
+//	header       magic "HMXA", uint16 version, uint16 reserved, uint32 chunk count, uint32 reserved
+//	chunk table  per chunk: [4]byte type, uint32 offset, uint32 length, uint32 reserved
+//	chunks       each starting on a 4 byte boundary with its name, a uint32 length followed by the bytes
+//	             padded to 4, so the float and index arrays after it can be used in place
+//
+// Chunk payloads after the name:
+//
+//	MESH  uint32 vertex count, uint32 index count, float32 vertices in the layout the skinning shader reads
+//	      (x, y, z, u, v, mesh offset, skin length, skin offset), uint32 indices
+//	SKIN  string armature, uint32 entry count, float32 (bone index, weight) pairs in the order the vertices'
+//	      skin offsets point into
+//	MRPH  uint32 target count, target names, uint32 vertex count, float32 deltas as Mesh.MorphDeltaBuffer
+//	ARMA  uint32 bone count, per bone a name and an int32 parent index, then every bone's matrix_local
+//	      followed by every bone's matrix_local_inverted, 16 float32 each in Matrix4f.Get1D order
+//	CLIP  string armature, int32 start frame, int32 end frame, uint32 bone count, then per bone in the
+//	      armature's order one matrix for every frame from start to end, relative to the rest pose, then
+//	      uint32 morph track count and per track, sorted by mesh and target, string mesh, string target and
+//	      one float32 weight for every frame from start to end
+
+const Version = 1
+
+const (
+	headerSize     = 16
+	chunkEntrySize = 16
+)
+
+var magic = [4]byte{'H', 'M', 'X', 'A'}
+
+type ChunkType [4]byte
+
+var (
+	ChunkMesh     = ChunkType{'M', 'E', 'S', 'H'}
+	ChunkSkin     = ChunkType{'S', 'K', 'I', 'N'}
+	ChunkMorph    = ChunkType{'M', 'R', 'P', 'H'}
+	ChunkArmature = ChunkType{'A', 'R', 'M', 'A'}
+	ChunkClip     = ChunkType{'C', 'L', 'I', 'P'}
+)
+
+func (t ChunkType) String() string {
+	return string(t[:])
+}
+
+// VertexStride is the number of floats per vertex in a MESH chunk.
+const VertexStride = 8
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
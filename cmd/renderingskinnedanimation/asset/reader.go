@@ -0,0 +1,600 @@
+package asset
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"unsafe"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+type Chunk struct {
+	Type ChunkType
+	Name string
+	// Data is the payload after the name, a view into the reader's data
+	Data []byte
+}
+
+// Reader indexes an asset file held in memory. The slices it returns point into that memory wherever the
+// host allows it, so they must not be modified and stay valid only as long as the data does.
+type Reader struct {
+	Version uint16
+	Chunks  []Chunk
+
+	data []byte
+}
+
+// Open reads a whole asset file into memory.
+func Open(path string) (*Reader, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReader(data)
+}
+
+func NewReader(data []byte) (*Reader, error) {
+	if len(data) < headerSize || [4]byte{data[0], data[1], data[2], data[3]} != magic {
+		return nil, fmt.Errorf("asset: not an asset file")
+	}
+
+	r := &Reader{
+		Version: binary.LittleEndian.Uint16(data[4:]),
+		Chunks:  []Chunk{},
+		data:    data,
+	}
+
+	if r.Version > Version {
+		return nil, fmt.Errorf("asset: version %d is newer than the supported version %d", r.Version, Version)
+	}
+
+	count := int(binary.LittleEndian.Uint32(data[8:]))
+
+	if count < 0 || headerSize+count*chunkEntrySize > len(data) {
+		return nil, fmt.Errorf("asset: chunk table of %d entries overruns the file", count)
+	}
+
+	for i := 0; i < count; i++ {
+		entry := data[headerSize+i*chunkEntrySize:]
+
+		var chunkType ChunkType
+		copy(chunkType[:], entry)
+
+		offset := int(binary.LittleEndian.Uint32(entry[4:]))
+		length := int(binary.LittleEndian.Uint32(entry[8:]))
+
+		if offset%4 != 0 || offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, fmt.Errorf("asset: chunk %d (%s) at %d+%d overruns the file or is misaligned", i, chunkType, offset, length)
+		}
+
+		payload := &cursor{data: data[offset : offset+length]}
+		name, err := payload.string()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: chunk %d (%s): %s", i, chunkType, err.Error())
+		}
+
+		r.Chunks = append(r.Chunks, Chunk{chunkType, name, payload.data[payload.offset:]})
+	}
+
+	return r, nil
+}
+
+// Find returns the first chunk of the type and name.
+func (r *Reader) Find(chunkType ChunkType, name string) *Chunk {
+	for i := range r.Chunks {
+		if r.Chunks[i].Type == chunkType && r.Chunks[i].Name == name {
+			return &r.Chunks[i]
+		}
+	}
+
+	return nil
+}
+
+// Names lists the names of every chunk of a type, in file order.
+func (r *Reader) Names(chunkType ChunkType) []string {
+	names := []string{}
+
+	for _, c := range r.Chunks {
+		if c.Type == chunkType {
+			names = append(names, c.Name)
+		}
+	}
+
+	return names
+}
+
+type cursor struct {
+	data   []byte
+	offset int
+}
+
+func (c *cursor) need(n int) error {
+	if n < 0 || c.offset+n > len(c.data) {
+		return fmt.Errorf("payload is truncated")
+	}
+
+	return nil
+}
+
+func (c *cursor) uint32() (uint32, error) {
+	if err := c.need(4); err != nil {
+		return 0, err
+	}
+
+	v := binary.LittleEndian.Uint32(c.data[c.offset:])
+	c.offset += 4
+
+	return v, nil
+}
+
+func (c *cursor) int32() (int32, error) {
+	v, err := c.uint32()
+	return int32(v), err
+}
+
+func (c *cursor) string() (string, error) {
+	n, err := c.uint32()
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.need(pad4(int(n))); err != nil {
+		return "", err
+	}
+
+	s := string(c.data[c.offset : c.offset+int(n)])
+	c.offset += pad4(int(n))
+
+	return s, nil
+}
+
+func (c *cursor) floats(count int) ([]float32, error) {
+	if err := c.need(count * 4); err != nil {
+		return nil, err
+	}
+
+	v := floatView(c.data[c.offset : c.offset+count*4])
+	c.offset += count * 4
+
+	return v, nil
+}
+
+func (c *cursor) uint32s(count int) ([]uint32, error) {
+	if err := c.need(count * 4); err != nil {
+		return nil, err
+	}
+
+	v := uint32View(c.data[c.offset : c.offset+count*4])
+	c.offset += count * 4
+
+	return v, nil
+}
+
+var littleEndianHost = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// viewable reports whether the little-endian bytes can be used in place as 32 bit values; views are limited
+// to 1<<28 values by the array type they are cut from.
+func viewable(b []byte) bool {
+	return len(b) > 0 && len(b)/4 <= 1<<28 && littleEndianHost && uintptr(unsafe.Pointer(&b[0]))%4 == 0
+}
+
+func floatView(b []byte) []float32 {
+	if viewable(b) {
+		n := len(b) / 4
+		return (*[1 << 28]float32)(unsafe.Pointer(&b[0]))[:n:n]
+	}
+
+	v := make([]float32, len(b)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+
+	return v
+}
+
+func uint32View(b []byte) []uint32 {
+	if viewable(b) {
+		n := len(b) / 4
+		return (*[1 << 28]uint32)(unsafe.Pointer(&b[0]))[:n:n]
+	}
+
+	v := make([]uint32, len(b)/4)
+	for i := range v {
+		v[i] = binary.LittleEndian.Uint32(b[i*4:])
+	}
+
+	return v
+}
+
+// MeshData holds a mesh's buffers ready for gl.BufferData: Points with a stride of VertexStride floats and
+// Indices for the element array.
+type MeshData struct {
+	Name    string
+	Points  []float32
+	Indices []uint32
+}
+
+func (m *MeshData) VertexCount() int {
+	return len(m.Points) / VertexStride
+}
+
+func (r *Reader) Mesh(name string) (*MeshData, error) {
+	chunk := r.Find(ChunkMesh, name)
+
+	if chunk == nil {
+		return nil, fmt.Errorf("asset: no mesh %q", name)
+	}
+
+	c := &cursor{data: chunk.Data}
+	m := &MeshData{Name: name}
+
+	vertexCount, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: mesh %q: %s", name, err.Error())
+	}
+
+	indexCount, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: mesh %q: %s", name, err.Error())
+	}
+
+	if m.Points, err = c.floats(int(vertexCount) * VertexStride); err != nil {
+		return nil, fmt.Errorf("asset: mesh %q: %s", name, err.Error())
+	}
+
+	if m.Indices, err = c.uint32s(int(indexCount)); err != nil {
+		return nil, fmt.Errorf("asset: mesh %q: %s", name, err.Error())
+	}
+
+	return m, nil
+}
+
+// SkinData holds the (bone index, weight) pairs the skin texture buffer is created from.
+type SkinData struct {
+	Armature string
+	Weights  []float32
+}
+
+func (r *Reader) Skin(mesh string) (*SkinData, error) {
+	chunk := r.Find(ChunkSkin, mesh)
+
+	if chunk == nil {
+		return nil, fmt.Errorf("asset: no skin for mesh %q", mesh)
+	}
+
+	c := &cursor{data: chunk.Data}
+	s := &SkinData{}
+
+	var err error
+
+	if s.Armature, err = c.string(); err != nil {
+		return nil, fmt.Errorf("asset: skin %q: %s", mesh, err.Error())
+	}
+
+	count, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: skin %q: %s", mesh, err.Error())
+	}
+
+	if s.Weights, err = c.floats(int(count) * 2); err != nil {
+		return nil, fmt.Errorf("asset: skin %q: %s", mesh, err.Error())
+	}
+
+	return s, nil
+}
+
+// MorphData holds a mesh's morph target names and the delta buffer laid out as Mesh.MorphDeltaBuffer.
+type MorphData struct {
+	Names       []string
+	VertexCount int
+	Deltas      []float32
+}
+
+// Morph returns the mesh's morph targets, or nil if it has none.
+func (r *Reader) Morph(mesh string) (*MorphData, error) {
+	chunk := r.Find(ChunkMorph, mesh)
+
+	if chunk == nil {
+		return nil, nil
+	}
+
+	c := &cursor{data: chunk.Data}
+	m := &MorphData{Names: []string{}}
+
+	count, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: morph %q: %s", mesh, err.Error())
+	}
+
+	for i := 0; i < int(count); i++ {
+		targetName, err := c.string()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: morph %q: %s", mesh, err.Error())
+		}
+
+		m.Names = append(m.Names, targetName)
+	}
+
+	vertexCount, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: morph %q: %s", mesh, err.Error())
+	}
+
+	m.VertexCount = int(vertexCount)
+
+	if m.Deltas, err = c.floats(len(m.Names) * m.VertexCount * 6); err != nil {
+		return nil, fmt.Errorf("asset: morph %q: %s", mesh, err.Error())
+	}
+
+	return m, nil
+}
+
+func matrixFrom1D(v []float32) *animation.Matrix4f {
+	return &animation.Matrix4f{
+		M00: v[0], M01: v[1], M02: v[2], M03: v[3],
+		M10: v[4], M11: v[5], M12: v[6], M13: v[7],
+		M20: v[8], M21: v[9], M22: v[10], M23: v[11],
+		M30: v[12], M31: v[13], M32: v[14], M33: v[15],
+	}
+}
+
+// ArmatureMatrices returns the armature's matrix_local_inverted values in skeleton order, the layout of the
+// inverted bone matrix texture buffer, without copying.
+func (r *Reader) ArmatureMatrices(name string) ([]float32, error) {
+	c, count, err := r.armatureCursor(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.floats(count * 16); err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	inverted, err := c.floats(count * 16)
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	return inverted, nil
+}
+
+// armatureCursor returns a cursor positioned at the armature's matrices, after the bone list.
+func (r *Reader) armatureCursor(name string) (*cursor, int, error) {
+	chunk := r.Find(ChunkArmature, name)
+
+	if chunk == nil {
+		return nil, 0, fmt.Errorf("asset: no armature %q", name)
+	}
+
+	c := &cursor{data: chunk.Data}
+	count, err := c.uint32()
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	for i := 0; i < int(count); i++ {
+		if _, err := c.string(); err != nil {
+			return nil, 0, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+		}
+
+		if _, err := c.int32(); err != nil {
+			return nil, 0, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+		}
+	}
+
+	return c, int(count), nil
+}
+
+func (r *Reader) Skeleton(name string) (*animation.Skeleton, error) {
+	chunk := r.Find(ChunkArmature, name)
+
+	if chunk == nil {
+		return nil, fmt.Errorf("asset: no armature %q", name)
+	}
+
+	c := &cursor{data: chunk.Data}
+	armature := &animation.Armature{Name: name, Bones: map[string]*animation.Bone{}}
+
+	count, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	names := []string{}
+	parents := []int32{}
+
+	for i := 0; i < int(count); i++ {
+		boneName, err := c.string()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+		}
+
+		parent, err := c.int32()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+		}
+
+		if parent >= int32(i) {
+			return nil, fmt.Errorf("asset: armature %q: bone %q must come after its parent", name, boneName)
+		}
+
+		names = append(names, boneName)
+		parents = append(parents, parent)
+	}
+
+	local, err := c.floats(int(count) * 16)
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	inverted, err := c.floats(int(count) * 16)
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	for i, boneName := range names {
+		bone := &animation.Bone{
+			Name:                boneName,
+			MatrixLocal:         matrixFrom1D(local[i*16:]),
+			MatrixLocalInverted: matrixFrom1D(inverted[i*16:]),
+		}
+
+		if parents[i] >= 0 {
+			bone.ParentName = names[parents[i]]
+		}
+
+		armature.Bones[boneName] = bone
+	}
+
+	skeleton, err := animation.NewSkeleton(armature)
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: armature %q: %s", name, err.Error())
+	}
+
+	return skeleton, nil
+}
+
+type ClipData struct {
+	Name       string
+	Armature   string
+	StartFrame int64
+	EndFrame   int64
+	Keyframes  map[string]*animation.IntToMatrix4fMap
+	// MorphWeights holds the morph target weight tracks per mesh name, keyed at every frame.
+	MorphWeights map[string]animation.MorphAnimation
+}
+
+// Clip reads a clip back into the bone -> frame -> matrix shape NewSkinnedAnimation consumes.
+func (r *Reader) Clip(name string) (*ClipData, error) {
+	chunk := r.Find(ChunkClip, name)
+
+	if chunk == nil {
+		return nil, fmt.Errorf("asset: no clip %q", name)
+	}
+
+	c := &cursor{data: chunk.Data}
+	clip := &ClipData{Name: name, Keyframes: map[string]*animation.IntToMatrix4fMap{}, MorphWeights: map[string]animation.MorphAnimation{}}
+
+	var err error
+
+	if clip.Armature, err = c.string(); err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	startFrame, err := c.int32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	endFrame, err := c.int32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	boneCount, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	if endFrame < startFrame {
+		return nil, fmt.Errorf("asset: clip %q ends at frame %d before it starts at %d", name, endFrame, startFrame)
+	}
+
+	clip.StartFrame = int64(startFrame)
+	clip.EndFrame = int64(endFrame)
+
+	// bones are stored in skeleton order, so the armature is needed to name them
+	skeleton, err := r.Skeleton(clip.Armature)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int(boneCount) != skeleton.Len() {
+		return nil, fmt.Errorf("asset: clip %q has %d bones but armature %q has %d", name, boneCount, clip.Armature, skeleton.Len())
+	}
+
+	frameCount := int(endFrame-startFrame) + 1
+	matrices, err := c.floats(int(boneCount) * frameCount * 16)
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	for b, boneName := range skeleton.Names {
+		frames := animation.NewIntToMatrix4fMap()
+
+		for f := 0; f < frameCount; f++ {
+			frames.Set(int(startFrame)+f, matrixFrom1D(matrices[(b*frameCount+f)*16:]))
+		}
+
+		clip.Keyframes[boneName] = frames
+	}
+
+	trackCount, err := c.uint32()
+
+	if err != nil {
+		return nil, fmt.Errorf("asset: clip %q: %s", name, err.Error())
+	}
+
+	for t := 0; t < int(trackCount); t++ {
+		meshName, err := c.string()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: clip %q morph track %d: %s", name, t, err.Error())
+		}
+
+		target, err := c.string()
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: clip %q morph track %d: %s", name, t, err.Error())
+		}
+
+		weights, err := c.floats(frameCount)
+
+		if err != nil {
+			return nil, fmt.Errorf("asset: clip %q morph track %d: %s", name, t, err.Error())
+		}
+
+		if clip.MorphWeights[meshName] == nil {
+			clip.MorphWeights[meshName] = animation.MorphAnimation{}
+		}
+
+		frames := animation.NewIntToFloat32Map()
+
+		for f, weight := range weights {
+			frames.Set(int(startFrame)+f, weight)
+		}
+
+		clip.MorphWeights[meshName][target] = frames
+	}
+
+	return clip, nil
+}
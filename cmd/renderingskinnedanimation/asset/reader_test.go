@@ -0,0 +1,258 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/internal/trumpdata"
+)
+
+type trump struct {
+	*trumpdata.Export
+	skeleton *animation.Skeleton
+}
+
+func readTrump(t *testing.T) *trump {
+	export := trumpdata.Load(t)
+	skeleton, err := animation.NewSkeleton(export.Armature)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &trump{export, skeleton}
+}
+
+func writeTrump(t *testing.T, data *trump) []byte {
+	w := NewWriter()
+	w.AddArmature(data.skeleton)
+
+	if err := w.AddMesh(trumpdata.MeshName, &data.Mesh, data.skeleton); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.AddClip(trumpdata.ActionName, data.skeleton, data.Keyframes, nil, trumpdata.StartFrame, trumpdata.EndFrame); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	return b.Bytes()
+}
+
+func TestClipRoundTrip(t *testing.T) {
+	data := readTrump(t)
+
+	r, err := NewReader(writeTrump(t, data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clip, err := r.Clip("ArmatureAction")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if clip.Armature != "Armature" || clip.StartFrame != 1 || clip.EndFrame != 35 {
+		t.Fatalf("got clip of %q frames %d-%d, want Armature frames 1-35", clip.Armature, clip.StartFrame, clip.EndFrame)
+	}
+
+	for boneName, frames := range data.Keyframes {
+		for _, frame := range frames.Keys() {
+			got := clip.Keyframes[boneName].Get(frame)
+
+			if got == nil || *got != *frames.Get(frame) {
+				t.Fatalf("bone %q frame %d is %v, want %v", boneName, frame, got, frames.Get(frame))
+			}
+		}
+	}
+
+	skeleton, err := r.Skeleton("Armature")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, boneName := range data.skeleton.Names {
+		if skeleton.Names[i] != boneName || skeleton.Parent(i) != data.skeleton.Parent(i) ||
+			*skeleton.MatrixLocal[i] != *data.skeleton.MatrixLocal[i] {
+			t.Fatalf("bone %d is %q, want %q", i, skeleton.Names[i], boneName)
+		}
+	}
+
+	mesh, err := r.Mesh("Cube")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mesh.VertexCount() != len(data.Mesh.Coordinates) || len(mesh.Indices) != len(data.Mesh.Indices) {
+		t.Fatalf("got %d vertices and %d indices, want %d and %d",
+			mesh.VertexCount(), len(mesh.Indices), len(data.Mesh.Coordinates), len(data.Mesh.Indices))
+	}
+}
+
+func TestClipMorphWeights(t *testing.T) {
+	data := readTrump(t)
+
+	track := func(weights map[int]float32) *animation.IntToFloat32Map {
+		m := animation.NewIntToFloat32Map()
+
+		for frame, weight := range weights {
+			m.Set(frame, weight)
+		}
+
+		return m
+	}
+
+	w := NewWriter()
+	w.AddArmature(data.skeleton)
+
+	morphWeights := map[string]animation.MorphAnimation{
+		"Cube": {"Smile": track(map[int]float32{1: 0, 3: 1}), "Frown": track(map[int]float32{})},
+		"Head": {"Blink": track(map[int]float32{2: 0.5})},
+	}
+
+	if err := w.AddClip("Face", data.skeleton, nil, morphWeights, 1, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+
+	if _, err := w.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(b.Bytes())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clip, err := r.Clip("Face")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// tracks are sampled at every frame of the clip, clamping outside their keys
+	want := map[string]map[string][]float32{
+		"Cube": {"Smile": {0, 0.5, 1, 1}, "Frown": {0, 0, 0, 0}},
+		"Head": {"Blink": {0.5, 0.5, 0.5, 0.5}},
+	}
+
+	if len(clip.MorphWeights) != len(want) {
+		t.Fatalf("got morph weights for %d meshes, want %d", len(clip.MorphWeights), len(want))
+	}
+
+	for meshName, targets := range want {
+		if len(clip.MorphWeights[meshName]) != len(targets) {
+			t.Fatalf("mesh %q has %d tracks, want %d", meshName, len(clip.MorphWeights[meshName]), len(targets))
+		}
+
+		for target, weights := range targets {
+			frames := clip.MorphWeights[meshName][target]
+
+			if frames == nil || len(frames.Keys()) != len(weights) {
+				t.Fatalf("mesh %q target %q is keyed at %v, want frames 1-4", meshName, target, frames)
+			}
+
+			for i, weight := range weights {
+				if got := frames.Get(1 + i); got != weight {
+					t.Errorf("mesh %q target %q frame %d is %g, want %g", meshName, target, 1+i, got, weight)
+				}
+			}
+		}
+	}
+}
+
+// the trump example loads a converted copy of the test data, which must be rebuilt whenever the format changes
+func TestTrumpAssetIsCurrent(t *testing.T) {
+	committed, err := ioutil.ReadFile("../trump/trump.hmxa")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(committed, writeTrump(t, readTrump(t))) {
+		t.Fatal("trump/trump.hmxa is out of date, rebuild it from ../testdata with the convert tool")
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	full := writeTrump(t, readTrump(t))
+
+	// every chunk is a whole number of 4 byte words, so the file has no trailing padding and any cut loses data
+	for length := 0; length < len(full); length++ {
+		if _, err := NewReader(full[:length]); err == nil {
+			t.Fatalf("a file cut to %d of %d bytes was read without error", length, len(full))
+		}
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	full := writeTrump(t, readTrump(t))
+
+	tests := []struct {
+		name    string
+		corrupt func(b []byte)
+	}{
+		{"bad magic", func(b []byte) { copy(b, "HMXB") }},
+		{"newer version", func(b []byte) { binary.LittleEndian.PutUint16(b[4:], Version+1) }},
+		{"chunk table overruns", func(b []byte) { binary.LittleEndian.PutUint32(b[8:], 1<<30) }},
+		{"misaligned chunk", func(b []byte) { binary.LittleEndian.PutUint32(b[headerSize+4:], 2) }},
+		{"chunk overruns", func(b []byte) { binary.LittleEndian.PutUint32(b[headerSize+8:], uint32(len(b))) }},
+		{"name overruns", func(b []byte) {
+			binary.LittleEndian.PutUint32(b[binary.LittleEndian.Uint32(b[headerSize+4:]):], 1<<30)
+		}},
+	}
+
+	for _, test := range tests {
+		b := append([]byte{}, full...)
+		test.corrupt(b)
+
+		if _, err := NewReader(b); err == nil {
+			t.Errorf("%s: read without error", test.name)
+		}
+	}
+}
+
+func TestCorruptPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		chunkType ChunkType
+		chunkName string
+		read      func(r *Reader) error
+	}{
+		{"mesh vertex count", ChunkMesh, "Cube", func(r *Reader) error { _, err := r.Mesh("Cube"); return err }},
+		{"skin armature name", ChunkSkin, "Cube", func(r *Reader) error { _, err := r.Skin("Cube"); return err }},
+		{"armature bone count", ChunkArmature, "Armature", func(r *Reader) error { _, err := r.Skeleton("Armature"); return err }},
+		{"clip armature name", ChunkClip, "ArmatureAction", func(r *Reader) error { _, err := r.Clip("ArmatureAction"); return err }},
+	}
+
+	full := writeTrump(t, readTrump(t))
+
+	for _, test := range tests {
+		r, err := NewReader(append([]byte{}, full...))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the first word of each of these payloads is a count or a string length
+		binary.LittleEndian.PutUint32(r.Find(test.chunkType, test.chunkName).Data, 1<<30)
+
+		if err := test.read(r); err == nil {
+			t.Errorf("%s: read without error", test.name)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+type chunk struct {
+	chunkType ChunkType
+	data      []byte
+}
+
+type Writer struct {
+	chunks []chunk
+}
+
+func NewWriter() *Writer {
+	return &Writer{chunks: []chunk{}}
+}
+
+type chunkBuffer struct {
+	bytes.Buffer
+}
+
+func newChunk(name string) *chunkBuffer {
+	b := &chunkBuffer{}
+	b.string(name)
+	return b
+}
+
+func (b *chunkBuffer) string(s string) {
+	binary.Write(b, binary.LittleEndian, uint32(len(s)))
+	b.WriteString(s)
+
+	for b.Len()%4 != 0 {
+		b.WriteByte(0)
+	}
+}
+
+func (b *chunkBuffer) uint32(v uint32) {
+	binary.Write(b, binary.LittleEndian, v)
+}
+
+func (b *chunkBuffer) int32(v int32) {
+	binary.Write(b, binary.LittleEndian, v)
+}
+
+func (b *chunkBuffer) floats(v []float32) {
+	binary.Write(b, binary.LittleEndian, v)
+}
+
+func (w *Writer) add(chunkType ChunkType, b *chunkBuffer) {
+	w.chunks = append(w.chunks, chunk{chunkType, b.Bytes()})
+}
+
+// AddMesh writes the mesh's vertex and index buffers. With a skeleton, which must be the one of the armature
+// the mesh is skinned to, a SKIN chunk is written too; morph targets go to a MRPH chunk.
+func (w *Writer) AddMesh(name string, mesh *animation.Mesh, skeleton *animation.Skeleton) error {
+	points := make([]float32, 0, len(mesh.Coordinates)*VertexStride)
+	skin := []float32{}
+	skinOffset := float32(0)
+
+	for i, coordinate := range mesh.Coordinates {
+		if len(coordinate.Vertices) < 3 || len(coordinate.Textures) < 2 {
+			return fmt.Errorf("asset: mesh %q coordinate %d needs 3 positions and 2 uvs", name, i)
+		}
+
+		skinLength := float32(0)
+
+		if skeleton != nil {
+			// sorted so the same mesh always produces the same bytes
+			boneNames := []string{}
+			for boneName := range coordinate.Skin {
+				boneNames = append(boneNames, boneName)
+			}
+			sort.Strings(boneNames)
+
+			for _, boneName := range boneNames {
+				boneIndex, present := skeleton.Index(boneName)
+
+				if !present {
+					return fmt.Errorf("asset: mesh %q coordinate %d is weighted to bone %q which is not in armature %q", name, i, boneName, skeleton.Name)
+				}
+
+				weight := coordinate.Skin[boneName]
+				if coordinate.TotalWeight != 0 {
+					weight /= coordinate.TotalWeight
+				}
+
+				skin = append(skin, float32(boneIndex), weight)
+			}
+
+			skinLength = float32(len(boneNames))
+		}
+
+		points = append(points,
+			coordinate.Vertices[0], coordinate.Vertices[1], coordinate.Vertices[2],
+			coordinate.Textures[0], 1-coordinate.Textures[1],
+			0, skinLength, skinOffset,
+		)
+
+		skinOffset += skinLength * 2
+	}
+
+	for _, index := range mesh.Indices {
+		if int(index) >= len(mesh.Coordinates) {
+			return fmt.Errorf("asset: mesh %q index %d is out of range for %d vertices", name, index, len(mesh.Coordinates))
+		}
+	}
+
+	b := newChunk(name)
+	b.uint32(uint32(len(mesh.Coordinates)))
+	b.uint32(uint32(len(mesh.Indices)))
+	b.floats(points)
+	binary.Write(b, binary.LittleEndian, mesh.Indices)
+	w.add(ChunkMesh, b)
+
+	if skeleton != nil {
+		b := newChunk(name)
+		b.string(skeleton.Name)
+		b.uint32(uint32(len(skin) / 2))
+		b.floats(skin)
+		w.add(ChunkSkin, b)
+	}
+
+	if len(mesh.MorphTargets) > 0 {
+		b := newChunk(name)
+		b.uint32(uint32(len(mesh.MorphTargets)))
+
+		for _, target := range mesh.MorphTargets {
+			b.string(target.Name)
+		}
+
+		b.uint32(uint32(len(mesh.Coordinates)))
+		b.floats(mesh.MorphDeltaBuffer())
+		w.add(ChunkMorph, b)
+	}
+
+	return nil
+}
+
+func (w *Writer) AddArmature(skeleton *animation.Skeleton) {
+	b := newChunk(skeleton.Name)
+	b.uint32(uint32(skeleton.Len()))
+
+	for i, boneName := range skeleton.Names {
+		b.string(boneName)
+		b.int32(int32(skeleton.Parent(i)))
+	}
+
+	for _, m := range skeleton.MatrixLocal {
+		b.floats(m.Get1D())
+	}
+
+	for _, m := range skeleton.MatrixLocalInverted {
+		b.floats(m.Get1D())
+	}
+
+	w.add(ChunkArmature, b)
+}
+
+// AddClip writes keyframes for every bone of the skeleton and every frame from startFrame to endFrame; bones
+// or frames without a key are written as the rest pose. morphWeights holds the clip's morph target weight
+// tracks by mesh name, sampled at every frame, and may be nil.
+func (w *Writer) AddClip(name string, skeleton *animation.Skeleton, keyframes map[string]*animation.IntToMatrix4fMap, morphWeights map[string]animation.MorphAnimation, startFrame, endFrame int64) error {
+	if endFrame < startFrame {
+		return fmt.Errorf("asset: clip %q ends at frame %d before it starts at %d", name, endFrame, startFrame)
+	}
+
+	for boneName := range keyframes {
+		if _, present := skeleton.Index(boneName); !present {
+			return fmt.Errorf("asset: clip %q has keyframes for bone %q which is not in armature %q", name, boneName, skeleton.Name)
+		}
+	}
+
+	b := newChunk(name)
+	b.string(skeleton.Name)
+	b.int32(int32(startFrame))
+	b.int32(int32(endFrame))
+	b.uint32(uint32(skeleton.Len()))
+
+	rest := (&animation.Matrix4f{M00: 1, M11: 1, M22: 1, M33: 1}).Get1D()
+
+	for _, boneName := range skeleton.Names {
+		frames := keyframes[boneName]
+
+		for frame := startFrame; frame <= endFrame; frame++ {
+			if frames != nil && frames.Get(int(frame)) != nil {
+				b.floats(frames.Get(int(frame)).Get1D())
+			} else {
+				b.floats(rest)
+			}
+		}
+	}
+
+	type track struct{ mesh, target string }
+	tracks := []track{}
+
+	for meshName, targets := range morphWeights {
+		for target := range targets {
+			tracks = append(tracks, track{meshName, target})
+		}
+	}
+
+	sort.Slice(tracks, func(i, j int) bool {
+		return tracks[i].mesh < tracks[j].mesh || tracks[i].mesh == tracks[j].mesh && tracks[i].target < tracks[j].target
+	})
+
+	b.uint32(uint32(len(tracks)))
+
+	for _, t := range tracks {
+		b.string(t.mesh)
+		b.string(t.target)
+
+		weights := make([]float32, endFrame-startFrame+1)
+
+		if frames := morphWeights[t.mesh][t.target]; frames != nil {
+			for i := range weights {
+				weights[i] = frames.Sample(float32(startFrame + int64(i)))
+			}
+		}
+
+		b.floats(weights)
+	}
+
+	w.add(ChunkClip, b)
+
+	return nil
+}
+
+// WriteTo writes the header, chunk table and chunks.
+func (w *Writer) WriteTo(out io.Writer) (int64, error) {
+	var b bytes.Buffer
+
+	b.Write(magic[:])
+	binary.Write(&b, binary.LittleEndian, uint16(Version))
+	binary.Write(&b, binary.LittleEndian, uint16(0))
+	binary.Write(&b, binary.LittleEndian, uint32(len(w.chunks)))
+	binary.Write(&b, binary.LittleEndian, uint32(0))
+
+	offset := headerSize + len(w.chunks)*chunkEntrySize
+
+	for _, c := range w.chunks {
+		b.Write(c.chunkType[:])
+		binary.Write(&b, binary.LittleEndian, []uint32{uint32(offset), uint32(len(c.data)), 0})
+		offset += pad4(len(c.data))
+	}
+
+	for _, c := range w.chunks {
+		b.Write(c.data)
+
+		for b.Len()%4 != 0 {
+			b.WriteByte(0)
+		}
+	}
+
+	n, err := out.Write(b.Bytes())
+
+	return int64(n), err
+}
@@ -0,0 +1,439 @@
+package bvh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+type Channel int
+
+const (
+	Xposition Channel = iota
+	Yposition
+	Zposition
+	Xrotation
+	Yrotation
+	Zrotation
+)
+
+var channelNames = map[string]Channel{
+	"Xposition": Xposition,
+	"Yposition": Yposition,
+	"Zposition": Zposition,
+	"Xrotation": Xrotation,
+	"Yrotation": Yrotation,
+	"Zrotation": Zrotation,
+}
+
+type Joint struct {
+	Name     string
+	Parent   string
+	Offset   animation.Vector3f
+	Channels []Channel
+}
+
+// Motion is a parsed BVH file. Keyframes are relative to the rest pose, frame 1 being the first line of motion
+// data, in the bone -> frame -> matrix shape NewSkinnedAnimation consumes.
+type Motion struct {
+	Armature   *animation.Armature
+	Joints     []*Joint // in file order, parents before children
+	Keyframes  map[string]*animation.IntToMatrix4fMap
+	StartFrame int64
+	EndFrame   int64
+	FrameTime  float32 // seconds per frame
+}
+
+func Load(path string) (*Motion, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Parse(f)
+}
+
+type tokenizer struct {
+	scanner *bufio.Scanner
+	line    int
+	pending []string
+}
+
+func (t *tokenizer) next() (string, error) {
+	for len(t.pending) == 0 {
+		if !t.scanner.Scan() {
+			if err := t.scanner.Err(); err != nil {
+				return "", err
+			}
+			return "", io.ErrUnexpectedEOF
+		}
+
+		t.line++
+		t.pending = strings.Fields(t.scanner.Text())
+	}
+
+	token := t.pending[0]
+	t.pending = t.pending[1:]
+
+	return token, nil
+}
+
+func (t *tokenizer) expect(expected string) error {
+	token, err := t.next()
+
+	if err != nil {
+		return err
+	}
+
+	if token != expected {
+		return fmt.Errorf("expected %q, got %q", expected, token)
+	}
+
+	return nil
+}
+
+func (t *tokenizer) float() (float32, error) {
+	token, err := t.next()
+
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := strconv.ParseFloat(token, 32)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", token)
+	}
+
+	return float32(v), nil
+}
+
+func Parse(r io.Reader) (*Motion, error) {
+	scanner := bufio.NewScanner(r)
+	// motion lines of large skeletons easily exceed the default 64k token limit
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+
+	t := &tokenizer{scanner: scanner}
+
+	m, err := parse(t)
+
+	if err != nil {
+		return nil, fmt.Errorf("bvh: line %d: %s", t.line, err.Error())
+	}
+
+	return m, nil
+}
+
+func parse(t *tokenizer) (*Motion, error) {
+	m := &Motion{
+		Joints:    []*Joint{},
+		Keyframes: map[string]*animation.IntToMatrix4fMap{},
+	}
+
+	if err := t.expect("HIERARCHY"); err != nil {
+		return nil, err
+	}
+
+	token, err := t.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for token == "ROOT" {
+		if err := parseJoint(t, m, ""); err != nil {
+			return nil, err
+		}
+
+		if token, err = t.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(m.Joints) == 0 {
+		return nil, fmt.Errorf("expected ROOT, got %q", token)
+	}
+
+	if token != "MOTION" {
+		return nil, fmt.Errorf("expected MOTION, got %q", token)
+	}
+
+	if err := t.expect("Frames:"); err != nil {
+		return nil, err
+	}
+
+	frames, err := t.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	frameCount, err := strconv.Atoi(frames)
+
+	if err != nil || frameCount < 0 {
+		return nil, fmt.Errorf("invalid frame count %q", frames)
+	}
+
+	if err := t.expect("Frame"); err != nil {
+		return nil, err
+	}
+
+	if err := t.expect("Time:"); err != nil {
+		return nil, err
+	}
+
+	if m.FrameTime, err = t.float(); err != nil {
+		return nil, err
+	}
+
+	if m.FrameTime <= 0 {
+		return nil, fmt.Errorf("frame time must be positive, got %v", m.FrameTime)
+	}
+
+	m.Armature = buildArmature(m.Joints)
+	m.StartFrame = 1
+	m.EndFrame = int64(frameCount)
+
+	for _, joint := range m.Joints {
+		m.Keyframes[joint.Name] = animation.NewIntToMatrix4fMap()
+	}
+
+	for frame := 1; frame <= frameCount; frame++ {
+		for _, joint := range m.Joints {
+			values := make([]float32, len(joint.Channels))
+
+			for i := range values {
+				if values[i], err = t.float(); err != nil {
+					return nil, fmt.Errorf("frame %d: %s", frame, err.Error())
+				}
+			}
+
+			m.Keyframes[joint.Name].Set(frame, jointBasis(joint, values))
+		}
+	}
+
+	return m, nil
+}
+
+func parseJoint(t *tokenizer, m *Motion, parent string) error {
+	name, err := t.next()
+
+	if err != nil {
+		return err
+	}
+
+	for _, joint := range m.Joints {
+		if joint.Name == name {
+			return fmt.Errorf("joint %q appears more than once", name)
+		}
+	}
+
+	joint := &Joint{Name: name, Parent: parent, Channels: []Channel{}}
+	m.Joints = append(m.Joints, joint)
+
+	if err := t.expect("{"); err != nil {
+		return err
+	}
+
+	for {
+		token, err := t.next()
+
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case "OFFSET":
+			if joint.Offset, err = parseVector(t); err != nil {
+				return err
+			}
+		case "CHANNELS":
+			count, err := t.next()
+
+			if err != nil {
+				return err
+			}
+
+			n, err := strconv.Atoi(count)
+
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid channel count %q", count)
+			}
+
+			for i := 0; i < n; i++ {
+				channelName, err := t.next()
+
+				if err != nil {
+					return err
+				}
+
+				channel, present := channelNames[channelName]
+
+				if !present {
+					return fmt.Errorf("joint %q has unknown channel %q", name, channelName)
+				}
+
+				joint.Channels = append(joint.Channels, channel)
+			}
+		case "JOINT":
+			if err := parseJoint(t, m, name); err != nil {
+				return err
+			}
+		case "End":
+			// end sites only mark the tip of the last bone, they carry no channels
+			if err := t.expect("Site"); err != nil {
+				return err
+			}
+
+			if err := t.expect("{"); err != nil {
+				return err
+			}
+
+			if err := t.expect("OFFSET"); err != nil {
+				return err
+			}
+
+			if _, err := parseVector(t); err != nil {
+				return err
+			}
+
+			if err := t.expect("}"); err != nil {
+				return err
+			}
+		case "}":
+			return nil
+		default:
+			return fmt.Errorf("unexpected %q in joint %q", token, name)
+		}
+	}
+}
+
+func parseVector(t *tokenizer) (animation.Vector3f, error) {
+	var values [3]float32
+
+	for i := range values {
+		v, err := t.float()
+
+		if err != nil {
+			return animation.Vector3f{}, err
+		}
+
+		values[i] = v
+	}
+
+	return animation.Vector3f{X: values[0], Y: values[1], Z: values[2]}, nil
+}
+
+// buildArmature places every bone at the sum of its parents' offsets; BVH rest poses carry no rotation.
+func buildArmature(joints []*Joint) *animation.Armature {
+	armature := &animation.Armature{
+		Name:  joints[0].Name,
+		Bones: map[string]*animation.Bone{},
+	}
+
+	heads := map[string]animation.Vector3f{}
+
+	for _, joint := range joints {
+		head := joint.Offset
+
+		if joint.Parent != "" {
+			head = heads[joint.Parent].Add(joint.Offset)
+		}
+
+		heads[joint.Name] = head
+
+		armature.Bones[joint.Name] = &animation.Bone{
+			Name:                joint.Name,
+			ParentName:          joint.Parent,
+			MatrixLocal:         animation.NewTranslationMatrix(head),
+			MatrixLocalInverted: animation.NewTranslationMatrix(head.Scale(-1)),
+		}
+	}
+
+	return armature
+}
+
+// jointBasis turns one frame of channel values into a matrix relative to the joint's rest pose. Position
+// channels add to the offset and rotations, in degrees, apply in the order the channels are listed.
+func jointBasis(joint *Joint, values []float32) *animation.Matrix4f {
+	translation := animation.Vector3f{}
+	rotation := animation.IdentityQuaternion()
+
+	for i, channel := range joint.Channels {
+		switch channel {
+		case Xposition:
+			translation.X += values[i]
+		case Yposition:
+			translation.Y += values[i]
+		case Zposition:
+			translation.Z += values[i]
+		case Xrotation:
+			rotation = rotation.Mul(axisRotation(animation.Vector3f{X: 1}, values[i]))
+		case Yrotation:
+			rotation = rotation.Mul(axisRotation(animation.Vector3f{Y: 1}, values[i]))
+		case Zrotation:
+			rotation = rotation.Mul(axisRotation(animation.Vector3f{Z: 1}, values[i]))
+		}
+	}
+
+	// the rest pose is a pure translation by the offset, so relative to it only the channels remain
+	return animation.Transform{
+		Translation: translation,
+		Rotation:    rotation,
+		Scale:       animation.Vector3f{X: 1, Y: 1, Z: 1},
+	}.Matrix()
+}
+
+func axisRotation(axis animation.Vector3f, degrees float32) animation.Quaternion {
+	return animation.QuaternionFromAxisAngle(axis, degrees*math.Pi/180)
+}
+
+// FPS is the frame rate the motion was captured at.
+func (m *Motion) FPS() float32 {
+	return 1 / m.FrameTime
+}
+
+// Resample returns the keyframes at a different frame rate, interpolating between captured frames, so
+// mocap recorded at e.g. 120fps plays at the right speed in a loop stepping one frame per tick.
+func (m *Motion) Resample(fps float32) (map[string]*animation.IntToMatrix4fMap, int64) {
+	if fps <= 0 || m.EndFrame < m.StartFrame {
+		return m.Keyframes, m.EndFrame
+	}
+
+	duration := float32(m.EndFrame-m.StartFrame) * m.FrameTime
+	endFrame := int64(math.Round(float64(duration*fps))) + 1
+	keyframes := map[string]*animation.IntToMatrix4fMap{}
+
+	for boneName, frames := range m.Keyframes {
+		resampled := animation.NewIntToMatrix4fMap()
+
+		for frame := int64(1); frame <= endFrame; frame++ {
+			source := float32(frame-1) / fps / m.FrameTime
+			before := m.StartFrame + int64(source)
+			weight := source - float32(int64(source))
+
+			if before >= m.EndFrame {
+				resampled.Set(int(frame), frames.Get(int(m.EndFrame)))
+				continue
+			}
+
+			a := animation.DecomposeMatrix(frames.Get(int(before)))
+			b := animation.DecomposeMatrix(frames.Get(int(before + 1)))
+
+			resampled.Set(int(frame), a.Lerp(b, weight).Matrix())
+		}
+
+		keyframes[boneName] = resampled
+	}
+
+	return keyframes, endFrame
+}
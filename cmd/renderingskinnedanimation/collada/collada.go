@@ -0,0 +1,206 @@
+package collada
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+// the subset of the COLLADA 1.4 schema the importer reads
+
+type document struct {
+	XMLName      xml.Name           `xml:"COLLADA"`
+	UpAxis       string             `xml:"asset>up_axis"`
+	Geometries   []geometry         `xml:"library_geometries>geometry"`
+	Controllers  []controller       `xml:"library_controllers>controller"`
+	Animations   []animationElement `xml:"library_animations>animation"`
+	VisualScenes []visualScene      `xml:"library_visual_scenes>visual_scene"`
+}
+
+type source struct {
+	ID         string       `xml:"id,attr"`
+	FloatArray *valueArray  `xml:"float_array"`
+	NameArray  *valueArray  `xml:"Name_array"`
+	IDREFArray *valueArray  `xml:"IDREF_array"`
+	Accessor   sourceAccess `xml:"technique_common>accessor"`
+}
+
+type valueArray struct {
+	Count int    `xml:"count,attr"`
+	Text  string `xml:",chardata"`
+}
+
+type sourceAccess struct {
+	Count  int `xml:"count,attr"`
+	Stride int `xml:"stride,attr"`
+}
+
+type input struct {
+	Semantic string `xml:"semantic,attr"`
+	Source   string `xml:"source,attr"`
+	Offset   int    `xml:"offset,attr"`
+	Set      int    `xml:"set,attr"`
+}
+
+type geometry struct {
+	ID   string       `xml:"id,attr"`
+	Name string       `xml:"name,attr"`
+	Mesh *meshElement `xml:"mesh"`
+}
+
+type meshElement struct {
+	Sources   []source           `xml:"source"`
+	Vertices  verticesElement    `xml:"vertices"`
+	Triangles []primitiveElement `xml:"triangles"`
+	Polylists []primitiveElement `xml:"polylist"`
+}
+
+type verticesElement struct {
+	ID     string  `xml:"id,attr"`
+	Inputs []input `xml:"input"`
+}
+
+type primitiveElement struct {
+	Count    int     `xml:"count,attr"`
+	Material string  `xml:"material,attr"`
+	Inputs   []input `xml:"input"`
+	VCount   string  `xml:"vcount"`
+	P        string  `xml:"p"`
+}
+
+type controller struct {
+	ID   string       `xml:"id,attr"`
+	Name string       `xml:"name,attr"`
+	Skin *skinElement `xml:"skin"`
+}
+
+type skinElement struct {
+	Source          string   `xml:"source,attr"`
+	BindShapeMatrix string   `xml:"bind_shape_matrix"`
+	Sources         []source `xml:"source"`
+	Joints          struct {
+		Inputs []input `xml:"input"`
+	} `xml:"joints"`
+	VertexWeights struct {
+		Count  int     `xml:"count,attr"`
+		Inputs []input `xml:"input"`
+		VCount string  `xml:"vcount"`
+		V      string  `xml:"v"`
+	} `xml:"vertex_weights"`
+}
+
+type animationElement struct {
+	ID       string             `xml:"id,attr"`
+	Sources  []source           `xml:"source"`
+	Samplers []samplerElement   `xml:"sampler"`
+	Channels []channelElement   `xml:"channel"`
+	Children []animationElement `xml:"animation"`
+}
+
+type samplerElement struct {
+	ID     string  `xml:"id,attr"`
+	Inputs []input `xml:"input"`
+}
+
+type channelElement struct {
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+type visualScene struct {
+	Nodes []*nodeElement `xml:"node"`
+}
+
+type nodeElement struct {
+	ID                  string               `xml:"id,attr"`
+	SID                 string               `xml:"sid,attr"`
+	Name                string               `xml:"name,attr"`
+	Type                string               `xml:"type,attr"`
+	Children            []*nodeElement       `xml:"node"`
+	InstanceControllers []instanceController `xml:"instance_controller"`
+	InstanceGeometries  []instanceGeometry   `xml:"instance_geometry"`
+	// every other child element, in document order, so matrix, translate, rotate and scale compose correctly
+	Elements []transformElement `xml:",any"`
+
+	parent *nodeElement
+}
+
+type transformElement struct {
+	XMLName xml.Name
+	SID     string `xml:"sid,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type instanceController struct {
+	URL       string   `xml:"url,attr"`
+	Skeletons []string `xml:"skeleton"`
+}
+
+type instanceGeometry struct {
+	URL string `xml:"url,attr"`
+}
+
+func parseFloats(text string) ([]float32, error) {
+	fields := strings.Fields(text)
+	values := make([]float32, len(fields))
+
+	for i, field := range fields {
+		v, err := strconv.ParseFloat(field, 32)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+
+		values[i] = float32(v)
+	}
+
+	return values, nil
+}
+
+func parseInts(text string) ([]int, error) {
+	fields := strings.Fields(text)
+	values := make([]int, len(fields))
+
+	for i, field := range fields {
+		v, err := strconv.Atoi(field)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", field)
+		}
+
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+// matrixFromRowMajor reads 16 values; COLLADA writes matrices row by row, the same layout as Matrix4f.
+func matrixFromRowMajor(v []float32) *animation.Matrix4f {
+	return &animation.Matrix4f{
+		M00: v[0], M01: v[1], M02: v[2], M03: v[3],
+		M10: v[4], M11: v[5], M12: v[6], M13: v[7],
+		M20: v[8], M21: v[9], M22: v[10], M23: v[11],
+		M30: v[12], M31: v[13], M32: v[14], M33: v[15],
+	}
+}
+
+func parseMatrix(text string) (*animation.Matrix4f, error) {
+	values, err := parseFloats(text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) != 16 {
+		return nil, fmt.Errorf("matrix has %d values, expected 16", len(values))
+	}
+
+	return matrixFromRowMajor(values), nil
+}
+
+func identity() *animation.Matrix4f {
+	return &animation.Matrix4f{M00: 1, M11: 1, M22: 1, M33: 1}
+}
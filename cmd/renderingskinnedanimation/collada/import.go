@@ -0,0 +1,1007 @@
+package collada
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+const DefaultFPS = 24
+
+type Clip struct {
+	StartFrame int64
+	EndFrame   int64
+	FPS        int64
+	// Keyframes holds a matrix per bone for every frame, relative to the bone's rest pose, in the
+	// bone -> frame -> matrix shape NewSkinnedAnimation consumes.
+	Keyframes map[string]*animation.IntToMatrix4fMap
+}
+
+// Scene holds what was imported from a .dae file. Meshes are keyed by geometry name and static meshes are
+// left in their own space, the transforms of the nodes instancing them are not applied.
+type Scene struct {
+	Meshes        map[string]*animation.Mesh
+	Armatures     map[string]*animation.Armature
+	MeshArmatures map[string]string // mesh name -> name of the armature that skins it
+	Clip          *Clip
+}
+
+type importer struct {
+	doc *document
+	fps int64
+
+	// upAxis converts from the file's up axis to the engine's Y up
+	upAxis        *animation.Matrix4f
+	upAxisInverse *animation.Matrix4f
+
+	nodesByID  map[string]*nodeElement
+	nodesBySID map[string]*nodeElement
+	nodes      []*nodeElement
+	boneNames  map[*nodeElement]string
+	geometries map[string]*geometry
+	jointRests map[*nodeElement]*animation.Bone
+	jointBones map[*nodeElement]*animation.Armature
+
+	// animated matrix elements: node -> transform sid -> sampler
+	channels map[*nodeElement]map[string]*matrixSampler
+}
+
+type matrixSampler struct {
+	times         []float32
+	matrices      []*animation.Matrix4f
+	interpolation []string
+}
+
+// Load imports a .dae file, sampling its animation at fps frames per second.
+func Load(path string, fps int64) (*Scene, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Parse(f, fps)
+}
+
+func Parse(r io.Reader, fps int64) (*Scene, error) {
+	if fps <= 0 {
+		fps = DefaultFPS
+	}
+
+	doc := &document{}
+
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, fmt.Errorf("collada: %s", err.Error())
+	}
+
+	im := &importer{
+		doc:        doc,
+		fps:        fps,
+		nodesByID:  map[string]*nodeElement{},
+		nodesBySID: map[string]*nodeElement{},
+		boneNames:  map[*nodeElement]string{},
+		geometries: map[string]*geometry{},
+		jointRests: map[*nodeElement]*animation.Bone{},
+		jointBones: map[*nodeElement]*animation.Armature{},
+		channels:   map[*nodeElement]map[string]*matrixSampler{},
+	}
+
+	switch strings.TrimSpace(doc.UpAxis) {
+	case "Z_UP":
+		// (x, y, z) -> (x, z, -y)
+		im.upAxis = &animation.Matrix4f{M00: 1, M12: 1, M21: -1, M33: 1}
+	case "X_UP":
+		// (x, y, z) -> (-y, x, z)
+		im.upAxis = &animation.Matrix4f{M01: -1, M10: 1, M22: 1, M33: 1}
+	default:
+		im.upAxis = identity()
+	}
+
+	im.upAxisInverse = im.upAxis.Inverse()
+
+	scene, err := im.importScene()
+
+	if err != nil {
+		return nil, fmt.Errorf("collada: %s", err.Error())
+	}
+
+	return scene, nil
+}
+
+// convert re-expresses a transform from the file's axes in the engine's, the same change of basis that
+// convertPoint applies to positions.
+func (im *importer) convert(m *animation.Matrix4f) *animation.Matrix4f {
+	return im.upAxis.Mul(m).Mul(im.upAxisInverse)
+}
+
+func (im *importer) convertPoint(x, y, z float32) []float32 {
+	p := im.upAxis.TransformPoint(animation.Vector3f{X: x, Y: y, Z: z})
+	return []float32{p.X, p.Y, p.Z}
+}
+
+func (im *importer) importScene() (*Scene, error) {
+	scene := &Scene{
+		Meshes:        map[string]*animation.Mesh{},
+		Armatures:     map[string]*animation.Armature{},
+		MeshArmatures: map[string]string{},
+	}
+
+	for i := range im.doc.Geometries {
+		g := &im.doc.Geometries[i]
+		im.geometries[g.ID] = g
+	}
+
+	var visit func(n, parent *nodeElement)
+	visit = func(n, parent *nodeElement) {
+		n.parent = parent
+		im.nodes = append(im.nodes, n)
+
+		if n.ID != "" {
+			im.nodesByID[n.ID] = n
+		}
+
+		if n.SID != "" {
+			im.nodesBySID[n.SID] = n
+		}
+
+		for _, child := range n.Children {
+			visit(child, n)
+		}
+	}
+
+	for _, vs := range im.doc.VisualScenes {
+		for _, n := range vs.Nodes {
+			visit(n, nil)
+		}
+	}
+
+	im.nameNodes()
+
+	if err := im.readAnimations(im.doc.Animations); err != nil {
+		return nil, err
+	}
+
+	skinned := map[string]bool{}
+
+	for _, n := range im.nodes {
+		for _, instance := range n.InstanceControllers {
+			c := im.controller(instance.URL)
+
+			if c == nil || c.Skin == nil {
+				return nil, fmt.Errorf("node %q instances controller %q which is not a skin", n.ID, instance.URL)
+			}
+
+			mesh, armature, err := im.importSkin(c)
+
+			if err != nil {
+				return nil, fmt.Errorf("controller %q: %s", c.ID, err.Error())
+			}
+
+			meshName := im.geometryName(strings.TrimPrefix(c.Skin.Source, "#"))
+
+			scene.Meshes[meshName] = mesh
+			scene.Armatures[armature.Name] = armature
+			scene.MeshArmatures[meshName] = armature.Name
+			skinned[strings.TrimPrefix(c.Skin.Source, "#")] = true
+		}
+	}
+
+	for _, g := range im.doc.Geometries {
+		if skinned[g.ID] || g.Mesh == nil {
+			continue
+		}
+
+		mesh, err := im.importGeometry(&g, nil, nil)
+
+		if err != nil {
+			return nil, fmt.Errorf("geometry %q: %s", g.ID, err.Error())
+		}
+
+		scene.Meshes[im.geometryName(g.ID)] = mesh
+	}
+
+	clip, err := im.importClip()
+
+	if err != nil {
+		return nil, err
+	}
+
+	scene.Clip = clip
+
+	return scene, nil
+}
+
+// nameNodes names bones after the node's name, falling back to its sid and id, keeping names unique.
+func (im *importer) nameNodes() {
+	used := map[string]bool{}
+
+	for i, n := range im.nodes {
+		name := n.Name
+
+		if name == "" {
+			name = n.SID
+		}
+
+		if name == "" {
+			name = n.ID
+		}
+
+		if name == "" {
+			name = fmt.Sprintf("node%d", i)
+		}
+
+		unique := name
+		for suffix := 1; used[unique]; suffix++ {
+			unique = fmt.Sprintf("%s.%03d", name, suffix)
+		}
+
+		used[unique] = true
+		im.boneNames[n] = unique
+	}
+}
+
+func (im *importer) geometryName(id string) string {
+	if g := im.geometries[id]; g != nil && g.Name != "" {
+		return g.Name
+	}
+
+	return id
+}
+
+func (im *importer) controller(url string) *controller {
+	id := strings.TrimPrefix(url, "#")
+
+	for i := range im.doc.Controllers {
+		if im.doc.Controllers[i].ID == id {
+			return &im.doc.Controllers[i]
+		}
+	}
+
+	return nil
+}
+
+func findSource(sources []source, url string) *source {
+	id := strings.TrimPrefix(url, "#")
+
+	for i := range sources {
+		if sources[i].ID == id {
+			return &sources[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *source) floats() ([]float32, int, error) {
+	if s.FloatArray == nil {
+		return nil, 0, fmt.Errorf("source %q has no float_array", s.ID)
+	}
+
+	values, err := parseFloats(s.FloatArray.Text)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("source %q: %s", s.ID, err.Error())
+	}
+
+	stride := s.Accessor.Stride
+	if stride <= 0 {
+		stride = 1
+	}
+
+	return values, stride, nil
+}
+
+func (s *source) names() []string {
+	switch {
+	case s.NameArray != nil:
+		return strings.Fields(s.NameArray.Text)
+	case s.IDREFArray != nil:
+		return strings.Fields(s.IDREFArray.Text)
+	}
+
+	return nil
+}
+
+// nodeLocal composes the node's transform elements in order; animated matrices are looked up with sample.
+func (im *importer) nodeLocal(n *nodeElement, sample func(*matrixSampler) *animation.Matrix4f) (*animation.Matrix4f, error) {
+	m := identity()
+
+	for _, e := range n.Elements {
+		values, err := parseFloats(e.Text)
+
+		if err != nil {
+			return nil, fmt.Errorf("node %q %s: %s", n.ID, e.XMLName.Local, err.Error())
+		}
+
+		var t *animation.Matrix4f
+
+		switch e.XMLName.Local {
+		case "matrix":
+			if len(values) != 16 {
+				return nil, fmt.Errorf("node %q matrix has %d values", n.ID, len(values))
+			}
+
+			t = matrixFromRowMajor(values)
+
+			if s := im.channels[n][e.SID]; s != nil && sample != nil {
+				t = sample(s)
+			}
+		case "translate":
+			if len(values) != 3 {
+				return nil, fmt.Errorf("node %q translate has %d values", n.ID, len(values))
+			}
+
+			t = animation.NewTranslationMatrix(animation.Vector3f{X: values[0], Y: values[1], Z: values[2]})
+		case "rotate":
+			if len(values) != 4 {
+				return nil, fmt.Errorf("node %q rotate has %d values", n.ID, len(values))
+			}
+
+			axis := animation.Vector3f{X: values[0], Y: values[1], Z: values[2]}.Normalize()
+			t = animation.Transform{
+				Rotation: animation.QuaternionFromAxisAngle(axis, values[3]*math.Pi/180),
+				Scale:    animation.Vector3f{X: 1, Y: 1, Z: 1},
+			}.Matrix()
+		case "scale":
+			if len(values) != 3 {
+				return nil, fmt.Errorf("node %q scale has %d values", n.ID, len(values))
+			}
+
+			t = &animation.Matrix4f{M00: values[0], M11: values[1], M22: values[2], M33: 1}
+		default:
+			continue
+		}
+
+		m = m.Mul(t)
+	}
+
+	return im.convert(m), nil
+}
+
+func (im *importer) nodeGlobal(n *nodeElement, sample func(*matrixSampler) *animation.Matrix4f) (*animation.Matrix4f, error) {
+	m, err := im.nodeLocal(n, sample)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for parent := n.parent; parent != nil; parent = parent.parent {
+		local, err := im.nodeLocal(parent, sample)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m = local.Mul(m)
+	}
+
+	return m, nil
+}
+
+// jointNode finds the node a skin's joint name refers to: Blender and most exporters write sids, some ids.
+func (im *importer) jointNode(name string) *nodeElement {
+	if n := im.nodesBySID[name]; n != nil {
+		return n
+	}
+
+	return im.nodesByID[name]
+}
+
+func (im *importer) importSkin(c *controller) (*animation.Mesh, *animation.Armature, error) {
+	skin := c.Skin
+
+	var jointNames []string
+	var inverseBindMatrices []float32
+
+	for _, in := range skin.Joints.Inputs {
+		s := findSource(skin.Sources, in.Source)
+
+		if s == nil {
+			return nil, nil, fmt.Errorf("joints input %q does not exist", in.Source)
+		}
+
+		switch in.Semantic {
+		case "JOINT":
+			jointNames = s.names()
+		case "INV_BIND_MATRIX":
+			values, _, err := s.floats()
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			inverseBindMatrices = values
+		}
+	}
+
+	if len(inverseBindMatrices) < len(jointNames)*16 {
+		return nil, nil, fmt.Errorf("skin needs an inverse bind matrix per joint")
+	}
+
+	name := c.Name
+	if name == "" {
+		name = c.ID
+	}
+
+	armature := &animation.Armature{
+		Name:  name,
+		Bones: map[string]*animation.Bone{},
+	}
+
+	joints := []*nodeElement{}
+	isJoint := map[*nodeElement]bool{}
+
+	for _, jointName := range jointNames {
+		n := im.jointNode(jointName)
+
+		if n == nil {
+			return nil, nil, fmt.Errorf("joint %q does not exist in the visual scene", jointName)
+		}
+
+		joints = append(joints, n)
+		isJoint[n] = true
+	}
+
+	for j, n := range joints {
+		bone := &animation.Bone{
+			Name:                im.boneNames[n],
+			MatrixLocalInverted: im.convert(matrixFromRowMajor(inverseBindMatrices[j*16 : j*16+16])),
+		}
+
+		bone.MatrixLocal = bone.MatrixLocalInverted.Inverse()
+
+		if bone.MatrixLocal == nil {
+			return nil, nil, fmt.Errorf("joint %q has a singular inverse bind matrix", bone.Name)
+		}
+
+		// the parent bone is the nearest ancestor node that is also a joint of this skin
+		for parent := n.parent; parent != nil; parent = parent.parent {
+			if isJoint[parent] {
+				bone.ParentName = im.boneNames[parent]
+				break
+			}
+		}
+
+		armature.Bones[bone.Name] = bone
+
+		if _, present := im.jointRests[n]; !present {
+			im.jointRests[n] = bone
+			im.jointBones[n] = armature
+		}
+	}
+
+	weights, err := im.vertexWeights(skin, jointNames, joints)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := im.geometries[strings.TrimPrefix(skin.Source, "#")]
+
+	if g == nil || g.Mesh == nil {
+		return nil, nil, fmt.Errorf("skin source %q is not a mesh geometry", skin.Source)
+	}
+
+	bindShape := identity()
+
+	if strings.TrimSpace(skin.BindShapeMatrix) != "" {
+		if bindShape, err = parseMatrix(skin.BindShapeMatrix); err != nil {
+			return nil, nil, fmt.Errorf("bind_shape_matrix: %s", err.Error())
+		}
+	}
+
+	mesh, err := im.importGeometry(g, bindShape, func(position int) (map[string]float32, error) {
+		if position >= len(weights) {
+			return nil, fmt.Errorf("position %d has no vertex weights", position)
+		}
+		return weights[position], nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mesh, armature, nil
+}
+
+// vertexWeights returns the bone weights of every position of the skinned geometry.
+func (im *importer) vertexWeights(skin *skinElement, jointNames []string, joints []*nodeElement) ([]map[string]float32, error) {
+	vw := skin.VertexWeights
+
+	jointOffset, weightOffset := -1, -1
+	var weightValues []float32
+	stride := 0
+
+	for _, in := range vw.Inputs {
+		if in.Offset+1 > stride {
+			stride = in.Offset + 1
+		}
+
+		switch in.Semantic {
+		case "JOINT":
+			jointOffset = in.Offset
+		case "WEIGHT":
+			weightOffset = in.Offset
+
+			s := findSource(skin.Sources, in.Source)
+
+			if s == nil {
+				return nil, fmt.Errorf("weight source %q does not exist", in.Source)
+			}
+
+			values, _, err := s.floats()
+
+			if err != nil {
+				return nil, err
+			}
+
+			weightValues = values
+		}
+	}
+
+	if jointOffset < 0 || weightOffset < 0 {
+		return nil, fmt.Errorf("vertex_weights needs JOINT and WEIGHT inputs")
+	}
+
+	counts, err := parseInts(vw.VCount)
+
+	if err != nil {
+		return nil, fmt.Errorf("vertex_weights vcount: %s", err.Error())
+	}
+
+	v, err := parseInts(vw.V)
+
+	if err != nil {
+		return nil, fmt.Errorf("vertex_weights v: %s", err.Error())
+	}
+
+	weights := make([]map[string]float32, len(counts))
+	offset := 0
+
+	for position, count := range counts {
+		weights[position] = map[string]float32{}
+
+		for k := 0; k < count; k++ {
+			if offset+stride > len(v) {
+				return nil, fmt.Errorf("vertex_weights v is shorter than vcount needs")
+			}
+
+			joint := v[offset+jointOffset]
+			weightIndex := v[offset+weightOffset]
+			offset += stride
+
+			// joint -1 binds to the bind shape itself, which has no bone in the engine
+			if joint < 0 {
+				continue
+			}
+
+			if joint >= len(joints) || weightIndex < 0 || weightIndex >= len(weightValues) {
+				return nil, fmt.Errorf("vertex_weights for position %d are out of range", position)
+			}
+
+			weights[position][im.boneNames[joints[joint]]] += weightValues[weightIndex]
+		}
+	}
+
+	return weights, nil
+}
+
+type vertexKey struct {
+	position, texture int
+}
+
+// importGeometry reads every triangle and polylist set of the geometry into one mesh. Positions are
+// transformed by the bind shape matrix of a skin, and converted to Y up.
+func (im *importer) importGeometry(g *geometry, bindShape *animation.Matrix4f, skinWeights func(int) (map[string]float32, error)) (*animation.Mesh, error) {
+	m := g.Mesh
+
+	var positionSource *source
+
+	for _, in := range m.Vertices.Inputs {
+		if in.Semantic == "POSITION" {
+			positionSource = findSource(m.Sources, in.Source)
+		}
+	}
+
+	if positionSource == nil {
+		return nil, fmt.Errorf("vertices have no POSITION input")
+	}
+
+	positions, positionStride, err := positionSource.floats()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if positionStride < 3 {
+		return nil, fmt.Errorf("positions need 3 components")
+	}
+
+	if bindShape == nil {
+		bindShape = identity()
+	}
+
+	mesh := &animation.Mesh{
+		Indices:      []uint32{},
+		Coordinates:  []animation.Coordinate{},
+		MorphTargets: []animation.MorphTarget{},
+	}
+
+	vertices := map[vertexKey]uint32{}
+
+	sets := append(append([]primitiveElement{}, m.Triangles...), m.Polylists...)
+
+	for _, set := range sets {
+		vertexOffset, textureOffset := -1, -1
+		var textures []float32
+		textureStride := 0
+		stride := 0
+
+		for _, in := range set.Inputs {
+			if in.Offset+1 > stride {
+				stride = in.Offset + 1
+			}
+
+			switch in.Semantic {
+			case "VERTEX":
+				vertexOffset = in.Offset
+			case "TEXCOORD":
+				// only the first uv set is used
+				if textureOffset >= 0 {
+					continue
+				}
+
+				s := findSource(m.Sources, in.Source)
+
+				if s == nil {
+					return nil, fmt.Errorf("texcoord source %q does not exist", in.Source)
+				}
+
+				if textures, textureStride, err = s.floats(); err != nil {
+					return nil, err
+				}
+
+				if textureStride < 2 {
+					return nil, fmt.Errorf("texcoords need 2 components")
+				}
+
+				textureOffset = in.Offset
+			}
+		}
+
+		if vertexOffset < 0 {
+			return nil, fmt.Errorf("primitive has no VERTEX input")
+		}
+
+		p, err := parseInts(set.P)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// triangles have an implicit vcount of 3 per polygon
+		counts := []int{}
+
+		if set.VCount != "" {
+			if counts, err = parseInts(set.VCount); err != nil {
+				return nil, err
+			}
+		} else {
+			for i := 0; i < len(p)/stride/3; i++ {
+				counts = append(counts, 3)
+			}
+		}
+
+		offset := 0
+
+		for _, count := range counts {
+			polygon := []uint32{}
+
+			for k := 0; k < count; k++ {
+				if offset+stride > len(p) {
+					return nil, fmt.Errorf("p is shorter than the polygon counts need")
+				}
+
+				key := vertexKey{p[offset+vertexOffset], -1}
+				if textureOffset >= 0 {
+					key.texture = p[offset+textureOffset]
+				}
+				offset += stride
+
+				index, present := vertices[key]
+
+				if !present {
+					if key.position < 0 || (key.position+1)*positionStride > len(positions) {
+						return nil, fmt.Errorf("position %d is out of range", key.position)
+					}
+
+					if key.texture >= 0 && (key.texture+1)*textureStride > len(textures) {
+						return nil, fmt.Errorf("texcoord %d is out of range", key.texture)
+					}
+
+					coordinate, err := im.coordinate(key, positions[key.position*positionStride:], textures, textureStride, bindShape, skinWeights)
+
+					if err != nil {
+						return nil, err
+					}
+
+					index = uint32(len(mesh.Coordinates))
+					coordinate.Index = int(index)
+					mesh.Coordinates = append(mesh.Coordinates, coordinate)
+					vertices[key] = index
+				}
+
+				polygon = append(polygon, index)
+			}
+
+			for k := 1; k+1 < len(polygon); k++ {
+				mesh.Indices = append(mesh.Indices, polygon[0], polygon[k], polygon[k+1])
+			}
+		}
+	}
+
+	return mesh, nil
+}
+
+func (im *importer) coordinate(key vertexKey, position []float32, textures []float32, textureStride int, bindShape *animation.Matrix4f, skinWeights func(int) (map[string]float32, error)) (animation.Coordinate, error) {
+	p := bindShape.TransformPoint(animation.Vector3f{X: position[0], Y: position[1], Z: position[2]})
+
+	coordinate := animation.Coordinate{
+		Vertices: im.convertPoint(p.X, p.Y, p.Z),
+		Textures: []float32{0, 0},
+		Skin:     map[string]float32{},
+	}
+
+	if key.texture >= 0 {
+		coordinate.Textures = []float32{textures[key.texture*textureStride], textures[key.texture*textureStride+1]}
+	}
+
+	if skinWeights != nil {
+		weights, err := skinWeights(key.position)
+
+		if err != nil {
+			return coordinate, err
+		}
+
+		for boneName, weight := range weights {
+			coordinate.Skin[boneName] = weight
+			coordinate.TotalWeight += weight
+		}
+	}
+
+	return coordinate, nil
+}
+
+func (im *importer) readAnimations(animations []animationElement) error {
+	for _, a := range animations {
+		for _, ch := range a.Channels {
+			slash := strings.Index(ch.Target, "/")
+
+			if slash < 0 {
+				return fmt.Errorf("animation %q has an invalid channel target %q", a.ID, ch.Target)
+			}
+
+			n := im.nodesByID[ch.Target[:slash]]
+
+			if n == nil {
+				return fmt.Errorf("animation %q targets node %q which does not exist", a.ID, ch.Target[:slash])
+			}
+
+			sid := ch.Target[slash+1:]
+			isMatrix := false
+
+			for _, e := range n.Elements {
+				if e.SID == sid && e.XMLName.Local == "matrix" {
+					isMatrix = true
+				}
+			}
+
+			// exporters bake to whole matrices; channels on single components of translate or rotate are not read
+			if !isMatrix {
+				return fmt.Errorf("animation %q targets %q which is not a matrix, only matrix channels are supported", a.ID, ch.Target)
+			}
+
+			s, err := readMatrixSampler(a, ch.Source)
+
+			if err != nil {
+				return fmt.Errorf("animation %q: %s", a.ID, err.Error())
+			}
+
+			if im.channels[n] == nil {
+				im.channels[n] = map[string]*matrixSampler{}
+			}
+
+			im.channels[n][sid] = s
+		}
+
+		if err := im.readAnimations(a.Children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readMatrixSampler(a animationElement, url string) (*matrixSampler, error) {
+	id := strings.TrimPrefix(url, "#")
+
+	for _, sampler := range a.Samplers {
+		if sampler.ID != id {
+			continue
+		}
+
+		s := &matrixSampler{}
+
+		for _, in := range sampler.Inputs {
+			src := findSource(a.Sources, in.Source)
+
+			if src == nil {
+				return nil, fmt.Errorf("sampler %q input %q does not exist", id, in.Source)
+			}
+
+			switch in.Semantic {
+			case "INPUT":
+				values, _, err := src.floats()
+
+				if err != nil {
+					return nil, err
+				}
+
+				s.times = values
+			case "OUTPUT":
+				values, _, err := src.floats()
+
+				if err != nil {
+					return nil, err
+				}
+
+				for i := 0; i+16 <= len(values); i += 16 {
+					s.matrices = append(s.matrices, matrixFromRowMajor(values[i:i+16]))
+				}
+			case "INTERPOLATION":
+				s.interpolation = src.names()
+			}
+		}
+
+		if len(s.times) == 0 || len(s.matrices) != len(s.times) {
+			return nil, fmt.Errorf("sampler %q needs one matrix per key", id)
+		}
+
+		for i := 1; i < len(s.times); i++ {
+			if s.times[i] < s.times[i-1] {
+				return nil, fmt.Errorf("sampler %q key times are not increasing", id)
+			}
+		}
+
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("sampler %q does not exist", id)
+}
+
+// sample interpolates the sampler at time t. STEP keys hold, everything else, BEZIER included, is linear.
+func (s *matrixSampler) sample(t float32) *animation.Matrix4f {
+	k := sort.Search(len(s.times), func(i int) bool { return s.times[i] > t }) - 1
+
+	if k < 0 {
+		return s.matrices[0]
+	}
+
+	if k >= len(s.times)-1 || (k < len(s.interpolation) && s.interpolation[k] == "STEP") {
+		return s.matrices[k]
+	}
+
+	dt := s.times[k+1] - s.times[k]
+
+	if dt <= 0 {
+		return s.matrices[k+1]
+	}
+
+	a := animation.DecomposeMatrix(s.matrices[k])
+	b := animation.DecomposeMatrix(s.matrices[k+1])
+
+	return a.Lerp(b, (t-s.times[k])/dt).Matrix()
+}
+
+func (im *importer) importClip() (*Clip, error) {
+	duration := float32(0)
+
+	for _, samplers := range im.channels {
+		for _, s := range samplers {
+			if last := s.times[len(s.times)-1]; last > duration {
+				duration = last
+			}
+		}
+	}
+
+	frames := math.Round(float64(duration) * float64(im.fps))
+
+	if !(frames <= animation.MaxFrames) {
+		return nil, fmt.Errorf("animation lasts %gs, more than %d frames at %d fps", duration, animation.MaxFrames, im.fps)
+	}
+
+	clip := &Clip{
+		StartFrame: 1,
+		EndFrame:   int64(frames) + 1,
+		FPS:        im.fps,
+		Keyframes:  map[string]*animation.IntToMatrix4fMap{},
+	}
+
+	if len(im.channels) == 0 {
+		return clip, nil
+	}
+
+	for _, n := range im.nodes {
+		bone := im.jointRests[n]
+
+		if bone == nil {
+			continue
+		}
+
+		armature := im.jointBones[n]
+
+		var parentNode *nodeElement
+		for parent := n.parent; parent != nil; parent = parent.parent {
+			if im.boneNames[parent] == bone.ParentName {
+				parentNode = parent
+				break
+			}
+		}
+
+		// keyframes are relative to the rest pose of the bone in its parent bone's space
+		var restInverse *animation.Matrix4f
+
+		if parentNode != nil {
+			restInverse = armature.Bones[bone.ParentName].MatrixLocalInverted.Mul(bone.MatrixLocal).Inverse()
+		} else {
+			restInverse = bone.MatrixLocalInverted
+		}
+
+		if restInverse == nil {
+			return nil, fmt.Errorf("joint %q has a singular rest pose", bone.Name)
+		}
+
+		frames := animation.NewIntToMatrix4fMap()
+
+		for frame := clip.StartFrame; frame <= clip.EndFrame; frame++ {
+			t := float32(frame-clip.StartFrame) / float32(im.fps)
+			sample := func(s *matrixSampler) *animation.Matrix4f { return s.sample(t) }
+
+			animated, err := im.nodeGlobal(n, sample)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if parentNode != nil {
+				parentGlobal, err := im.nodeGlobal(parentNode, sample)
+
+				if err != nil {
+					return nil, err
+				}
+
+				parentInverse := parentGlobal.Inverse()
+
+				if parentInverse == nil {
+					return nil, fmt.Errorf("joint %q has a singular transform at %gs", bone.ParentName, t)
+				}
+
+				animated = parentInverse.Mul(animated)
+			}
+
+			frames.Set(int(frame), restInverse.Mul(animated))
+		}
+
+		clip.Keyframes[bone.Name] = frames
+	}
+
+	return clip, nil
+}
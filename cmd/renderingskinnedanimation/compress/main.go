@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"io/ioutil"
+	"log"
+)
+
+// compress reads an exported AnimationMatrices file (mesh -> action -> bone -> frame -> matrix), removes
+// redundant keys and writes the compressed clip as JSON along with a report of the size and error per bone.
+func main() {
+	in := flag.String("in", "", "exported animation matrices JSON file")
+	out := flag.String("out", "", "compressed clip output file (defaults to stdout)")
+	mesh := flag.String("mesh", "Cube", "mesh name in the export")
+	action := flag.String("action", "ArmatureAction", "action name in the export")
+	positionTolerance := flag.Float64("position", 0.001, "maximum position error")
+	rotationTolerance := flag.Float64("rotation", 0.001, "maximum rotation error in radians")
+	scaleTolerance := flag.Float64("scale", 0.001, "maximum scale error")
+	flag.Parse()
+
+	if *in == "" {
+		log.Fatal("-in is required")
+	}
+
+	b, err := ioutil.ReadFile(*in)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var animationData ExportedAnimations
+
+	err = json.Unmarshal(b, &animationData)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	keyframes, ok := animationData[*mesh][*action]
+
+	if !ok {
+		log.Fatalf("no action %q for mesh %q in %s", *action, *mesh, *in)
+	}
+
+	settings := CompressionSettings{
+		PositionTolerance: float32(*positionTolerance),
+		RotationTolerance: float32(*rotationTolerance),
+		ScaleTolerance:    float32(*scaleTolerance),
+	}
+
+	clip, report := CompressKeyframes(keyframes, settings)
+
+	compressed, err := json.Marshal(clip)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if *out == "" {
+		fmt.Println(string(compressed))
+	} else if err = ioutil.WriteFile(*out, compressed, 0644); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	log.Print(report)
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/asset"
+)
+
+// convert turns an exported vertex, armature, animation and morph weights JSON file set into a single binary
+// asset file.
+func main() {
+	vertexFile := flag.String("vertices", "", "exported vertex data JSON file")
+	armatureFile := flag.String("armature", "", "exported armature data JSON file")
+	animationFile := flag.String("animation", "", "exported animation matrices JSON file")
+	morphWeightsFile := flag.String("morphWeights", "", "exported morph weights JSON file")
+	armatureName := flag.String("armatureName", "Armature", "armature the meshes are skinned to and the actions animate")
+	out := flag.String("out", "", "asset output file")
+	flag.Parse()
+
+	if *out == "" {
+		log.Fatal("-out is required")
+	}
+
+	w := asset.NewWriter()
+	skeletons := map[string]*Skeleton{}
+
+	if *armatureFile != "" {
+		var armatureData map[string]*Armature
+		readJSON(*armatureFile, &armatureData)
+
+		armatureNames := []string{}
+		for k := range armatureData {
+			armatureNames = append(armatureNames, k)
+		}
+		sort.Strings(armatureNames)
+
+		for _, name := range armatureNames {
+			skeleton, err := NewSkeleton(armatureData[name])
+
+			if err != nil {
+				log.Fatalf("armature %q: %s", name, err.Error())
+			}
+
+			skeletons[name] = skeleton
+			w.AddArmature(skeleton)
+		}
+	}
+
+	skeleton := skeletons[*armatureName]
+
+	if *vertexFile != "" {
+		var vertexData map[string]Mesh
+		readJSON(*vertexFile, &vertexData)
+
+		meshNames := []string{}
+		for k := range vertexData {
+			meshNames = append(meshNames, k)
+		}
+		sort.Strings(meshNames)
+
+		for _, name := range meshNames {
+			mesh := vertexData[name]
+
+			// unskinned meshes get no SKIN chunk
+			var meshSkeleton *Skeleton
+
+			if hasSkin(&mesh) {
+				if skeleton == nil {
+					log.Fatalf("mesh %q is skinned but there is no armature %q", name, *armatureName)
+				}
+
+				meshSkeleton = skeleton
+			}
+
+			if err := w.AddMesh(name, &mesh, meshSkeleton); err != nil {
+				log.Fatal(err.Error())
+			}
+		}
+	}
+
+	// an action's bone keyframes come from one mesh's entry in the animation file, its morph weights from any
+	// number of meshes in the morph weights file
+	actionKeyframes := map[string]map[string]*IntToMatrix4fMap{}
+	actionMorphWeights := map[string]map[string]MorphAnimation{}
+
+	if *animationFile != "" {
+		var animationData ExportedAnimations
+		readJSON(*animationFile, &animationData)
+
+		meshNames := []string{}
+		for k := range animationData {
+			meshNames = append(meshNames, k)
+		}
+		sort.Strings(meshNames)
+
+		for _, meshName := range meshNames {
+			for action, keyframes := range animationData[meshName] {
+				if _, present := actionKeyframes[action]; present {
+					log.Fatalf("action %q appears for more than one mesh", action)
+				}
+
+				actionKeyframes[action] = keyframes
+			}
+		}
+	}
+
+	if *morphWeightsFile != "" {
+		var morphData ExportedMorphWeights
+		readJSON(*morphWeightsFile, &morphData)
+
+		for meshName, actions := range morphData {
+			for action, weights := range actions {
+				if actionMorphWeights[action] == nil {
+					actionMorphWeights[action] = map[string]MorphAnimation{}
+				}
+
+				actionMorphWeights[action][meshName] = weights
+			}
+		}
+	}
+
+	actions := []string{}
+	for k := range actionKeyframes {
+		actions = append(actions, k)
+	}
+	for k := range actionMorphWeights {
+		if _, present := actionKeyframes[k]; !present {
+			actions = append(actions, k)
+		}
+	}
+	sort.Strings(actions)
+
+	if len(actions) > 0 && skeleton == nil {
+		log.Fatalf("actions need armature %q", *armatureName)
+	}
+
+	for _, action := range actions {
+		keyframes := actionKeyframes[action]
+		morphWeights := actionMorphWeights[action]
+		startFrame, endFrame, keyed := frameRange(keyframes, morphWeights)
+
+		// an action that keys no bone and no morph target has no frame range to write
+		if !keyed {
+			log.Printf("skipping action %q, it has no keyframes", action)
+			continue
+		}
+
+		if err := w.AddClip(action, skeleton, keyframes, morphWeights, startFrame, endFrame); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+
+	f, err := os.Create(*out)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	n, err := w.WriteTo(f)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	fmt.Printf("wrote %d bytes to %s\n", n, *out)
+}
+
+func hasSkin(mesh *Mesh) bool {
+	for _, coordinate := range mesh.Coordinates {
+		if len(coordinate.Skin) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// frameRange returns the first and last keyed frame of an action, or false if no bone or morph target has a key.
+func frameRange(keyframes map[string]*IntToMatrix4fMap, morphWeights map[string]MorphAnimation) (int64, int64, bool) {
+	frames := []int{}
+
+	for _, boneFrames := range keyframes {
+		if boneFrames != nil {
+			frames = append(frames, boneFrames.Keys()...)
+		}
+	}
+
+	for _, targets := range morphWeights {
+		for _, weights := range targets {
+			if weights != nil {
+				frames = append(frames, weights.Keys()...)
+			}
+		}
+	}
+
+	if len(frames) == 0 {
+		return 0, 0, false
+	}
+
+	sort.Ints(frames)
+
+	return int64(frames[0]), int64(frames[len(frames)-1]), true
+}
+
+func readJSON(file string, v interface{}) {
+	b, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = json.Unmarshal(b, v)
+
+	if err != nil {
+		log.Fatalf("%s: %s", file, err.Error())
+	}
+}
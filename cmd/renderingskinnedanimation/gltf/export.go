@@ -0,0 +1,750 @@
+package gltf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+const generator = "hellmouthxyz"
+
+type exporter struct {
+	scene *Scene
+	doc   *document
+	data  bytes.Buffer
+
+	boneNodes  map[string]map[string]int // armature name -> bone name -> node
+	boneRests  map[string]map[string]*animation.Matrix4f
+	meshNodes  map[string]int
+	meshNames  map[string][]string // mesh name -> morph target names
+	materials  map[*Material]int
+	sceneNodes []int
+}
+
+// Save writes the scene to a .gltf file with its buffer embedded, or to a .glb file.
+func Save(path string, scene *Scene) error {
+	var data []byte
+	var err error
+
+	if strings.EqualFold(filepath.Ext(path), ".glb") {
+		data, err = ExportGLB(scene)
+	} else {
+		data, err = Export(scene)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Export encodes the scene as glTF JSON with the binary data embedded as a base64 data uri.
+func Export(scene *Scene) ([]byte, error) {
+	e, err := newExporter(scene)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e.data.Len() > 0 {
+		e.doc.Buffers = []buffer{{
+			URI:        "data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(e.data.Bytes()),
+			ByteLength: e.data.Len(),
+		}}
+	}
+
+	return json.Marshal(e.doc)
+}
+
+// ExportGLB encodes the scene as a binary glTF container.
+func ExportGLB(scene *Scene) ([]byte, error) {
+	e, err := newExporter(scene)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if e.data.Len() > 0 {
+		e.doc.Buffers = []buffer{{ByteLength: e.data.Len()}}
+	}
+
+	jsonChunk, err := json.Marshal(e.doc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// chunks are padded to 4 bytes, JSON with spaces and binary data with zeros
+	for len(jsonChunk)%4 != 0 {
+		jsonChunk = append(jsonChunk, ' ')
+	}
+
+	binChunk := e.data.Bytes()
+
+	for len(binChunk)%4 != 0 {
+		binChunk = append(binChunk, 0)
+	}
+
+	length := 12 + 8 + len(jsonChunk)
+	if len(binChunk) > 0 {
+		length += 8 + len(binChunk)
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, length))
+
+	binary.Write(out, binary.LittleEndian, []uint32{glbMagic, glbVersion, uint32(length)})
+	binary.Write(out, binary.LittleEndian, []uint32{uint32(len(jsonChunk)), glbChunkJSON})
+	out.Write(jsonChunk)
+
+	if len(binChunk) > 0 {
+		binary.Write(out, binary.LittleEndian, []uint32{uint32(len(binChunk)), glbChunkBIN})
+		out.Write(binChunk)
+	}
+
+	return out.Bytes(), nil
+}
+
+func newExporter(s *Scene) (*exporter, error) {
+	e := &exporter{
+		scene:     s,
+		doc:       &document{Asset: asset{Version: "2.0", Generator: generator}},
+		boneNodes: map[string]map[string]int{},
+		boneRests: map[string]map[string]*animation.Matrix4f{},
+		meshNodes: map[string]int{},
+		meshNames: map[string][]string{},
+		materials: map[*Material]int{},
+	}
+
+	armatureNames := []string{}
+	for name := range s.Armatures {
+		armatureNames = append(armatureNames, name)
+	}
+	sort.Strings(armatureNames)
+
+	for _, name := range armatureNames {
+		if err := e.exportArmature(s.Armatures[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	meshNames := []string{}
+	for name := range s.Meshes {
+		meshNames = append(meshNames, name)
+	}
+	sort.Strings(meshNames)
+
+	for _, name := range meshNames {
+		if err := e.exportMesh(name, s.Meshes[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	clipNames := []string{}
+	for name := range s.Clips {
+		clipNames = append(clipNames, name)
+	}
+	sort.Strings(clipNames)
+
+	for _, name := range clipNames {
+		if err := e.exportClip(name, s.Clips[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	sceneIndex := 0
+	e.doc.Scene = &sceneIndex
+	e.doc.Scenes = []scene{{Nodes: e.sceneNodes}}
+
+	return e, nil
+}
+
+// exportArmature writes a node per bone, in skeleton order, and a skin whose inverse bind matrices are the
+// bones' MatrixLocalInverted.
+func (e *exporter) exportArmature(armature *animation.Armature) error {
+	skeleton, err := animation.NewSkeleton(armature)
+
+	if err != nil {
+		return fmt.Errorf("gltf: armature %q: %s", armature.Name, err.Error())
+	}
+
+	nodes := map[string]int{}
+	rests := map[string]*animation.Matrix4f{}
+	joints := []int{}
+	inverseBindMatrices := []float32{}
+
+	for i, boneName := range skeleton.Names {
+		// glTF nodes are relative to their parent, the bones are relative to the armature
+		rest := skeleton.MatrixLocal[i]
+
+		if parent := skeleton.Parent(i); parent >= 0 {
+			rest = skeleton.MatrixLocalInverted[parent].Mul(rest)
+		}
+
+		rests[boneName] = rest
+		nodes[boneName] = len(e.doc.Nodes)
+		joints = append(joints, nodes[boneName])
+		inverseBindMatrices = append(inverseBindMatrices, matrixToColumnMajor(skeleton.MatrixLocalInverted[i])...)
+
+		// animated nodes must use TRS rather than a matrix
+		e.doc.Nodes = append(e.doc.Nodes, transformNode(boneName, animation.DecomposeMatrix(rest)))
+
+		if parent := skeleton.Parent(i); parent >= 0 {
+			parentNode := &e.doc.Nodes[nodes[skeleton.Names[parent]]]
+			parentNode.Children = append(parentNode.Children, nodes[boneName])
+		} else {
+			e.sceneNodes = append(e.sceneNodes, nodes[boneName])
+		}
+	}
+
+	e.boneNodes[armature.Name] = nodes
+	e.boneRests[armature.Name] = rests
+
+	if len(joints) == 0 {
+		return nil
+	}
+
+	ibm := e.addAccessor(inverseBindMatrices, "MAT4", nil, false)
+
+	e.doc.Skins = append(e.doc.Skins, skin{
+		Name:                armature.Name,
+		InverseBindMatrices: &ibm,
+		Joints:              joints,
+	})
+
+	return nil
+}
+
+func transformNode(name string, t animation.Transform) node {
+	n := node{Name: name}
+
+	if t.Translation != (animation.Vector3f{}) {
+		n.Translation = []float32{t.Translation.X, t.Translation.Y, t.Translation.Z}
+	}
+
+	if t.Rotation != animation.IdentityQuaternion() {
+		n.Rotation = []float32{t.Rotation.X, t.Rotation.Y, t.Rotation.Z, t.Rotation.W}
+	}
+
+	if t.Scale != (animation.Vector3f{X: 1, Y: 1, Z: 1}) {
+		n.Scale = []float32{t.Scale.X, t.Scale.Y, t.Scale.Z}
+	}
+
+	return n
+}
+
+func (e *exporter) exportMesh(name string, primitives []*Primitive) error {
+	m := mesh{Name: name, Primitives: []primitive{}}
+
+	var joints []string
+	var skinIndex *int
+
+	if armatureName, present := e.scene.MeshArmatures[name]; present {
+		armature := e.scene.Armatures[armatureName]
+
+		if armature == nil {
+			return fmt.Errorf("gltf: mesh %q is skinned by armature %q which does not exist", name, armatureName)
+		}
+
+		for i, s := range e.doc.Skins {
+			if s.Name == armatureName {
+				skinIndex = new(int)
+				*skinIndex = i
+
+				for _, joint := range s.Joints {
+					joints = append(joints, e.doc.Nodes[joint].Name)
+				}
+			}
+		}
+	}
+
+	var targetNames []string
+
+	for p, prim := range primitives {
+		names := []string{}
+		for _, target := range prim.Mesh.MorphTargets {
+			names = append(names, target.Name)
+		}
+
+		// glTF gives every primitive of a mesh the same morph targets
+		if p == 0 {
+			targetNames = names
+		} else if strings.Join(names, "\x00") != strings.Join(targetNames, "\x00") {
+			return fmt.Errorf("gltf: mesh %q primitives have different morph targets", name)
+		}
+
+		exported, err := e.exportPrimitive(prim, joints)
+
+		if err != nil {
+			return fmt.Errorf("gltf: mesh %q primitive %d: %s", name, p, err.Error())
+		}
+
+		m.Primitives = append(m.Primitives, exported)
+	}
+
+	if len(targetNames) > 0 {
+		m.Extras = &meshExtras{TargetNames: targetNames}
+		m.Weights = make([]float32, len(targetNames))
+		e.meshNames[name] = targetNames
+	}
+
+	meshIndex := len(e.doc.Meshes)
+	e.doc.Meshes = append(e.doc.Meshes, m)
+
+	e.meshNodes[name] = len(e.doc.Nodes)
+	e.doc.Nodes = append(e.doc.Nodes, node{Name: name, Mesh: &meshIndex, Skin: skinIndex})
+	e.sceneNodes = append(e.sceneNodes, e.meshNodes[name])
+
+	return nil
+}
+
+func (e *exporter) exportPrimitive(prim *Primitive, joints []string) (primitive, error) {
+	mesh := prim.Mesh
+	count := len(mesh.Coordinates)
+
+	jointIndices := map[string]int{}
+	for i, joint := range joints {
+		jointIndices[joint] = i
+	}
+
+	positions := make([]float32, 0, count*3)
+	uvs := make([]float32, 0, count*2)
+	influences := 0
+
+	for i, coordinate := range mesh.Coordinates {
+		if len(coordinate.Vertices) < 3 {
+			return primitive{}, fmt.Errorf("coordinate %d has %d position values", i, len(coordinate.Vertices))
+		}
+
+		positions = append(positions, coordinate.Vertices[:3]...)
+
+		// the engine puts the uv origin at the bottom left, glTF at the top left
+		if len(coordinate.Textures) >= 2 {
+			uvs = append(uvs, coordinate.Textures[0], 1-coordinate.Textures[1])
+		} else {
+			uvs = append(uvs, 0, 1)
+		}
+
+		if len(coordinate.Skin) > influences {
+			influences = len(coordinate.Skin)
+		}
+	}
+
+	p := primitive{Attributes: map[string]int{}}
+
+	p.Attributes["POSITION"] = e.addAccessor(positions, "VEC3", &targetArray, true)
+	p.Attributes["TEXCOORD_0"] = e.addAccessor(uvs, "VEC2", &targetArray, false)
+
+	if influences > 0 && joints == nil {
+		return p, fmt.Errorf("mesh has skin weights but no armature")
+	}
+
+	// four influences per JOINTS_n/WEIGHTS_n set
+	for set := 0; set*4 < influences; set++ {
+		jointValues := make([]uint16, 0, count*4)
+		weightValues := make([]float32, 0, count*4)
+
+		for i, coordinate := range mesh.Coordinates {
+			boneNames := []string{}
+			for boneName := range coordinate.Skin {
+				boneNames = append(boneNames, boneName)
+			}
+
+			// heaviest influences first, so a reader that only takes the first set gets the most important ones
+			sort.Slice(boneNames, func(a, b int) bool {
+				wa, wb := coordinate.Skin[boneNames[a]], coordinate.Skin[boneNames[b]]
+				if wa != wb {
+					return wa > wb
+				}
+				return boneNames[a] < boneNames[b]
+			})
+
+			for k := set * 4; k < set*4+4; k++ {
+				if k >= len(boneNames) {
+					jointValues = append(jointValues, 0)
+					weightValues = append(weightValues, 0)
+					continue
+				}
+
+				joint, present := jointIndices[boneNames[k]]
+
+				if !present {
+					return p, fmt.Errorf("coordinate %d is weighted to bone %q which is not in the armature", i, boneNames[k])
+				}
+
+				jointValues = append(jointValues, uint16(joint))
+				weightValues = append(weightValues, coordinate.Skin[boneNames[k]])
+			}
+		}
+
+		p.Attributes[fmt.Sprintf("JOINTS_%d", set)] = e.addJointAccessor(jointValues)
+		p.Attributes[fmt.Sprintf("WEIGHTS_%d", set)] = e.addAccessor(weightValues, "VEC4", &targetArray, false)
+	}
+
+	if len(mesh.Indices) > 0 {
+		for _, index := range mesh.Indices {
+			if int(index) >= count {
+				return p, fmt.Errorf("index %d is out of range for %d vertices", index, count)
+			}
+		}
+
+		indices := e.addIndexAccessor(mesh.Indices)
+		p.Indices = &indices
+	}
+
+	for _, target := range mesh.MorphTargets {
+		t, err := e.exportMorphTarget(target, count)
+
+		if err != nil {
+			return p, err
+		}
+
+		p.Targets = append(p.Targets, t)
+	}
+
+	if prim.Material != nil {
+		material, err := e.exportMaterial(prim.Material)
+
+		if err != nil {
+			return p, err
+		}
+
+		p.Material = &material
+	}
+
+	return p, nil
+}
+
+func (e *exporter) exportMorphTarget(target animation.MorphTarget, count int) (map[string]int, error) {
+	positions := make([]float32, count*3)
+	normals := make([]float32, count*3)
+	hasNormals := false
+
+	for _, delta := range target.Deltas {
+		if delta.Index < 0 || delta.Index >= count {
+			return nil, fmt.Errorf("morph target %q has a delta for vertex %d of %d", target.Name, delta.Index, count)
+		}
+
+		copy(positions[delta.Index*3:delta.Index*3+3], delta.Position)
+
+		if len(delta.Normal) > 0 {
+			copy(normals[delta.Index*3:delta.Index*3+3], delta.Normal)
+			hasNormals = true
+		}
+	}
+
+	t := map[string]int{"POSITION": e.addAccessor(positions, "VEC3", &targetArray, true)}
+
+	if hasNormals {
+		t["NORMAL"] = e.addAccessor(normals, "VEC3", &targetArray, false)
+	}
+
+	return t, nil
+}
+
+func (e *exporter) exportMaterial(m *Material) (int, error) {
+	if index, present := e.materials[m]; present {
+		return index, nil
+	}
+
+	exported := material{
+		Name: m.Name,
+		PbrMetallicRoughness: &pbrMetallicRoughness{
+			BaseColorFactor: m.BaseColorFactor[:],
+		},
+	}
+
+	var img *image
+
+	switch {
+	case m.DiffuseTextureData != nil:
+		view := e.addBufferView(m.DiffuseTextureData, nil)
+		img = &image{MimeType: http.DetectContentType(m.DiffuseTextureData), BufferView: &view}
+	case m.DiffuseTexture != "":
+		img = &image{URI: filepath.ToSlash(m.DiffuseTexture)}
+	}
+
+	if img != nil {
+		source := len(e.doc.Images)
+		e.doc.Images = append(e.doc.Images, *img)
+		e.doc.Textures = append(e.doc.Textures, texture{Source: &source})
+		exported.PbrMetallicRoughness.BaseColorTexture = &textureRef{Index: len(e.doc.Textures) - 1}
+	}
+
+	e.materials[m] = len(e.doc.Materials)
+	e.doc.Materials = append(e.doc.Materials, exported)
+
+	return e.materials[m], nil
+}
+
+// exportClip writes a translation, rotation and scale channel per keyed bone, sampled at the keyed frames,
+// and a weights channel per animated mesh.
+func (e *exporter) exportClip(name string, clip *Clip) error {
+	fps := clip.FPS
+	if fps <= 0 {
+		fps = DefaultFPS
+	}
+
+	a := gltfAnimation{Name: name, Channels: []channel{}, Samplers: []sampler{}}
+
+	addChannel := func(nodeIndex int, path string, input, output int) {
+		a.Channels = append(a.Channels, channel{
+			Sampler: len(a.Samplers),
+			Target:  channelTarget{Node: &nodeIndex, Path: path},
+		})
+		a.Samplers = append(a.Samplers, sampler{Input: input, Output: output, Interpolation: interpolationLinear})
+	}
+
+	frameTimes := func(frames []int) int {
+		times := []float32{}
+		for _, frame := range frames {
+			times = append(times, float32(int64(frame)-clip.StartFrame)/float32(fps))
+		}
+		return e.addAccessor(times, "SCALAR", nil, true)
+	}
+
+	armatureNames := []string{}
+	for name := range e.scene.Armatures {
+		armatureNames = append(armatureNames, name)
+	}
+	sort.Strings(armatureNames)
+
+	boneNames := []string{}
+	for name := range clip.Keyframes {
+		boneNames = append(boneNames, name)
+	}
+	sort.Strings(boneNames)
+
+	for _, boneName := range boneNames {
+		frames := clip.Keyframes[boneName]
+
+		if frames == nil || len(frames.Keys()) == 0 {
+			continue
+		}
+
+		// clips are not tied to an armature, the bone is taken from the first armature that has it
+		armatureName := ""
+		for _, candidate := range armatureNames {
+			if _, present := e.boneNodes[candidate][boneName]; present {
+				armatureName = candidate
+				break
+			}
+		}
+
+		if armatureName == "" {
+			return fmt.Errorf("gltf: clip %q has keyframes for bone %q which is in no armature", name, boneName)
+		}
+
+		rest := e.boneRests[armatureName][boneName]
+		translations := []float32{}
+		rotations := []float32{}
+		scales := []float32{}
+		previous := animation.IdentityQuaternion()
+
+		for _, frame := range frames.Keys() {
+			t := animation.DecomposeMatrix(rest.Mul(frames.Get(frame)))
+
+			// keep consecutive rotations in the same hemisphere so linear interpolation takes the short way
+			if t.Rotation.Dot(previous) < 0 {
+				t.Rotation = animation.Quaternion{X: -t.Rotation.X, Y: -t.Rotation.Y, Z: -t.Rotation.Z, W: -t.Rotation.W}
+			}
+			previous = t.Rotation
+
+			translations = append(translations, t.Translation.X, t.Translation.Y, t.Translation.Z)
+			rotations = append(rotations, t.Rotation.X, t.Rotation.Y, t.Rotation.Z, t.Rotation.W)
+			scales = append(scales, t.Scale.X, t.Scale.Y, t.Scale.Z)
+		}
+
+		input := frameTimes(frames.Keys())
+		nodeIndex := e.boneNodes[armatureName][boneName]
+
+		addChannel(nodeIndex, "translation", input, e.addAccessor(translations, "VEC3", nil, false))
+		addChannel(nodeIndex, "rotation", input, e.addAccessor(rotations, "VEC4", nil, false))
+		addChannel(nodeIndex, "scale", input, e.addAccessor(scales, "VEC3", nil, false))
+	}
+
+	meshNames := []string{}
+	for name := range clip.MorphWeights {
+		meshNames = append(meshNames, name)
+	}
+	sort.Strings(meshNames)
+
+	for _, meshName := range meshNames {
+		nodeIndex, present := e.meshNodes[meshName]
+
+		if !present {
+			return fmt.Errorf("gltf: clip %q has morph weights for mesh %q which does not exist", name, meshName)
+		}
+
+		tracks := clip.MorphWeights[meshName]
+		targetNames := e.meshNames[meshName]
+
+		for targetName := range tracks {
+			if indexOf(targetNames, targetName) < 0 {
+				return fmt.Errorf("gltf: clip %q has weights for morph target %q which mesh %q does not have", name, targetName, meshName)
+			}
+		}
+
+		keyed := map[int]bool{}
+		for _, track := range tracks {
+			for _, frame := range track.Keys() {
+				keyed[frame] = true
+			}
+		}
+
+		frames := []int{}
+		for frame := range keyed {
+			frames = append(frames, frame)
+		}
+		sort.Ints(frames)
+
+		if len(frames) == 0 {
+			continue
+		}
+
+		// a weights sampler holds every target's weight for each key, unkeyed targets stay at 0
+		weights := []float32{}
+
+		for _, frame := range frames {
+			for _, targetName := range targetNames {
+				weight := float32(0)
+
+				if track := tracks[targetName]; track != nil && len(track.Keys()) > 0 {
+					weight = track.Sample(float32(frame))
+				}
+
+				weights = append(weights, weight)
+			}
+		}
+
+		addChannel(nodeIndex, "weights", frameTimes(frames), e.addAccessor(weights, "SCALAR", nil, false))
+	}
+
+	if len(a.Channels) > 0 {
+		e.doc.Animations = append(e.doc.Animations, a)
+	}
+
+	return nil
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+var (
+	targetArray        = targetArrayBuffer
+	targetElementArray = targetElementArrayBuffer
+)
+
+// addBufferView appends data to the binary buffer, aligned to 4 bytes as accessors require.
+func (e *exporter) addBufferView(data []byte, target *int) int {
+	for e.data.Len()%4 != 0 {
+		e.data.WriteByte(0)
+	}
+
+	view := bufferView{
+		Buffer:     0,
+		ByteOffset: e.data.Len(),
+		ByteLength: len(data),
+		Target:     target,
+	}
+
+	e.data.Write(data)
+	e.doc.BufferViews = append(e.doc.BufferViews, view)
+
+	return len(e.doc.BufferViews) - 1
+}
+
+func (e *exporter) addAccessor(values []float32, accessorType string, target *int, bounds bool) int {
+	components := typeComponents[accessorType]
+
+	var data bytes.Buffer
+	binary.Write(&data, binary.LittleEndian, values)
+
+	view := e.addBufferView(data.Bytes(), target)
+
+	a := accessor{
+		BufferView:    &view,
+		ComponentType: componentFloat,
+		Count:         len(values) / components,
+		Type:          accessorType,
+	}
+
+	// POSITION and animation inputs must declare their bounds
+	if bounds && a.Count > 0 {
+		a.Min = make([]float32, components)
+		a.Max = make([]float32, components)
+
+		for c := 0; c < components; c++ {
+			a.Min[c] = float32(math.Inf(1))
+			a.Max[c] = float32(math.Inf(-1))
+		}
+
+		for i, v := range values {
+			c := i % components
+			a.Min[c] = float32(math.Min(float64(a.Min[c]), float64(v)))
+			a.Max[c] = float32(math.Max(float64(a.Max[c]), float64(v)))
+		}
+	}
+
+	e.doc.Accessors = append(e.doc.Accessors, a)
+
+	return len(e.doc.Accessors) - 1
+}
+
+func (e *exporter) addJointAccessor(joints []uint16) int {
+	var data bytes.Buffer
+	binary.Write(&data, binary.LittleEndian, joints)
+
+	view := e.addBufferView(data.Bytes(), &targetArray)
+
+	e.doc.Accessors = append(e.doc.Accessors, accessor{
+		BufferView:    &view,
+		ComponentType: componentUnsignedShort,
+		Count:         len(joints) / 4,
+		Type:          "VEC4",
+	})
+
+	return len(e.doc.Accessors) - 1
+}
+
+func (e *exporter) addIndexAccessor(indices []uint32) int {
+	var data bytes.Buffer
+	binary.Write(&data, binary.LittleEndian, indices)
+
+	view := e.addBufferView(data.Bytes(), &targetElementArray)
+
+	e.doc.Accessors = append(e.doc.Accessors, accessor{
+		BufferView:    &view,
+		ComponentType: componentUnsignedInt,
+		Count:         len(indices),
+		Type:          "SCALAR",
+	})
+
+	return len(e.doc.Accessors) - 1
+}
+
+func matrixToColumnMajor(m *animation.Matrix4f) []float32 {
+	return []float32{
+		m.M00, m.M10, m.M20, m.M30,
+		m.M01, m.M11, m.M21, m.M31,
+		m.M02, m.M12, m.M22, m.M32,
+		m.M03, m.M13, m.M23, m.M33,
+	}
+}
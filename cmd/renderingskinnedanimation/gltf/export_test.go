@@ -0,0 +1,159 @@
+package gltf
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/internal/trumpdata"
+)
+
+// the largest difference allowed between an exported value and the value read back. Bones and keyframes go
+// through glTF as translation, rotation and scale, and the exported matrices are rounded to five decimals so
+// they are not quite rotations; the round trip moves them by up to about 7e-5.
+const tolerance = 1e-4
+
+func readTrump(t testing.TB) *Scene {
+	export := trumpdata.Load(t)
+
+	return &Scene{
+		Meshes:        map[string][]*Primitive{trumpdata.MeshName: {{Mesh: &export.Mesh}}},
+		Armatures:     map[string]*animation.Armature{trumpdata.ArmatureName: export.Armature},
+		MeshArmatures: map[string]string{trumpdata.MeshName: trumpdata.ArmatureName},
+		Clips: map[string]*Clip{trumpdata.ActionName: {
+			Name:       trumpdata.ActionName,
+			StartFrame: trumpdata.StartFrame,
+			EndFrame:   trumpdata.EndFrame,
+			FPS:        30,
+			Keyframes:  export.Keyframes,
+		}},
+	}
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	for _, binary := range []bool{false, true} {
+		original := readTrump(t)
+
+		var data []byte
+		var err error
+
+		if binary {
+			data, err = ExportGLB(original)
+		} else {
+			data, err = Export(original)
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		imported, err := Parse(data, "", 30)
+
+		if err != nil {
+			t.Fatalf("glb %v: %s", binary, err)
+		}
+
+		compareMesh(t, original.Meshes["Cube"][0].Mesh, imported)
+		compareArmature(t, original.Armatures["Armature"], imported.Armatures["Armature"])
+		compareClip(t, original.Clips["ArmatureAction"], imported.Clips["ArmatureAction"])
+	}
+}
+
+func compareMesh(t *testing.T, want *animation.Mesh, imported *Scene) {
+	primitives := imported.Meshes["Cube"]
+
+	if len(primitives) != 1 {
+		t.Fatalf("got %d primitives for mesh Cube, want 1", len(primitives))
+	}
+
+	got := primitives[0].Mesh
+
+	if len(got.Indices) != len(want.Indices) || len(got.Coordinates) != len(want.Coordinates) {
+		t.Fatalf("got %d indices and %d coordinates, want %d and %d",
+			len(got.Indices), len(got.Coordinates), len(want.Indices), len(want.Coordinates))
+	}
+
+	for i := range want.Indices {
+		if got.Indices[i] != want.Indices[i] {
+			t.Fatalf("index %d is %d, want %d", i, got.Indices[i], want.Indices[i])
+		}
+	}
+
+	for i, w := range want.Coordinates {
+		g := got.Coordinates[i]
+		compareFloats(t, "coordinate position", g.Vertices, w.Vertices)
+		compareFloats(t, "coordinate uv", g.Textures, w.Textures)
+
+		// glTF cannot tell a zero weight from an unused influence, so zero weights are not read back
+		weights := 0
+
+		for boneName, weight := range w.Skin {
+			if weight != 0 {
+				weights++
+			}
+
+			if d := math.Abs(float64(g.Skin[boneName]/g.TotalWeight - weight/w.TotalWeight)); d > tolerance {
+				t.Fatalf("coordinate %d weight for %q differs by %g", i, boneName, d)
+			}
+		}
+
+		if len(g.Skin) != weights {
+			t.Fatalf("coordinate %d has %d skin weights, want %d", i, len(g.Skin), weights)
+		}
+	}
+}
+
+func compareArmature(t *testing.T, want, got *animation.Armature) {
+	if got == nil || len(got.Bones) != len(want.Bones) {
+		t.Fatalf("got armature %v, want %d bones", got, len(want.Bones))
+	}
+
+	for boneName, w := range want.Bones {
+		g := got.Bones[boneName]
+
+		if g == nil {
+			t.Fatalf("bone %q is missing", boneName)
+		}
+
+		if g.ParentName != w.ParentName {
+			t.Fatalf("bone %q has parent %q, want %q", boneName, g.ParentName, w.ParentName)
+		}
+
+		compareFloats(t, boneName+" matrix_local", g.MatrixLocal.Get1D(), w.MatrixLocal.Get1D())
+		compareFloats(t, boneName+" matrix_local_inverted", g.MatrixLocalInverted.Get1D(), w.MatrixLocalInverted.Get1D())
+	}
+}
+
+func compareClip(t *testing.T, want, got *Clip) {
+	if got == nil {
+		t.Fatal("clip ArmatureAction is missing")
+	}
+
+	if got.StartFrame != want.StartFrame || got.EndFrame != want.EndFrame {
+		t.Fatalf("got frames %d-%d, want %d-%d", got.StartFrame, got.EndFrame, want.StartFrame, want.EndFrame)
+	}
+
+	for boneName, frames := range want.Keyframes {
+		for _, frame := range frames.Keys() {
+			g := got.Keyframes[boneName]
+
+			if g == nil || g.Get(frame) == nil {
+				t.Fatalf("bone %q frame %d is missing", boneName, frame)
+			}
+
+			compareFloats(t, boneName+" keyframe", g.Get(frame).Get1D(), frames.Get(frame).Get1D())
+		}
+	}
+}
+
+func compareFloats(t *testing.T, what string, got, want []float32) {
+	if len(got) != len(want) {
+		t.Fatalf("%s has %d values, want %d", what, len(got), len(want))
+	}
+
+	for i := range want {
+		if d := math.Abs(float64(got[i] - want[i])); d > tolerance {
+			t.Fatalf("%s value %d is %g, want %g", what, i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package gltf
+
+import "encoding/json"
+
+// the subset of the glTF 2.0 document structure the importer reads and the exporter writes
+
+type document struct {
+	Asset       asset           `json:"asset"`
+	Scene       *int            `json:"scene,omitempty"`
+	Scenes      []scene         `json:"scenes,omitempty"`
+	Nodes       []node          `json:"nodes,omitempty"`
+	Meshes      []mesh          `json:"meshes,omitempty"`
+	Skins       []skin          `json:"skins,omitempty"`
+	Animations  []gltfAnimation `json:"animations,omitempty"`
+	Accessors   []accessor      `json:"accessors,omitempty"`
+	BufferViews []bufferView    `json:"bufferViews,omitempty"`
+	Buffers     []buffer        `json:"buffers,omitempty"`
+	Materials   []material      `json:"materials,omitempty"`
+	Textures    []texture       `json:"textures,omitempty"`
+	Images      []image         `json:"images,omitempty"`
+}
+
+type asset struct {
+	Version   string `json:"version"`
+	Generator string `json:"generator,omitempty"`
+}
+
+type scene struct {
+	Nodes []int `json:"nodes"`
+}
+
+type node struct {
+	Name        string    `json:"name,omitempty"`
+	Children    []int     `json:"children,omitempty"`
+	Mesh        *int      `json:"mesh,omitempty"`
+	Skin        *int      `json:"skin,omitempty"`
+	Matrix      []float32 `json:"matrix,omitempty"`
+	Translation []float32 `json:"translation,omitempty"`
+	Rotation    []float32 `json:"rotation,omitempty"`
+	Scale       []float32 `json:"scale,omitempty"`
+	Weights     []float32 `json:"weights,omitempty"`
+}
+
+type mesh struct {
+	Name       string      `json:"name,omitempty"`
+	Primitives []primitive `json:"primitives"`
+	Weights    []float32   `json:"weights,omitempty"`
+	Extras     *meshExtras `json:"extras,omitempty"`
+}
+
+type meshExtras struct {
+	TargetNames []string `json:"targetNames,omitempty"`
+}
+
+type primitive struct {
+	Attributes map[string]int   `json:"attributes"`
+	Indices    *int             `json:"indices,omitempty"`
+	Material   *int             `json:"material,omitempty"`
+	Mode       *int             `json:"mode,omitempty"`
+	Targets    []map[string]int `json:"targets,omitempty"`
+}
+
+type skin struct {
+	Name                string `json:"name,omitempty"`
+	InverseBindMatrices *int   `json:"inverseBindMatrices,omitempty"`
+	Joints              []int  `json:"joints"`
+	Skeleton            *int   `json:"skeleton,omitempty"`
+}
+
+type gltfAnimation struct {
+	Name     string    `json:"name,omitempty"`
+	Channels []channel `json:"channels"`
+	Samplers []sampler `json:"samplers"`
+}
+
+type channel struct {
+	Sampler int           `json:"sampler"`
+	Target  channelTarget `json:"target"`
+}
+
+type channelTarget struct {
+	Node *int   `json:"node,omitempty"`
+	Path string `json:"path"`
+}
+
+type sampler struct {
+	Input         int    `json:"input"`
+	Output        int    `json:"output"`
+	Interpolation string `json:"interpolation,omitempty"`
+}
+
+type accessor struct {
+	BufferView    *int             `json:"bufferView,omitempty"`
+	ByteOffset    int              `json:"byteOffset,omitempty"`
+	ComponentType int              `json:"componentType"`
+	Normalized    bool             `json:"normalized,omitempty"`
+	Count         int              `json:"count"`
+	Type          string           `json:"type"`
+	Min           []float32        `json:"min,omitempty"`
+	Max           []float32        `json:"max,omitempty"`
+	Sparse        *json.RawMessage `json:"sparse,omitempty"`
+}
+
+type bufferView struct {
+	Buffer     int  `json:"buffer"`
+	ByteOffset int  `json:"byteOffset,omitempty"`
+	ByteLength int  `json:"byteLength"`
+	ByteStride int  `json:"byteStride,omitempty"`
+	Target     *int `json:"target,omitempty"`
+}
+
+type buffer struct {
+	URI        string `json:"uri,omitempty"`
+	ByteLength int    `json:"byteLength"`
+}
+
+type material struct {
+	Name                 string                `json:"name,omitempty"`
+	PbrMetallicRoughness *pbrMetallicRoughness `json:"pbrMetallicRoughness,omitempty"`
+}
+
+type pbrMetallicRoughness struct {
+	BaseColorFactor  []float32   `json:"baseColorFactor,omitempty"`
+	BaseColorTexture *textureRef `json:"baseColorTexture,omitempty"`
+}
+
+type textureRef struct {
+	Index int `json:"index"`
+}
+
+type texture struct {
+	Source *int `json:"source,omitempty"`
+}
+
+type image struct {
+	URI        string `json:"uri,omitempty"`
+	MimeType   string `json:"mimeType,omitempty"`
+	BufferView *int   `json:"bufferView,omitempty"`
+}
+
+const (
+	componentByte          = 5120
+	componentUnsignedByte  = 5121
+	componentShort         = 5122
+	componentUnsignedShort = 5123
+	componentUnsignedInt   = 5125
+	componentFloat         = 5126
+
+	modeTriangles = 4
+
+	targetArrayBuffer        = 34962
+	targetElementArrayBuffer = 34963
+
+	glbMagic     = 0x46546C67
+	glbVersion   = 2
+	glbChunkJSON = 0x4E4F534A
+	glbChunkBIN  = 0x004E4942
+
+	interpolationStep        = "STEP"
+	interpolationLinear      = "LINEAR"
+	interpolationCubicSpline = "CUBICSPLINE"
+)
+
+var componentSizes = map[int]int{
+	componentByte:          1,
+	componentUnsignedByte:  1,
+	componentShort:         2,
+	componentUnsignedShort: 2,
+	componentUnsignedInt:   4,
+	componentFloat:         4,
+}
+
+var typeComponents = map[string]int{
+	"SCALAR": 1,
+	"VEC2":   2,
+	"VEC3":   3,
+	"VEC4":   4,
+	"MAT2":   4,
+	"MAT3":   9,
+	"MAT4":   16,
+}
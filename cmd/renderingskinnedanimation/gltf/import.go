@@ -0,0 +1,1329 @@
+package gltf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+const DefaultFPS = 30
+
+type Material struct {
+	Name            string
+	BaseColorFactor [4]float32
+	// DiffuseTexture is the base colour image path, resolved relative to the glTF file, or empty if the
+	// material has no texture or the image is embedded in a buffer, in which case DiffuseTextureData holds it.
+	DiffuseTexture     string
+	DiffuseTextureData []byte
+}
+
+// Primitive is one draw call's worth of a glTF mesh: the vertices that share a material.
+type Primitive struct {
+	Mesh     *animation.Mesh
+	Material *Material
+}
+
+type Clip struct {
+	Name       string
+	StartFrame int64
+	EndFrame   int64
+	FPS        int64
+	// Keyframes holds a matrix per bone for every frame, relative to the bone's rest pose, in the
+	// bone -> frame -> matrix shape NewSkinnedAnimation consumes.
+	Keyframes map[string]*animation.IntToMatrix4fMap
+	// MorphWeights holds the morph target weight tracks per mesh name.
+	MorphWeights map[string]animation.MorphAnimation
+}
+
+type Scene struct {
+	Meshes        map[string][]*Primitive
+	Armatures     map[string]*animation.Armature
+	MeshArmatures map[string]string // mesh name -> name of the armature that skins it
+	Clips         map[string]*Clip
+}
+
+type importer struct {
+	doc     *document
+	baseDir string
+	fps     int64
+	buffers [][]byte
+
+	parents    map[int]int
+	nodeNames  map[int]string
+	meshNames  map[int]string
+	jointSkins map[int]int
+	armatures  []*animation.Armature
+}
+
+// Load imports a .gltf or .glb file, sampling its animations at fps frames per second.
+func Load(path string, fps int64) (*Scene, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data, filepath.Dir(path), fps)
+}
+
+// Parse imports glTF JSON or GLB data; baseDir is used to resolve external buffers and images.
+func Parse(data []byte, baseDir string, fps int64) (*Scene, error) {
+	if fps <= 0 {
+		fps = DefaultFPS
+	}
+
+	var binChunk []byte
+
+	if len(data) >= 4 && binary.LittleEndian.Uint32(data) == glbMagic {
+		var err error
+
+		data, binChunk, err = readGLB(data)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doc := &document{}
+
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("gltf: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(doc.Asset.Version, "2.") {
+		return nil, fmt.Errorf("gltf: unsupported version %q", doc.Asset.Version)
+	}
+
+	im := &importer{
+		doc:        doc,
+		baseDir:    baseDir,
+		fps:        fps,
+		parents:    map[int]int{},
+		nodeNames:  map[int]string{},
+		meshNames:  map[int]string{},
+		jointSkins: map[int]int{},
+	}
+
+	if err := im.loadBuffers(binChunk); err != nil {
+		return nil, err
+	}
+
+	return im.importScene()
+}
+
+func readGLB(data []byte) ([]byte, []byte, error) {
+	if len(data) < 12 {
+		return nil, nil, fmt.Errorf("gltf: glb header is truncated")
+	}
+
+	if version := binary.LittleEndian.Uint32(data[4:]); version != glbVersion {
+		return nil, nil, fmt.Errorf("gltf: unsupported glb version %d", version)
+	}
+
+	length := int(binary.LittleEndian.Uint32(data[8:]))
+
+	if length > len(data) {
+		return nil, nil, fmt.Errorf("gltf: glb declares %d bytes but only %d were read", length, len(data))
+	}
+
+	var jsonChunk, binChunk []byte
+
+	for offset := 12; offset+8 <= length; {
+		chunkLength := int(binary.LittleEndian.Uint32(data[offset:]))
+		chunkType := binary.LittleEndian.Uint32(data[offset+4:])
+		start := offset + 8
+
+		if chunkLength < 0 || start+chunkLength > length {
+			return nil, nil, fmt.Errorf("gltf: glb chunk at byte %d overruns the file", offset)
+		}
+
+		switch chunkType {
+		case glbChunkJSON:
+			jsonChunk = data[start : start+chunkLength]
+		case glbChunkBIN:
+			if binChunk == nil {
+				binChunk = data[start : start+chunkLength]
+			}
+		}
+
+		offset = start + chunkLength
+	}
+
+	if jsonChunk == nil {
+		return nil, nil, fmt.Errorf("gltf: glb has no JSON chunk")
+	}
+
+	return jsonChunk, binChunk, nil
+}
+
+func (im *importer) loadBuffers(binChunk []byte) error {
+	for i, b := range im.doc.Buffers {
+		var data []byte
+
+		switch {
+		case b.URI == "":
+			// only the first buffer of a glb may omit its uri, it refers to the binary chunk
+			if i != 0 || binChunk == nil {
+				return fmt.Errorf("gltf: buffer %d has no uri", i)
+			}
+			data = binChunk
+		case strings.HasPrefix(b.URI, "data:"):
+			comma := strings.Index(b.URI, ",")
+
+			if comma < 0 || !strings.HasSuffix(b.URI[:comma], ";base64") {
+				return fmt.Errorf("gltf: buffer %d has an unsupported data uri", i)
+			}
+
+			decoded, err := base64.StdEncoding.DecodeString(b.URI[comma+1:])
+
+			if err != nil {
+				return fmt.Errorf("gltf: buffer %d: %s", i, err.Error())
+			}
+			data = decoded
+		default:
+			file, err := url.PathUnescape(b.URI)
+
+			if err != nil {
+				return fmt.Errorf("gltf: buffer %d: %s", i, err.Error())
+			}
+
+			data, err = ioutil.ReadFile(filepath.Join(im.baseDir, file))
+
+			if err != nil {
+				return fmt.Errorf("gltf: buffer %d: %s", i, err.Error())
+			}
+		}
+
+		if len(data) < b.ByteLength {
+			return fmt.Errorf("gltf: buffer %d declares %d bytes but only %d were read", i, b.ByteLength, len(data))
+		}
+
+		im.buffers = append(im.buffers, data)
+	}
+
+	return nil
+}
+
+func (im *importer) importScene() (*Scene, error) {
+	doc := im.doc
+
+	for i, n := range doc.Nodes {
+		for _, child := range n.Children {
+			if child < 0 || child >= len(doc.Nodes) {
+				return nil, fmt.Errorf("gltf: node %d has child %d which does not exist", i, child)
+			}
+			im.parents[child] = i
+		}
+	}
+
+	im.nameNodes()
+
+	scene := &Scene{
+		Meshes:        map[string][]*Primitive{},
+		Armatures:     map[string]*animation.Armature{},
+		MeshArmatures: map[string]string{},
+		Clips:         map[string]*Clip{},
+	}
+
+	for i := range doc.Skins {
+		armature, err := im.importSkin(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+		im.armatures = append(im.armatures, armature)
+		scene.Armatures[armature.Name] = armature
+	}
+
+	for i, m := range doc.Meshes {
+		name := m.Name
+		if name == "" {
+			name = fmt.Sprintf("mesh%d", i)
+		}
+		im.meshNames[i] = uniqueName(name, func(n string) bool {
+			_, present := scene.Meshes[n]
+			return present
+		})
+		scene.Meshes[im.meshNames[i]] = nil
+	}
+
+	// a mesh is skinned by the skin of the node that instantiates it
+	meshSkins := map[int]int{}
+
+	for _, n := range doc.Nodes {
+		if n.Mesh != nil && n.Skin != nil {
+			meshSkins[*n.Mesh] = *n.Skin
+		}
+	}
+
+	for i := range doc.Meshes {
+		var joints []string
+
+		if skinIndex, present := meshSkins[i]; present && skinIndex >= 0 && skinIndex < len(doc.Skins) {
+			for _, joint := range doc.Skins[skinIndex].Joints {
+				joints = append(joints, im.nodeNames[joint])
+			}
+			scene.MeshArmatures[im.meshNames[i]] = im.armatures[skinIndex].Name
+		}
+
+		primitives, err := im.importMesh(i, joints)
+
+		if err != nil {
+			return nil, err
+		}
+
+		scene.Meshes[im.meshNames[i]] = primitives
+	}
+
+	for i, a := range doc.Animations {
+		clip, err := im.importAnimation(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := a.Name
+		if name == "" {
+			name = fmt.Sprintf("animation%d", i)
+		}
+		clip.Name = uniqueName(name, func(n string) bool {
+			_, present := scene.Clips[n]
+			return present
+		})
+		scene.Clips[clip.Name] = clip
+	}
+
+	return scene, nil
+}
+
+// nameNodes gives every node a unique name, as bones are looked up by name everywhere in the engine.
+func (im *importer) nameNodes() {
+	used := map[string]bool{}
+
+	for i, n := range im.doc.Nodes {
+		name := n.Name
+		if name == "" {
+			name = fmt.Sprintf("node%d", i)
+		}
+
+		unique := name
+		for suffix := 1; used[unique]; suffix++ {
+			unique = fmt.Sprintf("%s.%03d", name, suffix)
+		}
+
+		used[unique] = true
+		im.nodeNames[i] = unique
+	}
+}
+
+func uniqueName(name string, exists func(string) bool) string {
+	unique := name
+	for suffix := 1; exists(unique); suffix++ {
+		unique = fmt.Sprintf("%s.%03d", name, suffix)
+	}
+
+	return unique
+}
+
+func (im *importer) nodeLocal(i int) *animation.Matrix4f {
+	n := im.doc.Nodes[i]
+
+	if len(n.Matrix) == 16 {
+		return columnMajorToMatrix(n.Matrix)
+	}
+
+	return nodeTransform(n).Matrix()
+}
+
+func nodeTransform(n node) animation.Transform {
+	t := animation.IdentityTransform()
+
+	if len(n.Matrix) == 16 {
+		return animation.DecomposeMatrix(columnMajorToMatrix(n.Matrix))
+	}
+
+	if len(n.Translation) == 3 {
+		t.Translation = animation.Vector3f{X: n.Translation[0], Y: n.Translation[1], Z: n.Translation[2]}
+	}
+
+	if len(n.Rotation) == 4 {
+		t.Rotation = animation.Quaternion{X: n.Rotation[0], Y: n.Rotation[1], Z: n.Rotation[2], W: n.Rotation[3]}
+	}
+
+	if len(n.Scale) == 3 {
+		t.Scale = animation.Vector3f{X: n.Scale[0], Y: n.Scale[1], Z: n.Scale[2]}
+	}
+
+	return t
+}
+
+func (im *importer) nodeGlobal(i int, locals func(int) *animation.Matrix4f) *animation.Matrix4f {
+	m := locals(i)
+
+	// walk up the parents, bounded by the node count in case the hierarchy loops
+	for n, steps := i, 0; steps < len(im.doc.Nodes); steps++ {
+		parent, present := im.parents[n]
+
+		if !present {
+			break
+		}
+
+		m = locals(parent).Mul(m)
+		n = parent
+	}
+
+	return m
+}
+
+// jointParent returns the nearest ancestor of a node that is also a joint of the skin, or -1.
+func (im *importer) jointParent(i int, joints map[int]bool) int {
+	for n, steps := i, 0; steps < len(im.doc.Nodes); steps++ {
+		parent, present := im.parents[n]
+
+		if !present {
+			return -1
+		}
+
+		if joints[parent] {
+			return parent
+		}
+
+		n = parent
+	}
+
+	return -1
+}
+
+func (im *importer) importSkin(i int) (*animation.Armature, error) {
+	s := im.doc.Skins[i]
+
+	name := s.Name
+	if name == "" {
+		name = fmt.Sprintf("skin%d", i)
+	}
+
+	armature := &animation.Armature{
+		Name:  name,
+		Bones: map[string]*animation.Bone{},
+	}
+
+	var inverseBindMatrices []float32
+
+	if s.InverseBindMatrices != nil {
+		values, components, err := im.readAccessor(*s.InverseBindMatrices)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if components != 16 || len(values) < len(s.Joints)*16 {
+			return nil, fmt.Errorf("gltf: skin %d needs a MAT4 inverse bind matrix per joint", i)
+		}
+
+		inverseBindMatrices = values
+	}
+
+	joints := map[int]bool{}
+
+	for _, joint := range s.Joints {
+		if joint < 0 || joint >= len(im.doc.Nodes) {
+			return nil, fmt.Errorf("gltf: skin %d has joint %d which does not exist", i, joint)
+		}
+		joints[joint] = true
+	}
+
+	for j, joint := range s.Joints {
+		bone := &animation.Bone{Name: im.nodeNames[joint]}
+
+		if parent := im.jointParent(joint, joints); parent >= 0 {
+			bone.ParentName = im.nodeNames[parent]
+		}
+
+		if inverseBindMatrices != nil {
+			bone.MatrixLocalInverted = columnMajorToMatrix(inverseBindMatrices[j*16 : j*16+16])
+			bone.MatrixLocal = bone.MatrixLocalInverted.Inverse()
+		} else {
+			bone.MatrixLocal = im.nodeGlobal(joint, im.nodeLocal)
+			bone.MatrixLocalInverted = bone.MatrixLocal.Inverse()
+		}
+
+		if bone.MatrixLocal == nil || bone.MatrixLocalInverted == nil {
+			return nil, fmt.Errorf("gltf: skin %d joint %q has a singular bind matrix", i, bone.Name)
+		}
+
+		armature.Bones[bone.Name] = bone
+
+		if _, present := im.jointSkins[joint]; !present {
+			im.jointSkins[joint] = i
+		}
+	}
+
+	return armature, nil
+}
+
+func (im *importer) importMesh(i int, joints []string) ([]*Primitive, error) {
+	m := im.doc.Meshes[i]
+	primitives := []*Primitive{}
+
+	for p, prim := range m.Primitives {
+		mesh, err := im.importPrimitive(m, prim, joints)
+
+		if err != nil {
+			return nil, fmt.Errorf("gltf: mesh %d primitive %d: %s", i, p, err.Error())
+		}
+
+		material := &Material{
+			Name:            "default",
+			BaseColorFactor: [4]float32{1, 1, 1, 1},
+		}
+
+		if prim.Material != nil {
+			material, err = im.importMaterial(*prim.Material)
+
+			if err != nil {
+				return nil, fmt.Errorf("gltf: mesh %d primitive %d: %s", i, p, err.Error())
+			}
+		}
+
+		primitives = append(primitives, &Primitive{mesh, material})
+	}
+
+	return primitives, nil
+}
+
+func (im *importer) importPrimitive(m mesh, prim primitive, joints []string) (*animation.Mesh, error) {
+	if prim.Mode != nil && *prim.Mode != modeTriangles {
+		return nil, fmt.Errorf("only triangle primitives are supported, got mode %d", *prim.Mode)
+	}
+
+	positionAccessor, present := prim.Attributes["POSITION"]
+
+	if !present {
+		return nil, fmt.Errorf("primitive has no POSITION attribute")
+	}
+
+	positions, components, err := im.readAccessor(positionAccessor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if components != 3 {
+		return nil, fmt.Errorf("POSITION must be a VEC3")
+	}
+
+	count := len(positions) / 3
+
+	uvs := make([]float32, count*2)
+
+	if accessorIndex, present := prim.Attributes["TEXCOORD_0"]; present {
+		values, components, err := im.readAccessor(accessorIndex)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if components != 2 || len(values) != count*2 {
+			return nil, fmt.Errorf("TEXCOORD_0 must be a VEC2 per vertex")
+		}
+
+		uvs = values
+	}
+
+	mesh := &animation.Mesh{
+		Indices:      []uint32{},
+		Coordinates:  make([]animation.Coordinate, count),
+		MorphTargets: []animation.MorphTarget{},
+	}
+
+	for v := 0; v < count; v++ {
+		// glTF puts the uv origin at the top left, the Blender exporter (and so the examples) at the bottom left
+		mesh.Coordinates[v] = animation.Coordinate{
+			Index:    v,
+			Vertices: []float32{positions[v*3], positions[v*3+1], positions[v*3+2]},
+			Textures: []float32{uvs[v*2], 1 - uvs[v*2+1]},
+			Skin:     map[string]float32{},
+		}
+	}
+
+	for set := 0; ; set++ {
+		jointAccessor, hasJoints := prim.Attributes[fmt.Sprintf("JOINTS_%d", set)]
+		weightAccessor, hasWeights := prim.Attributes[fmt.Sprintf("WEIGHTS_%d", set)]
+
+		if !hasJoints || !hasWeights {
+			break
+		}
+
+		jointValues, jointComponents, err := im.readAccessor(jointAccessor)
+
+		if err != nil {
+			return nil, err
+		}
+
+		weightValues, weightComponents, err := im.readAccessor(weightAccessor)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if jointComponents != 4 || weightComponents != 4 || len(jointValues) != count*4 || len(weightValues) != count*4 {
+			return nil, fmt.Errorf("JOINTS_%d and WEIGHTS_%d must be a VEC4 per vertex", set, set)
+		}
+
+		for v := 0; v < count; v++ {
+			coordinate := &mesh.Coordinates[v]
+
+			for k := 0; k < 4; k++ {
+				weight := weightValues[v*4+k]
+
+				if weight == 0 {
+					continue
+				}
+
+				joint := int(jointValues[v*4+k])
+
+				if joint < 0 || joint >= len(joints) {
+					return nil, fmt.Errorf("vertex %d references joint %d but the skin has %d", v, joint, len(joints))
+				}
+
+				coordinate.Skin[joints[joint]] += weight
+				coordinate.TotalWeight += weight
+			}
+		}
+	}
+
+	if prim.Indices != nil {
+		indices, err := im.readIndices(*prim.Indices)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range indices {
+			if int(index) >= count {
+				return nil, fmt.Errorf("index %d is out of range for %d vertices", index, count)
+			}
+		}
+
+		mesh.Indices = indices
+	} else {
+		for v := 0; v < count; v++ {
+			mesh.Indices = append(mesh.Indices, uint32(v))
+		}
+	}
+
+	for t, target := range prim.Targets {
+		morphTarget, err := im.importMorphTarget(m, t, target, count)
+
+		if err != nil {
+			return nil, err
+		}
+
+		mesh.MorphTargets = append(mesh.MorphTargets, morphTarget)
+	}
+
+	return mesh, nil
+}
+
+func (im *importer) importMorphTarget(m mesh, t int, target map[string]int, count int) (animation.MorphTarget, error) {
+	name := fmt.Sprintf("target%d", t)
+
+	if m.Extras != nil && t < len(m.Extras.TargetNames) {
+		name = m.Extras.TargetNames[t]
+	}
+
+	morphTarget := animation.MorphTarget{Name: name, Deltas: []animation.MorphDelta{}}
+	attributes := map[string][]float32{}
+
+	for _, attribute := range []string{"POSITION", "NORMAL"} {
+		accessorIndex, present := target[attribute]
+
+		if !present {
+			continue
+		}
+
+		values, components, err := im.readAccessor(accessorIndex)
+
+		if err != nil {
+			return morphTarget, err
+		}
+
+		if components != 3 || len(values) != count*3 {
+			return morphTarget, fmt.Errorf("morph target %d %s must be a VEC3 per vertex", t, attribute)
+		}
+
+		attributes[attribute] = values
+	}
+
+	for v := 0; v < count; v++ {
+		position := []float32{0, 0, 0}
+		normal := []float32{0, 0, 0}
+		changed := false
+
+		if values := attributes["POSITION"]; values != nil {
+			copy(position, values[v*3:v*3+3])
+		}
+
+		if values := attributes["NORMAL"]; values != nil {
+			copy(normal, values[v*3:v*3+3])
+		}
+
+		for k := 0; k < 3; k++ {
+			changed = changed || position[k] != 0 || normal[k] != 0
+		}
+
+		// deltas are sparse in the engine format, vertices the target does not move are left out
+		if changed {
+			morphTarget.Deltas = append(morphTarget.Deltas, animation.MorphDelta{Index: v, Position: position, Normal: normal})
+		}
+	}
+
+	return morphTarget, nil
+}
+
+func (im *importer) importMaterial(i int) (*Material, error) {
+	if i < 0 || i >= len(im.doc.Materials) {
+		return nil, fmt.Errorf("material %d does not exist", i)
+	}
+
+	m := im.doc.Materials[i]
+
+	material := &Material{
+		Name:            m.Name,
+		BaseColorFactor: [4]float32{1, 1, 1, 1},
+	}
+
+	if material.Name == "" {
+		material.Name = fmt.Sprintf("material%d", i)
+	}
+
+	pbr := m.PbrMetallicRoughness
+
+	if pbr == nil {
+		return material, nil
+	}
+
+	if len(pbr.BaseColorFactor) == 4 {
+		copy(material.BaseColorFactor[:], pbr.BaseColorFactor)
+	}
+
+	if pbr.BaseColorTexture == nil {
+		return material, nil
+	}
+
+	textureIndex := pbr.BaseColorTexture.Index
+
+	if textureIndex < 0 || textureIndex >= len(im.doc.Textures) {
+		return nil, fmt.Errorf("material %d references texture %d which does not exist", i, textureIndex)
+	}
+
+	source := im.doc.Textures[textureIndex].Source
+
+	if source == nil {
+		return material, nil
+	}
+
+	if *source < 0 || *source >= len(im.doc.Images) {
+		return nil, fmt.Errorf("texture %d references image %d which does not exist", textureIndex, *source)
+	}
+
+	img := im.doc.Images[*source]
+
+	switch {
+	case img.BufferView != nil:
+		data, _, err := im.bufferViewData(*img.BufferView)
+
+		if err != nil {
+			return nil, err
+		}
+
+		material.DiffuseTextureData = data
+	case strings.HasPrefix(img.URI, "data:"):
+		comma := strings.Index(img.URI, ",")
+
+		if comma < 0 {
+			return nil, fmt.Errorf("image %d has an invalid data uri", *source)
+		}
+
+		data, err := base64.StdEncoding.DecodeString(img.URI[comma+1:])
+
+		if err != nil {
+			return nil, fmt.Errorf("image %d: %s", *source, err.Error())
+		}
+
+		material.DiffuseTextureData = data
+	case img.URI != "":
+		file, err := url.PathUnescape(img.URI)
+
+		if err != nil {
+			return nil, fmt.Errorf("image %d: %s", *source, err.Error())
+		}
+
+		material.DiffuseTexture = filepath.Join(im.baseDir, file)
+	}
+
+	return material, nil
+}
+
+func (im *importer) importAnimation(i int) (*Clip, error) {
+	a := im.doc.Animations[i]
+
+	type channelSampler struct {
+		times         []float32
+		values        []float32
+		interpolation string
+	}
+
+	// node -> path -> sampler
+	nodeChannels := map[int]map[string]*channelSampler{}
+	duration := float32(0)
+
+	for c, ch := range a.Channels {
+		if ch.Target.Node == nil {
+			continue
+		}
+
+		nodeIndex := *ch.Target.Node
+
+		if nodeIndex < 0 || nodeIndex >= len(im.doc.Nodes) {
+			return nil, fmt.Errorf("gltf: animation %d channel %d targets node %d which does not exist", i, c, nodeIndex)
+		}
+
+		if ch.Sampler < 0 || ch.Sampler >= len(a.Samplers) {
+			return nil, fmt.Errorf("gltf: animation %d channel %d uses sampler %d which does not exist", i, c, ch.Sampler)
+		}
+
+		s := a.Samplers[ch.Sampler]
+
+		times, _, err := im.readAccessor(s.Input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		values, _, err := im.readAccessor(s.Output)
+
+		if err != nil {
+			return nil, err
+		}
+
+		interpolation := s.Interpolation
+		if interpolation == "" {
+			interpolation = interpolationLinear
+		}
+
+		switch interpolation {
+		case interpolationStep, interpolationLinear, interpolationCubicSpline:
+		default:
+			return nil, fmt.Errorf("gltf: animation %d sampler %d has unknown interpolation %q", i, ch.Sampler, interpolation)
+		}
+
+		if len(times) == 0 {
+			continue
+		}
+
+		if duration < times[len(times)-1] {
+			duration = times[len(times)-1]
+		}
+
+		if nodeChannels[nodeIndex] == nil {
+			nodeChannels[nodeIndex] = map[string]*channelSampler{}
+		}
+
+		nodeChannels[nodeIndex][ch.Target.Path] = &channelSampler{times, values, interpolation}
+	}
+
+	frames := math.Round(float64(duration) * float64(im.fps))
+
+	if !(frames <= animation.MaxFrames) {
+		return nil, fmt.Errorf("gltf: animation %d lasts %gs, more than %d frames at %d fps", i, duration, animation.MaxFrames, im.fps)
+	}
+
+	clip := &Clip{
+		StartFrame:   1,
+		EndFrame:     int64(frames) + 1,
+		FPS:          im.fps,
+		Keyframes:    map[string]*animation.IntToMatrix4fMap{},
+		MorphWeights: map[string]animation.MorphAnimation{},
+	}
+
+	sampleTime := func(frame int64) float32 {
+		return float32(frame-clip.StartFrame) / float32(im.fps)
+	}
+
+	animatedLocal := func(nodeIndex int, t float32) *animation.Matrix4f {
+		channels := nodeChannels[nodeIndex]
+
+		if channels == nil {
+			return im.nodeLocal(nodeIndex)
+		}
+
+		transform := nodeTransform(im.doc.Nodes[nodeIndex])
+
+		if s := channels["translation"]; s != nil {
+			v, err := sampleChannel(s.times, s.values, 3, s.interpolation, t, false)
+			if err == nil {
+				transform.Translation = animation.Vector3f{X: v[0], Y: v[1], Z: v[2]}
+			}
+		}
+
+		if s := channels["rotation"]; s != nil {
+			v, err := sampleChannel(s.times, s.values, 4, s.interpolation, t, true)
+			if err == nil {
+				transform.Rotation = animation.Quaternion{X: v[0], Y: v[1], Z: v[2], W: v[3]}
+			}
+		}
+
+		if s := channels["scale"]; s != nil {
+			v, err := sampleChannel(s.times, s.values, 3, s.interpolation, t, false)
+			if err == nil {
+				transform.Scale = animation.Vector3f{X: v[0], Y: v[1], Z: v[2]}
+			}
+		}
+
+		return transform.Matrix()
+	}
+
+	// check every channel has enough output values before sampling, so sampling itself cannot fail
+	for nodeIndex, channels := range nodeChannels {
+		for path, s := range channels {
+			components := 0
+
+			switch path {
+			case "translation", "scale":
+				components = 3
+			case "rotation":
+				components = 4
+			case "weights":
+				components = len(im.doc.Nodes[nodeIndex].Weights)
+
+				if n := im.doc.Nodes[nodeIndex].Mesh; n != nil && components == 0 && *n >= 0 && *n < len(im.doc.Meshes) {
+					components = len(im.doc.Meshes[*n].Weights)
+				}
+
+				if components == 0 {
+					components = weightComponents(s.times, s.values, s.interpolation)
+				}
+			default:
+				continue
+			}
+
+			if _, err := sampleChannel(s.times, s.values, components, s.interpolation, 0, false); err != nil {
+				return nil, fmt.Errorf("gltf: animation %d node %d %s: %s", i, nodeIndex, path, err.Error())
+			}
+		}
+	}
+
+	for skinIndex, s := range im.doc.Skins {
+		armature := im.armatures[skinIndex]
+
+		joints := map[int]bool{}
+		for _, joint := range s.Joints {
+			joints[joint] = true
+		}
+
+		for _, joint := range s.Joints {
+			// a joint shared by several skins is keyed once, against the first skin's bind pose
+			if im.jointSkins[joint] != skinIndex {
+				continue
+			}
+
+			bone := armature.Bones[im.nodeNames[joint]]
+			parentJoint := im.jointParent(joint, joints)
+
+			// the rest pose of the bone relative to its parent bone, which the keyframes are relative to
+			var restInverse *animation.Matrix4f
+
+			if parentJoint >= 0 {
+				parent := armature.Bones[im.nodeNames[parentJoint]]
+				restInverse = parent.MatrixLocalInverted.Mul(bone.MatrixLocal).Inverse()
+			} else {
+				restInverse = bone.MatrixLocalInverted
+			}
+
+			if restInverse == nil {
+				return nil, fmt.Errorf("gltf: joint %q has a singular rest pose", bone.Name)
+			}
+
+			frames := animation.NewIntToMatrix4fMap()
+
+			for frame := clip.StartFrame; frame <= clip.EndFrame; frame++ {
+				t := sampleTime(frame)
+				locals := func(n int) *animation.Matrix4f { return animatedLocal(n, t) }
+
+				// the animated transform relative to the parent bone: just the local transform for a child, but a
+				// root bone also carries every non-joint node above it
+				var animated *animation.Matrix4f
+
+				if parentJoint >= 0 {
+					parentInverse := im.nodeGlobal(parentJoint, locals).Inverse()
+
+					if parentInverse == nil {
+						return nil, fmt.Errorf("gltf: node %q has a singular transform at %gs", im.nodeNames[parentJoint], t)
+					}
+
+					animated = parentInverse.Mul(im.nodeGlobal(joint, locals))
+				} else {
+					animated = im.nodeGlobal(joint, locals)
+				}
+
+				frames.Set(int(frame), restInverse.Mul(animated))
+			}
+
+			clip.Keyframes[bone.Name] = frames
+		}
+	}
+
+	for nodeIndex, channels := range nodeChannels {
+		s := channels["weights"]
+		meshIndex := im.doc.Nodes[nodeIndex].Mesh
+
+		if s == nil || meshIndex == nil || *meshIndex < 0 || *meshIndex >= len(im.doc.Meshes) {
+			continue
+		}
+
+		m := im.doc.Meshes[*meshIndex]
+		components := weightComponents(s.times, s.values, s.interpolation)
+		tracks := animation.MorphAnimation{}
+
+		for target := 0; target < components; target++ {
+			name := fmt.Sprintf("target%d", target)
+
+			if m.Extras != nil && target < len(m.Extras.TargetNames) {
+				name = m.Extras.TargetNames[target]
+			}
+
+			tracks[name] = animation.NewIntToFloat32Map()
+		}
+
+		for frame := clip.StartFrame; frame <= clip.EndFrame; frame++ {
+			weights, err := sampleChannel(s.times, s.values, components, s.interpolation, sampleTime(frame), false)
+
+			if err != nil {
+				return nil, fmt.Errorf("gltf: animation %d node %d weights: %s", i, nodeIndex, err.Error())
+			}
+
+			for target, weight := range weights {
+				name := fmt.Sprintf("target%d", target)
+
+				if m.Extras != nil && target < len(m.Extras.TargetNames) {
+					name = m.Extras.TargetNames[target]
+				}
+
+				tracks[name].Set(int(frame), weight)
+			}
+		}
+
+		clip.MorphWeights[im.meshNames[*meshIndex]] = tracks
+	}
+
+	return clip, nil
+}
+
+func weightComponents(times, values []float32, interpolation string) int {
+	if len(times) == 0 {
+		return 0
+	}
+
+	if interpolation == interpolationCubicSpline {
+		return len(values) / len(times) / 3
+	}
+
+	return len(values) / len(times)
+}
+
+// sampleChannel evaluates an animation sampler with the given number of components per key at time t, clamping
+// outside the keyed range. Rotations are interpolated spherically and renormalised.
+func sampleChannel(times, values []float32, components int, interpolation string, t float32, rotation bool) ([]float32, error) {
+	keyCount := len(times)
+	stride := components
+
+	if interpolation == interpolationCubicSpline {
+		stride = components * 3
+	}
+
+	if components <= 0 || len(values) < keyCount*stride {
+		return nil, fmt.Errorf("sampler has %d values for %d keys of %d components", len(values), keyCount, components)
+	}
+
+	// the value itself sits between the in and out tangents for cubic splines
+	value := func(k int) []float32 {
+		offset := k * stride
+		if interpolation == interpolationCubicSpline {
+			offset += components
+		}
+		return values[offset : offset+components]
+	}
+
+	k := sort.Search(keyCount, func(i int) bool { return times[i] > t }) - 1
+
+	if k < 0 {
+		return append([]float32{}, value(0)...), nil
+	}
+
+	if k >= keyCount-1 || interpolation == interpolationStep {
+		return append([]float32{}, value(k)...), nil
+	}
+
+	dt := times[k+1] - times[k]
+	s := float32(0)
+
+	if dt > 0 {
+		s = (t - times[k]) / dt
+	}
+
+	a := value(k)
+	b := value(k + 1)
+	result := make([]float32, components)
+
+	switch interpolation {
+	case interpolationLinear:
+		if rotation && components == 4 {
+			q := animation.Quaternion{X: a[0], Y: a[1], Z: a[2], W: a[3]}.Slerp(animation.Quaternion{X: b[0], Y: b[1], Z: b[2], W: b[3]}, s)
+			return []float32{q.X, q.Y, q.Z, q.W}, nil
+		}
+
+		for c := range result {
+			result[c] = a[c] + (b[c]-a[c])*s
+		}
+	case interpolationCubicSpline:
+		outTangent := values[k*stride+2*components : k*stride+3*components]
+		inTangent := values[(k+1)*stride : (k+1)*stride+components]
+
+		s2 := s * s
+		s3 := s2 * s
+
+		for c := range result {
+			result[c] = (2*s3-3*s2+1)*a[c] + (s3-2*s2+s)*dt*outTangent[c] + (-2*s3+3*s2)*b[c] + (s3-s2)*dt*inTangent[c]
+		}
+
+		if rotation && components == 4 {
+			q := animation.Quaternion{X: result[0], Y: result[1], Z: result[2], W: result[3]}.Normalize()
+			return []float32{q.X, q.Y, q.Z, q.W}, nil
+		}
+	}
+
+	return result, nil
+}
+
+func (im *importer) bufferViewData(i int) ([]byte, int, error) {
+	if i < 0 || i >= len(im.doc.BufferViews) {
+		return nil, 0, fmt.Errorf("gltf: buffer view %d does not exist", i)
+	}
+
+	view := im.doc.BufferViews[i]
+
+	if view.Buffer < 0 || view.Buffer >= len(im.buffers) {
+		return nil, 0, fmt.Errorf("gltf: buffer view %d references buffer %d which does not exist", i, view.Buffer)
+	}
+
+	data := im.buffers[view.Buffer]
+
+	if view.ByteOffset < 0 || view.ByteLength < 0 || view.ByteOffset+view.ByteLength > len(data) {
+		return nil, 0, fmt.Errorf("gltf: buffer view %d overruns buffer %d", i, view.Buffer)
+	}
+
+	if view.ByteStride < 0 {
+		return nil, 0, fmt.Errorf("gltf: buffer view %d has negative stride %d", i, view.ByteStride)
+	}
+
+	return data[view.ByteOffset : view.ByteOffset+view.ByteLength], view.ByteStride, nil
+}
+
+func (im *importer) bufferBytes() int {
+	total := 0
+
+	for _, b := range im.buffers {
+		total += len(b)
+	}
+
+	return total
+}
+
+// readAccessor returns the accessor's elements as floats, converting normalised integers to 0-1 or -1-1,
+// along with the number of components per element.
+func (im *importer) readAccessor(i int) ([]float32, int, error) {
+	if i < 0 || i >= len(im.doc.Accessors) {
+		return nil, 0, fmt.Errorf("gltf: accessor %d does not exist", i)
+	}
+
+	a := im.doc.Accessors[i]
+
+	if a.Sparse != nil {
+		return nil, 0, fmt.Errorf("gltf: accessor %d is sparse, which is not supported", i)
+	}
+
+	components, ok := typeComponents[a.Type]
+
+	if !ok {
+		return nil, 0, fmt.Errorf("gltf: accessor %d has unknown type %q", i, a.Type)
+	}
+
+	size, ok := componentSizes[a.ComponentType]
+
+	if !ok {
+		return nil, 0, fmt.Errorf("gltf: accessor %d has unknown component type %d", i, a.ComponentType)
+	}
+
+	if a.Count < 1 {
+		return nil, 0, fmt.Errorf("gltf: accessor %d has count %d, it needs at least one element", i, a.Count)
+	}
+
+	// an accessor without a buffer view is all zeros. It pairs with accessors that do have data, such as a
+	// morph target's positions, and every element of those takes at least a byte of the buffers.
+	if a.BufferView == nil {
+		if a.Count > im.bufferBytes() {
+			return nil, 0, fmt.Errorf("gltf: accessor %d has %d elements but no buffer view, more than the %d bytes of the buffers", i, a.Count, im.bufferBytes())
+		}
+
+		return make([]float32, a.Count*components), components, nil
+	}
+
+	data, stride, err := im.bufferViewData(*a.BufferView)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	elementSize := size * components
+
+	if stride == 0 {
+		stride = elementSize
+	}
+
+	if !accessorFits(a, len(data), stride, elementSize) {
+		return nil, 0, fmt.Errorf("gltf: accessor %d has %d elements, which overrun buffer view %d", i, a.Count, *a.BufferView)
+	}
+
+	values := make([]float32, a.Count*components)
+
+	for e := 0; e < a.Count; e++ {
+		element := data[a.ByteOffset+e*stride:]
+
+		for c := 0; c < components; c++ {
+			values[e*components+c] = readComponent(element[c*size:], a.ComponentType, a.Normalized)
+		}
+	}
+
+	return values, components, nil
+}
+
+// accessorFits reports whether all of an accessor's elements lie inside its buffer view. It is checked before
+// allocating, and divides rather than multiplies so that a huge count cannot overflow.
+func accessorFits(a accessor, length, stride, elementSize int) bool {
+	if a.Count < 1 || a.ByteOffset < 0 || a.ByteOffset+elementSize > length {
+		return false
+	}
+
+	return (length-a.ByteOffset-elementSize)/stride >= a.Count-1
+}
+
+func readComponent(b []byte, componentType int, normalized bool) float32 {
+	switch componentType {
+	case componentByte:
+		v := float32(int8(b[0]))
+		if normalized {
+			return float32(math.Max(float64(v)/127, -1))
+		}
+		return v
+	case componentUnsignedByte:
+		v := float32(b[0])
+		if normalized {
+			return v / 255
+		}
+		return v
+	case componentShort:
+		v := float32(int16(binary.LittleEndian.Uint16(b)))
+		if normalized {
+			return float32(math.Max(float64(v)/32767, -1))
+		}
+		return v
+	case componentUnsignedShort:
+		v := float32(binary.LittleEndian.Uint16(b))
+		if normalized {
+			return v / 65535
+		}
+		return v
+	case componentUnsignedInt:
+		return float32(binary.LittleEndian.Uint32(b))
+	}
+
+	return math.Float32frombits(binary.LittleEndian.Uint32(b))
+}
+
+func (im *importer) readIndices(i int) ([]uint32, error) {
+	if i < 0 || i >= len(im.doc.Accessors) {
+		return nil, fmt.Errorf("gltf: accessor %d does not exist", i)
+	}
+
+	a := im.doc.Accessors[i]
+
+	if a.Type != "SCALAR" {
+		return nil, fmt.Errorf("gltf: index accessor %d must be SCALAR", i)
+	}
+
+	if a.ComponentType != componentUnsignedByte && a.ComponentType != componentUnsignedShort && a.ComponentType != componentUnsignedInt {
+		return nil, fmt.Errorf("gltf: index accessor %d must be an unsigned integer type", i)
+	}
+
+	if a.BufferView == nil || a.Sparse != nil {
+		return nil, fmt.Errorf("gltf: index accessor %d must be a plain buffer view", i)
+	}
+
+	data, stride, err := im.bufferViewData(*a.BufferView)
+
+	if err != nil {
+		return nil, err
+	}
+
+	size := componentSizes[a.ComponentType]
+
+	if stride == 0 {
+		stride = size
+	}
+
+	if !accessorFits(a, len(data), stride, size) {
+		return nil, fmt.Errorf("gltf: index accessor %d has %d elements, which overrun buffer view %d", i, a.Count, *a.BufferView)
+	}
+
+	indices := make([]uint32, a.Count)
+	reader := bytes.NewReader(data)
+
+	for e := range indices {
+		reader.Seek(int64(a.ByteOffset+e*stride), 0)
+
+		switch size {
+		case 1:
+			b, _ := reader.ReadByte()
+			indices[e] = uint32(b)
+		case 2:
+			var v uint16
+			binary.Read(reader, binary.LittleEndian, &v)
+			indices[e] = uint32(v)
+		default:
+			binary.Read(reader, binary.LittleEndian, &indices[e])
+		}
+	}
+
+	return indices, nil
+}
+
+func columnMajorToMatrix(c []float32) *animation.Matrix4f {
+	return &animation.Matrix4f{
+		M00: c[0], M01: c[4], M02: c[8], M03: c[12],
+		M10: c[1], M11: c[5], M12: c[9], M13: c[13],
+		M20: c[2], M21: c[6], M22: c[10], M23: c[14],
+		M30: c[3], M31: c[7], M32: c[11], M33: c[15],
+	}
+}
@@ -0,0 +1,106 @@
+package gltf
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+)
+
+func TestReadAccessorBounds(t *testing.T) {
+	view := 0
+
+	tests := []struct {
+		name   string
+		count  int
+		offset int
+		stride int
+		noView bool
+		ok     bool
+	}{
+		{"fits", 4, 0, 0, false, true},
+		{"fits with offset", 3, 12, 0, false, true},
+		{"fits with stride", 2, 0, 24, false, true},
+		{"zero count", 0, 0, 0, false, false},
+		{"negative count", -1, 0, 0, false, false},
+		{"one too many", 5, 0, 0, false, false},
+		{"offset overruns", 1, 48, 0, false, false},
+		{"negative offset", 1, -4, 0, false, false},
+		{"stride overruns", 3, 0, 24, false, false},
+		{"count overflows", math.MaxInt64 / 4, 0, 0, false, false},
+		{"zeros", 4, 0, 0, true, true},
+		{"zeros as long as the buffers", 48, 0, 0, true, true},
+		{"zeros longer than the buffers", 49, 0, 0, true, false},
+		{"zeros overflow", math.MaxInt64 / 2, 0, 0, true, false},
+	}
+
+	for _, test := range tests {
+		accessorView := &view
+
+		if test.noView {
+			accessorView = nil
+		}
+
+		im := &importer{
+			doc: &document{
+				Accessors:   []accessor{{BufferView: accessorView, ByteOffset: test.offset, ComponentType: componentFloat, Count: test.count, Type: "VEC3"}},
+				BufferViews: []bufferView{{ByteLength: 48, ByteStride: test.stride}},
+			},
+			buffers: [][]byte{make([]byte, 48)},
+		}
+
+		values, components, err := im.readAccessor(0)
+
+		if test.ok && (err != nil || len(values) != test.count*3 || components != 3) {
+			t.Errorf("%s: got %d values, %d components and error %v", test.name, len(values), components, err)
+		}
+
+		if !test.ok && err == nil {
+			t.Errorf("%s: got %d values, want an error", test.name, len(values))
+		}
+	}
+}
+
+func TestAnimationDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration float32
+		ok       bool
+	}{
+		{"one second", 1, true},
+		{"an hour", animation.MaxFrames / DefaultFPS, true},
+		{"a frame too long", animation.MaxFrames/DefaultFPS + 1.0/DefaultFPS, false},
+		{"a year", 365 * 24 * 60 * 60, false},
+		{"infinite", float32(math.Inf(1)), false},
+	}
+
+	for _, test := range tests {
+		b := make([]byte, 16)
+		binary.LittleEndian.PutUint32(b, math.Float32bits(test.duration))
+
+		doc := `{
+			"asset": {"version": "2.0"},
+			"nodes": [{"name": "root"}],
+			"animations": [{"channels": [{"sampler": 0, "target": {"node": 0, "path": "translation"}}],
+				"samplers": [{"input": 0, "output": 1}]}],
+			"accessors": [
+				{"bufferView": 0, "componentType": 5126, "count": 1, "type": "SCALAR"},
+				{"bufferView": 0, "byteOffset": 4, "componentType": 5126, "count": 1, "type": "VEC3"}
+			],
+			"bufferViews": [{"buffer": 0, "byteLength": 16}],
+			"buffers": [{"byteLength": 16, "uri": "data:application/octet-stream;base64,` + base64.StdEncoding.EncodeToString(b) + `"}]
+		}`
+
+		_, err := Parse([]byte(doc), "", DefaultFPS)
+
+		if test.ok && err != nil {
+			t.Errorf("%s: %s", test.name, err)
+		}
+
+		if !test.ok && err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
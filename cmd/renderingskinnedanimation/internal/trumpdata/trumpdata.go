@@ -0,0 +1,62 @@
+// Package trumpdata loads the trump export in renderingskinnedanimation/testdata for the tests of the packages
+// that read, convert or write it.
+package trumpdata
+
+import (
+	"encoding/json"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// The export's files and the names and frame range of what it holds.
+const (
+	Vertices  = "trump_vertices.json"
+	Armature  = "trump_armature.json"
+	Animation = "trump_animation.json"
+
+	MeshName     = "Cube"
+	ArmatureName = "Armature"
+	ActionName   = "ArmatureAction"
+	StartFrame   = 1
+	EndFrame     = 35
+)
+
+type Export struct {
+	Mesh      animation.Mesh
+	Armature  *animation.Armature
+	Keyframes map[string]*animation.IntToMatrix4fMap
+}
+
+// Read returns the contents of one of the export's files.
+func Read(t testing.TB, file string) []byte {
+	_, source, _, _ := runtime.Caller(0)
+	b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(source), "..", "..", "testdata", file))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+// Load decodes the export's mesh, armature and action.
+func Load(t testing.TB) *Export {
+	var vertexData map[string]animation.Mesh
+	var armatureData map[string]*animation.Armature
+	var animationData animation.ExportedAnimations
+
+	unmarshal(t, Vertices, &vertexData)
+	unmarshal(t, Armature, &armatureData)
+	unmarshal(t, Animation, &animationData)
+
+	return &Export{vertexData[MeshName], armatureData[ArmatureName], animationData[MeshName][ActionName]}
+}
+
+func unmarshal(t testing.TB, file string, v interface{}) {
+	if err := json.Unmarshal(Read(t, file), v); err != nil {
+		t.Fatalf("%s: %s", file, err)
+	}
+}
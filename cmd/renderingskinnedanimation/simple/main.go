@@ -2,17 +2,13 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"github.com/go-gl/gl/v3.3-core/gl"
 	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
-	"image"
-	"image/draw"
-	"image/png"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
-	"os"
 	"runtime"
-	"strings"
 	"time"
 )
 
@@ -25,87 +21,27 @@ const (
 	AnimationMatrices = `{"Cube": {"ArmatureAction": {"Bone": {"1": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.9763, 0.0, -0.21644, 0.0, 0.0, 1.0, 0.0, 0.0, 0.21644, 0.0, 0.9763, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.90631, 0.0, -0.42262, 0.0, 0.0, 1.0, 0.0, 0.0, 0.42262, 0.0, 0.90631, 0.0, 0.0, 0.0, 0.0, 1.0]}, "Bone.001": {"1": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.9763, 0.0, -0.21644, 0.0, 0.0, 1.0, 0.0, 0.0, 0.21644, 0.0, 0.9763, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.90631, 0.0, -0.42262, 0.0, 0.0, 1.0, 0.0, 0.0, 0.42262, 0.0, 0.90631, 0.0, 0.0, 0.0, 0.0, 1.0]}}}}`
 )
 
-func InitGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
+func main() {
+	runtime.LockOSThread()
 
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title, ClearColor: &[4]float32{0.9921568627, 0.968627451, 0.8901960784, 1}})
 
 	if err != nil {
-		panic(err)
-	}
-
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
-
-	return window
-}
-
-func InitOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
-	}
-
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
-
-	gl.ClearColor(0.9921568627, 0.968627451, 0.8901960784, 1)
-
-	width, height := window.GetFramebufferSize()
-
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
-
-func GlStr(str string) *uint8 {
-	if !strings.HasSuffix(str, "\x00") {
-		str = str + "\x00"
-	}
-	return gl.Str(str)
-}
-
-func CheckError() {
-
-	err := gl.GetError()
-
-	if err != gl.NO_ERROR {
-		log.Print("CheckError")
-		log.Fatal(err)
+		log.Fatal(err.Error())
 	}
-}
-
-func main() {
-	runtime.LockOSThread()
-
-	// initialise glfw and opengl
-	window := InitGLFW()
-	InitOpenGL(window)
 
 	// unmarshal vertex data
 	var vertexData map[string]Mesh
 
 	vertexByteArray := []byte(VertexData)
-	err := json.Unmarshal(vertexByteArray, &vertexData)
+	err = json.Unmarshal(vertexByteArray, &vertexData)
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 
 	// unmarshal animation data
-	var animationData map[string]map[string]map[string]*IntToMatrix4fMap
+	var animationData ExportedAnimations
 
 	animationByteArray := []byte(AnimationMatrices)
 	err = json.Unmarshal(animationByteArray, &animationData)
@@ -132,16 +68,29 @@ func main() {
 
 	armatureActionFrames := animationData["Cube"]["ArmatureAction"]
 	armature := armatureData["Armature"]
+
+	// refuse broken data up front rather than recursing forever or binding vertices to the wrong bones
+	cubeMesh := vertexData["Cube"]
+	err = ValidateSkinnedModel(&cubeMesh, armature, armatureActionFrames, 1, 3)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
 	// create a new skinned animation instance using the armature data, and the animation data
 	skinnedAnimation := NewSkinnedAnimation(armature, armatureActionFrames, 3, 1)
 	// fetch the bind matrices that were generated in the skinned animation instance
 	bindMatrices := skinnedAnimation.BindMatrices
 
-	boneNameToIndex := NewStringToFloat32Map()
-	boneIndex := float32(0)
+	// flatten the armature so every bone gets the same index, and the same place in the bone matrix buffer, on every run
+	skeleton, err := NewSkeleton(armature)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	// loop over the bones of the armature that the mesh is parented to
-	for boneName := range armature.Bones {
+	// loop over the bones of the armature that the mesh is parented to, parents before children
+	for _, boneName := range skeleton.Names {
 
 		// get the per-frame matrices of the current bone in the armature
 		frameMatrices := bindMatrices[boneName]
@@ -150,17 +99,10 @@ func main() {
 			frameMatrix := frameMatrices.Get(animationFrame)
 			boneMatrixBuffer = append(boneMatrixBuffer, frameMatrix.Get1D()...)
 		}
-
-		// keep track of the name of the bone whose matrices we just appended to a list, along with the index with which it was iterated on
-		boneNameToIndex.Set(boneName, boneIndex)
-
-		// incremenet the bone index
-		boneIndex++
 	}
 
-	// collect the inverted bone matrices for the bones in the armature based on the order they were encountered when collecting their pose matrices
-	for _, boneName := range boneNameToIndex.Keys() {
-		matrixLocalInverted := armature.Bones[boneName].MatrixLocalInverted
+	// collect the inverted bone matrices in the same order as their pose matrices
+	for _, matrixLocalInverted := range skeleton.MatrixLocalInverted {
 		invertedBoneMatrixBuffer = append(invertedBoneMatrixBuffer, matrixLocalInverted.Get1D()...)
 	}
 
@@ -168,7 +110,13 @@ func main() {
 
 	for _, v := range cubeVertexData.Coordinates {
 		for sk, sv := range v.Skin {
-			skinBuffer = append(skinBuffer, boneNameToIndex.Get(sk))
+			boneIndex, present := skeleton.Index(sk)
+
+			if !present {
+				log.Fatalf("vertex is weighted to bone %q which is not in armature %q", sk, skeleton.Name)
+			}
+
+			skinBuffer = append(skinBuffer, float32(boneIndex))
 			skinBuffer = append(skinBuffer, sv/v.TotalWeight)
 		}
 	}
@@ -180,6 +128,11 @@ func main() {
 	invertedBoneMatrixBufferID := ArrayToTexture(invertedBoneMatrixBuffer)
 	offsetBufferID := ArrayToTexture(offsetBuffer)
 
+	// upload the morph target deltas once, the weights are re-uploaded every frame so they can be changed at runtime
+	morphWeights := NewMorphWeights(&cubeVertexData)
+	morphDeltaBufferID := ArrayToTexture(cubeVertexData.MorphDeltaBuffer())
+	morphWeightBufferID := ArrayToTexture(morphWeights.Values())
+
 	// load all the vertex attributes for all vertices into a float array, which will later become a vertex buffer
 	points := make([]float32, len(cubeVertexData.Coordinates)*8) // 8 = 3 location coordinates + 2 texture coordinates + 1 mesh offset + 1 skin length + 1 skin offset
 
@@ -208,6 +161,8 @@ func main() {
 		skinOffset += float32(len(coordinate.Skin)) * 2
 	}
 
+	normals := VertexNormals(points, 8, cubeVertexData.Indices)
+
 	var vaoId uint32
 	gl.GenVertexArrays(1, &vaoId)
 
@@ -217,6 +172,9 @@ func main() {
 	var vboiId uint32
 	gl.GenBuffers(1, &vboiId)
 
+	var normalVboId uint32
+	gl.GenBuffers(1, &normalVboId)
+
 	gl.BindVertexArray(vaoId)
 
 	// pass the vertex attribute float array to an array buffer
@@ -246,47 +204,36 @@ func main() {
 	gl.VertexAttribPointer(4, 1, gl.FLOAT, false, 32, gl.PtrOffset(28))
 	gl.EnableVertexAttribArray(4)
 
+	// the normals live in their own buffer, the morph normal deltas are added to them in the shader
+	gl.BindBuffer(gl.ARRAY_BUFFER, normalVboId)
+	gl.BufferData(gl.ARRAY_BUFFER, len(normals)*4, gl.Ptr(normals), gl.STATIC_DRAW)
+	gl.VertexAttribPointer(5, 3, gl.FLOAT, false, 12, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(5)
+
 	gl.BindVertexArray(0)
 
 	// load in a texture to apply to the mesh
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../../grid.png")
+	diffuse, err := texture.LoadImage("../../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../../grid.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err := png.Decode(ioreader)
-
-	if err != nil {
-		log.Fatal("Error decoding image ../../../grid.png")
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+	diffuseTexture, err := texture.Upload(diffuse)
+
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
-	CheckError()
+	texId := diffuseTexture.ID
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
 	gl.BindTexture(gl.TEXTURE_2D, texId)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
 	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
 	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
-
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-
 	gl.BindTexture(gl.TEXTURE_2D, 0)
 
 	// create an identity matrix
@@ -323,14 +270,20 @@ uniform samplerBuffer offsets; // curFrame|numFrames|meshSkinOffset|animationOff
 uniform samplerBuffer skin;   // vertex -> { boneIndex : boneInfluence } | (skinOffset + in_VertexIndex) -> for i in in_NumberOfBones -> influence (passed by engine)
 uniform samplerBuffer boneMatrices; // mesh -> animation -> frame -> { boneIndex : mat4 } | in_ModelOffset -> { animation : frame : boneIndex : mat4 }
 uniform samplerBuffer invertedMatrices;
+uniform samplerBuffer morphDeltas;  // target -> vertex -> { xyz position delta, xyz normal delta }
+uniform samplerBuffer morphWeights; // target -> weight
+uniform int morphTargetCount;
+uniform int morphVertexCount;
 
 layout (location = 0) in vec3 in_Position;
 layout (location = 1) in vec2 in_Texture;
 layout (location = 2) in float in_ModelOffset;
 layout (location = 3) in float in_NumberOfBones;
 layout (location = 4) in float in_SkinOffset;
+layout (location = 5) in vec3 in_Normal;
 
 out vec2 out_Texture;
+out vec3 out_Normal;
 
 mat4 getMatrix(int index, samplerBuffer fpgbuffer){
   float m00 = texelFetch(fpgbuffer, index + 0).r;
@@ -356,13 +309,31 @@ mat4 getMatrix(int index, samplerBuffer fpgbuffer){
   				m03, m13, m23, m33);
 }
 
+void applyMorphTargets(inout vec3 position, inout vec3 normal){
+  for(int t=0;t<morphTargetCount;++t) {
+  	float weight = texelFetch(morphWeights, t).r;
+  	int deltaOffset = (t * morphVertexCount + gl_VertexID) * 6;
+
+  	position += vec3(texelFetch(morphDeltas, deltaOffset).r,
+  					 texelFetch(morphDeltas, deltaOffset + 1).r,
+  					 texelFetch(morphDeltas, deltaOffset + 2).r) * weight;
+  	normal += vec3(texelFetch(morphDeltas, deltaOffset + 3).r,
+  				   texelFetch(morphDeltas, deltaOffset + 4).r,
+  				   texelFetch(morphDeltas, deltaOffset + 5).r) * weight;
+  }
+}
+
 void main() { 
   out_Texture = in_Texture;
   mat4 modelMatrix = getMatrix(int(in_ModelOffset*16), modelMatrices);
 
   int offset = int(in_ModelOffset * 6);
   float curFrame = texelFetch(offsets, offset).r;   				// current frame of animation currently playing
-  vec3 mod_position = in_Position;
+  vec3 morphed_position = in_Position;
+  vec3 morphed_normal = in_Normal;
+  applyMorphTargets(morphed_position, morphed_normal);	// blend shapes are applied before skinning
+  vec3 mod_position = morphed_position;
+  vec3 mod_normal = morphed_normal;
 
    if (curFrame != -1){
 	  float numFramesInAnimation = texelFetch(offsets, offset + 1).r;   	// offset for how many bones are in the current meshes armature SHOULD BE NUMBER OF FRAMES IN CURRENT ANIMATION
@@ -374,6 +345,7 @@ void main() {
 	  float vertexSkinOffset = skinOffset + in_SkinOffset;      			// get the starting point of the current vertices skin
 
 	  mod_position = vec3(0,0,0);
+	  mod_normal = vec3(0,0,0);
 
 	  for(float i=0;i<in_NumberOfBones;++i) {
 	  	int vOffset = int(vertexSkinOffset + i*2);        	// for each bone that affects this vertex..
@@ -386,12 +358,14 @@ void main() {
 	  	mat4 boneMat = getMatrix(int(matIndex), boneMatrices);
 	  	mat4 invertedMat = getMatrix(int(invertedMatrixOffset + (boneIndex * 16)), invertedMatrices);
 
-	    mod_position = mod_position + ((boneMat * (invertedMat * vec4(in_Position, 1.0))) *  boneInfluence ).xyz;
+	    mod_position = mod_position + ((boneMat * (invertedMat * vec4(morphed_position, 1.0))) *  boneInfluence ).xyz;
+	    mod_normal = mod_normal + mat3(boneMat * invertedMat) * morphed_normal * boneInfluence;	// bones do not scale, so no inverse transpose
 	  }
   }
 
 
   vec3 worldPos = (modelMatrix * vec4(mod_position, 1.0)).xyz;
+  out_Normal = mat3(modelMatrix) * mod_normal;
 
   gl_Position = projectionMatrix * viewMatrix * vec4(worldPos, 1.0);
 }
@@ -401,33 +375,23 @@ void main() {
 uniform sampler2D diffuse;
 
 in vec2 out_Texture;
+in vec3 out_Normal;
 
 out vec4 out_Colour;
 
+const vec3 lightDirection = vec3(0.4, 0.8, 0.45);	// towards the light, roughly normalised
+
 void main() {
-  
-  out_Colour = vec4(texture(diffuse,out_Texture).rgb , 1.0);
+  float light = 0.35 + 0.65 * max(dot(normalize(out_Normal), lightDirection), 0.0);	// ambient + lambert
+  out_Colour = vec4(texture(diffuse,out_Texture).rgb * light, 1.0);
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
-
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	projectionMatrix := NewProjectionMatrix(Width, Height)
 
@@ -473,34 +437,23 @@ void main() {
 		complete := []float32{float32(curFrame), float32(skinnedAnimation.EndFrame), 0.0, 0.0, 0.0, 0.0}
 
 		UpdateArrayToTexture(offsetBufferID.BufferID, complete)
+		UpdateArrayToTexture(morphWeightBufferID.BufferID, morphWeights.Values())
 
-		gl.UseProgram(shaderProgram)
-		var location int32 = -1
+		shaderProgram.Use()
 
 		// set uniform values in the shader program
-		location = gl.GetUniformLocation(shaderProgram, GlStr("projectionMatrix"))
-		gl.UniformMatrix4fv(location, 1, true, &projectionMatrix.Get1D()[0])
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("viewMatrix"))
-		gl.UniformMatrix4fv(location, 1, true, &viewMatrix.Get1D()[0])
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("modelMatrices"))
-		gl.Uniform1i(location, 0)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("offsets"))
-		gl.Uniform1i(location, 1)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("skin"))
-		gl.Uniform1i(location, 2)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("boneMatrices"))
-		gl.Uniform1i(location, 3)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("invertedMatrices"))
-		gl.Uniform1i(location, 4)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("diffuse"))
-		gl.Uniform1i(location, 5)
+		shaderProgram.SetMat4("projectionMatrix", projectionMatrix.Get1D())
+		shaderProgram.SetMat4("viewMatrix", viewMatrix.Get1D())
+		shaderProgram.SetSampler("modelMatrices", 0)
+		shaderProgram.SetSampler("offsets", 1)
+		shaderProgram.SetSampler("skin", 2)
+		shaderProgram.SetSampler("boneMatrices", 3)
+		shaderProgram.SetSampler("invertedMatrices", 4)
+		shaderProgram.SetSampler("diffuse", 5)
+		shaderProgram.SetSampler("morphDeltas", 6)
+		shaderProgram.SetSampler("morphWeights", 7)
+		shaderProgram.SetInt("morphTargetCount", int32(len(cubeVertexData.MorphTargets)))
+		shaderProgram.SetInt("morphVertexCount", int32(len(cubeVertexData.Coordinates)))
 
 		// bind the buffers at the appropriate texture slots
 		gl.ActiveTexture(gl.TEXTURE0)
@@ -521,6 +474,12 @@ void main() {
 		gl.ActiveTexture(gl.TEXTURE5)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
 
+		gl.ActiveTexture(gl.TEXTURE6)
+		gl.BindTexture(gl.TEXTURE_BUFFER, morphDeltaBufferID.TextureID)
+
+		gl.ActiveTexture(gl.TEXTURE7)
+		gl.BindTexture(gl.TEXTURE_BUFFER, morphWeightBufferID.TextureID)
+
 		gl.BindVertexArray(vaoId)
 		gl.DrawElements(gl.TRIANGLES, int32(len(cubeVertexData.Indices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
 		gl.BindVertexArray(0)
@@ -543,8 +502,16 @@ void main() {
 		gl.ActiveTexture(gl.TEXTURE5)
 		gl.BindTexture(gl.TEXTURE_2D, 0)
 
+		gl.ActiveTexture(gl.TEXTURE6)
+		gl.BindTexture(gl.TEXTURE_BUFFER, 0)
+
+		gl.ActiveTexture(gl.TEXTURE7)
+		gl.BindTexture(gl.TEXTURE_BUFFER, 0)
+
 		glfw.PollEvents()
 		window.SwapBuffers()
-		CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
 }
@@ -2,146 +2,104 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
+	"flag"
 	"github.com/go-gl/gl/v3.3-core/gl"
 	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
 	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
-	"image"
-	"image/draw"
-	"image/png"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/asset"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
+	"io/ioutil"
 	"log"
-	"os"
 	"runtime"
-	"strings"
 	"time"
 )
 
 const (
-	Width             = 480
-	Height            = 480
-	Title             = "Skinned animation"
-	VertexData        = `{"Cube": {"indices": [1, 0, 286, 286, 271, 1, 6, 5, 15, 0, 1, 639, 639, 4, 0, 3, 2, 640, 640, 641, 3, 642, 13, 7, 7, 8, 642, 639, 643, 9, 9, 4, 639, 641, 644, 16, 16, 3, 641, 18, 17, 20, 20, 21, 18, 11, 18, 21, 14, 645, 12, 12, 13, 14, 646, 647, 648, 648, 10, 646, 649, 8, 7, 306, 273, 650, 650, 651, 306, 652, 272, 307, 307, 653, 652, 274, 306, 651, 651, 653, 274, 273, 276, 19, 19, 650, 273, 11, 650, 19, 19, 18, 11, 644, 654, 655, 655, 16, 644, 656, 8, 649, 657, 656, 649, 657, 649, 658, 649, 12, 645, 645, 658, 649, 19, 276, 309, 309, 18, 19, 3, 16, 24, 24, 25, 3, 659, 660, 22, 659, 22, 68, 659, 68, 23, 16, 655, 661, 661, 24, 16, 662, 277, 22, 22, 660, 662, 315, 67, 68, 277, 315, 68, 22, 277, 68, 315, 278, 66, 66, 67, 315, 66, 278, 318, 318, 65, 66, 663, 664, 27, 27, 26, 663, 664, 665, 28, 28, 27, 664, 30, 640, 2, 2, 31, 30, 666, 667, 668, 668, 32, 666, 668, 29, 44, 44, 32, 668, 669, 34, 670, 671, 672, 35, 673, 674, 33, 33, 675, 673, 33, 674, 37, 37, 36, 33, 36, 37, 39, 39, 38, 36, 38, 39, 41, 41, 40, 38, 40, 41, 43, 43, 42, 40, 676, 35, 672, 672, 45, 676, 677, 676, 45, 45, 46, 677, 678, 677, 46, 46, 47, 678, 44, 29, 679, 680, 681, 55, 55, 88, 680, 682, 683, 48, 48, 49, 682, 684, 685, 51, 51, 50, 684, 686, 684, 50, 50, 52, 686, 681, 687, 53, 53, 55, 681, 687, 686, 52, 52, 53, 687, 688, 689, 57, 57, 54, 688, 690, 688, 54, 54, 56, 690, 690, 56, 691, 692, 70, 48, 48, 683, 692, 693, 60, 58, 689, 693, 58, 58, 57, 689, 685, 694, 59, 59, 51, 685, 693, 695, 61, 61, 60, 693, 64, 360, 280, 280, 63, 64, 696, 281, 62, 62, 697, 696, 62, 61, 695, 695, 697, 62, 698, 59, 694, 694, 699, 698, 700, 28, 665, 665, 701, 700, 65, 702, 703, 703, 66, 65, 66, 703, 23, 23, 67, 66, 67, 23, 68, 692, 704, 69, 69, 70, 692, 705, 706, 707, 708, 709, 705, 710, 364, 72, 72, 711, 710, 712, 711, 72, 72, 71, 712, 364, 367, 74, 74, 72, 364, 71, 72, 74, 74, 73, 71, 74, 367, 282, 282, 76, 74, 73, 74, 76, 76, 75, 73, 76, 282, 372, 372, 78, 76, 75, 76, 78, 78, 77, 75, 78, 372, 283, 283, 80, 78, 77, 78, 80, 80, 79, 77, 713, 81, 709, 712, 71, 714, 73, 75, 83, 83, 82, 73, 82, 83, 70, 70, 69, 82, 83, 75, 77, 77, 85, 83, 70, 83, 85, 85, 84, 70, 85, 77, 79, 79, 87, 85, 84, 85, 87, 87, 86, 84, 49, 48, 70, 70, 84, 49, 84, 86, 90, 90, 89, 84, 49, 84, 89, 89, 682, 49, 706, 705, 91, 91, 92, 706, 705, 709, 91, 71, 73, 94, 94, 93, 71, 714, 71, 93, 93, 95, 714, 709, 81, 715, 715, 91, 709, 73, 82, 96, 96, 94, 73, 716, 706, 92, 92, 717, 716, 92, 91, 97, 97, 98, 92, 93, 94, 100, 100, 99, 93, 95, 93, 99, 99, 101, 95, 91, 715, 718, 718, 97, 91, 94, 96, 102, 102, 100, 94, 717, 92, 98, 98, 719, 717, 98, 97, 103, 103, 104, 98, 99, 100, 106, 106, 105, 99, 101, 99, 105, 105, 107, 101, 97, 718, 720, 720, 103, 97, 100, 102, 108, 108, 106, 100, 719, 98, 104, 104, 721, 719, 104, 103, 109, 109, 110, 104, 105, 106, 112, 112, 111, 105, 107, 105, 111, 111, 113, 107, 103, 720, 722, 722, 109, 103, 106, 108, 114, 114, 112, 106, 721, 104, 110, 110, 723, 721, 110, 109, 115, 115, 116, 110, 111, 112, 118, 118, 117, 111, 113, 111, 117, 117, 119, 113, 109, 722, 724, 724, 115, 109, 112, 114, 120, 120, 118, 112, 723, 110, 116, 116, 725, 723, 116, 115, 121, 121, 122, 116, 117, 118, 124, 124, 123, 117, 119, 117, 123, 123, 125, 119, 115, 724, 726, 726, 121, 115, 118, 120, 126, 126, 124, 118, 725, 116, 122, 122, 727, 725, 122, 121, 127, 127, 128, 122, 123, 124, 130, 130, 129, 123, 125, 123, 129, 129, 131, 125, 121, 726, 728, 728, 127, 121, 124, 126, 132, 132, 130, 124, 727, 122, 128, 128, 729, 727, 730, 731, 133, 133, 134, 730, 732, 733, 136, 136, 135, 732, 734, 732, 135, 135, 137, 734, 731, 734, 137, 137, 133, 731, 733, 735, 138, 138, 136, 733, 735, 730, 134, 134, 138, 735, 736, 737, 139, 139, 140, 736, 738, 739, 142, 142, 141, 738, 740, 738, 141, 141, 143, 740, 737, 740, 143, 143, 139, 737, 739, 741, 144, 144, 142, 739, 741, 736, 140, 140, 144, 741, 742, 743, 145, 145, 146, 742, 744, 745, 148, 148, 147, 744, 746, 744, 147, 147, 149, 746, 743, 746, 149, 149, 145, 743, 745, 747, 150, 150, 148, 745, 747, 742, 146, 146, 150, 747, 179, 180, 181, 181, 182, 179, 748, 749, 154, 154, 153, 748, 750, 748, 153, 153, 155, 750, 751, 752, 753, 753, 151, 751, 754, 755, 156, 156, 756, 754, 757, 758, 152, 152, 759, 757, 760, 151, 157, 157, 158, 760, 153, 154, 160, 160, 159, 153, 155, 153, 159, 159, 161, 155, 151, 753, 761, 761, 157, 151, 756, 156, 162, 162, 762, 756, 759, 152, 763, 763, 764, 759, 158, 157, 163, 163, 164, 158, 159, 160, 166, 166, 165, 159, 161, 159, 165, 165, 167, 161, 157, 761, 765, 765, 163, 157, 762, 162, 168, 168, 766, 762, 764, 763, 767, 767, 768, 764, 164, 163, 169, 169, 170, 164, 165, 166, 172, 172, 171, 165, 167, 165, 171, 171, 173, 167, 163, 765, 769, 769, 169, 163, 766, 168, 174, 174, 770, 766, 768, 767, 771, 771, 772, 768, 173, 171, 172, 172, 772, 173, 169, 769, 174, 174, 170, 169, 773, 751, 176, 176, 175, 773, 751, 151, 177, 177, 176, 751, 151, 760, 178, 178, 177, 151, 152, 758, 774, 774, 775, 152, 175, 176, 180, 180, 179, 175, 176, 177, 181, 181, 180, 176, 776, 775, 777, 777, 778, 776, 775, 774, 779, 779, 777, 775, 88, 780, 184, 184, 183, 88, 781, 782, 186, 186, 185, 781, 680, 88, 183, 783, 784, 785, 785, 786, 783, 184, 780, 787, 787, 192, 184, 186, 782, 788, 788, 187, 186, 789, 484, 188, 188, 790, 789, 791, 790, 188, 188, 189, 791, 792, 791, 189, 189, 190, 792, 793, 792, 190, 190, 191, 793, 787, 793, 191, 191, 192, 787, 187, 788, 794, 794, 193, 187, 187, 193, 194, 795, 796, 196, 196, 197, 795, 797, 795, 197, 197, 198, 797, 794, 797, 198, 198, 199, 794, 193, 794, 199, 199, 200, 193, 195, 194, 201, 201, 202, 195, 796, 195, 202, 202, 196, 796, 194, 193, 200, 200, 201, 194, 197, 196, 203, 203, 204, 197, 198, 197, 204, 204, 205, 198, 199, 198, 205, 205, 206, 199, 200, 199, 206, 206, 207, 200, 202, 201, 208, 196, 202, 208, 208, 203, 196, 201, 200, 207, 207, 208, 201, 204, 203, 209, 209, 210, 204, 205, 204, 210, 210, 211, 205, 206, 205, 211, 211, 212, 206, 207, 206, 212, 212, 213, 207, 203, 208, 214, 214, 209, 203, 208, 207, 213, 213, 214, 208, 210, 209, 215, 215, 216, 210, 211, 210, 216, 216, 217, 211, 212, 211, 217, 217, 218, 212, 213, 212, 218, 218, 219, 213, 209, 214, 220, 220, 215, 209, 214, 213, 219, 219, 220, 214, 216, 215, 221, 221, 222, 216, 217, 216, 222, 222, 223, 217, 218, 217, 223, 223, 224, 218, 219, 218, 224, 224, 225, 219, 215, 220, 226, 226, 221, 215, 220, 219, 225, 225, 226, 220, 222, 221, 227, 227, 228, 222, 223, 222, 228, 228, 229, 223, 224, 223, 229, 229, 230, 224, 225, 224, 230, 230, 231, 225, 221, 226, 232, 232, 227, 221, 226, 225, 231, 231, 232, 226, 228, 227, 233, 233, 234, 228, 229, 228, 234, 234, 235, 229, 230, 229, 235, 235, 236, 230, 231, 230, 236, 236, 237, 231, 227, 232, 238, 238, 233, 227, 232, 231, 237, 237, 238, 232, 798, 799, 239, 239, 240, 798, 800, 798, 240, 240, 241, 800, 801, 800, 241, 241, 242, 801, 802, 801, 242, 242, 243, 802, 799, 803, 244, 244, 239, 799, 803, 802, 243, 243, 244, 803, 804, 805, 245, 245, 246, 804, 806, 804, 246, 246, 247, 806, 807, 806, 247, 247, 248, 807, 808, 807, 257, 257, 249, 808, 805, 809, 250, 250, 245, 805, 809, 808, 249, 249, 250, 809, 246, 245, 251, 251, 252, 246, 247, 246, 252, 252, 253, 247, 248, 247, 253, 253, 254, 248, 245, 250, 256, 256, 251, 245, 250, 249, 255, 255, 256, 250, 807, 248, 257, 257, 248, 258, 258, 262, 257, 248, 254, 260, 260, 258, 248, 255, 249, 259, 259, 261, 255, 249, 257, 262, 262, 259, 249, 262, 258, 263, 263, 267, 262, 258, 260, 265, 265, 263, 258, 261, 259, 264, 264, 266, 261, 259, 262, 267, 267, 264, 259, 267, 263, 268, 263, 265, 269, 269, 268, 263, 266, 264, 268, 268, 269, 270, 266, 268, 270, 264, 267, 268, 270, 269, 265, 252, 256, 255, 255, 253, 252, 260, 254, 253, 253, 255, 261, 260, 253, 261, 261, 266, 270, 260, 261, 270, 265, 260, 270, 252, 251, 256, 652, 639, 1, 30, 31, 279, 279, 567, 30, 652, 1, 271, 271, 275, 652, 274, 653, 307, 810, 289, 811, 811, 812, 810, 813, 325, 324, 814, 640, 30, 30, 567, 814, 815, 332, 330, 330, 816, 815, 817, 818, 336, 336, 334, 817, 819, 338, 336, 336, 818, 819, 820, 700, 701, 701, 821, 820, 186, 480, 481, 481, 185, 186, 187, 194, 490, 490, 483, 187, 570, 496, 490, 195, 570, 490, 194, 195, 490, 284, 271, 286, 286, 285, 284, 287, 822, 288, 285, 290, 288, 288, 284, 285, 291, 823, 824, 824, 292, 291, 293, 304, 825, 825, 294, 293, 288, 290, 295, 295, 826, 288, 823, 291, 296, 296, 827, 823, 298, 297, 300, 300, 299, 298, 301, 297, 298, 302, 828, 303, 303, 301, 302, 829, 830, 831, 830, 829, 305, 832, 293, 294, 306, 828, 302, 302, 273, 306, 822, 287, 307, 307, 272, 822, 274, 287, 828, 828, 306, 274, 273, 302, 308, 308, 276, 273, 301, 298, 308, 308, 302, 301, 827, 296, 833, 833, 834, 827, 300, 297, 835, 835, 826, 295, 300, 835, 295, 835, 297, 301, 301, 303, 835, 308, 298, 309, 309, 276, 308, 291, 311, 310, 310, 296, 291, 836, 314, 313, 836, 313, 312, 836, 312, 837, 296, 310, 838, 838, 833, 296, 662, 837, 312, 312, 277, 662, 313, 316, 315, 313, 315, 277, 312, 313, 277, 315, 316, 317, 317, 278, 315, 317, 319, 318, 318, 278, 317, 839, 321, 320, 320, 840, 839, 840, 320, 322, 322, 841, 840, 323, 842, 292, 292, 824, 323, 324, 325, 843, 843, 844, 324, 843, 325, 327, 327, 326, 843, 845, 846, 328, 847, 329, 848, 849, 850, 851, 851, 852, 849, 851, 853, 331, 331, 852, 851, 853, 854, 333, 333, 331, 853, 854, 855, 335, 335, 333, 854, 855, 856, 337, 855, 337, 569, 855, 569, 335, 857, 339, 848, 848, 329, 857, 858, 340, 339, 339, 857, 858, 859, 341, 340, 340, 858, 859, 327, 860, 326, 43, 569, 337, 337, 342, 43, 861, 344, 343, 343, 862, 861, 863, 346, 345, 345, 864, 863, 865, 348, 347, 347, 866, 865, 867, 349, 348, 348, 865, 867, 862, 343, 350, 350, 868, 862, 868, 350, 349, 349, 867, 868, 869, 352, 351, 351, 870, 869, 871, 353, 352, 352, 869, 871, 871, 872, 353, 873, 864, 345, 345, 354, 873, 874, 356, 355, 870, 356, 874, 356, 870, 351, 866, 347, 357, 357, 875, 866, 874, 355, 358, 358, 876, 874, 359, 361, 280, 280, 360, 359, 696, 877, 362, 362, 281, 696, 362, 877, 876, 876, 358, 362, 361, 359, 878, 878, 879, 361, 880, 881, 841, 841, 322, 880, 882, 883, 310, 310, 311, 882, 317, 316, 314, 314, 884, 317, 316, 313, 314, 873, 354, 363, 363, 885, 873, 886, 885, 363, 887, 888, 889, 710, 890, 365, 365, 364, 710, 891, 366, 365, 365, 890, 891, 364, 365, 368, 368, 367, 364, 366, 369, 368, 368, 365, 366, 368, 370, 282, 282, 367, 368, 369, 371, 370, 370, 368, 369, 370, 373, 372, 372, 282, 370, 371, 374, 373, 373, 370, 371, 373, 375, 283, 283, 372, 373, 374, 376, 375, 375, 373, 374, 891, 889, 377, 891, 377, 366, 369, 379, 378, 378, 371, 369, 892, 363, 354, 354, 893, 892, 378, 380, 374, 374, 371, 378, 354, 381, 894, 894, 893, 354, 380, 382, 376, 376, 374, 380, 381, 383, 895, 895, 894, 381, 346, 381, 354, 354, 345, 346, 381, 385, 384, 384, 383, 381, 346, 863, 385, 385, 381, 346, 363, 387, 386, 386, 886, 363, 888, 896, 889, 366, 389, 388, 388, 369, 366, 377, 390, 389, 389, 366, 377, 889, 896, 390, 390, 377, 889, 369, 388, 391, 391, 379, 369, 892, 897, 387, 387, 363, 892, 898, 393, 392, 392, 896, 898, 389, 395, 394, 394, 388, 389, 390, 396, 395, 395, 389, 390, 896, 392, 396, 396, 390, 896, 388, 394, 397, 397, 391, 388, 391, 397, 393, 393, 898, 391, 393, 399, 398, 398, 392, 393, 395, 401, 400, 400, 394, 395, 396, 402, 401, 401, 395, 396, 392, 398, 402, 402, 396, 392, 394, 400, 403, 403, 397, 394, 397, 403, 399, 399, 393, 397, 399, 405, 404, 404, 398, 399, 401, 407, 406, 406, 400, 401, 402, 408, 407, 407, 401, 402, 398, 404, 408, 408, 402, 398, 400, 406, 409, 409, 403, 400, 403, 409, 405, 405, 399, 403, 405, 411, 410, 410, 404, 405, 407, 413, 412, 412, 406, 407, 408, 414, 413, 413, 407, 408, 404, 410, 414, 414, 408, 404, 406, 412, 415, 415, 409, 406, 409, 415, 411, 411, 405, 409, 411, 417, 416, 416, 410, 411, 413, 419, 418, 418, 412, 413, 414, 420, 419, 419, 413, 414, 410, 416, 420, 420, 414, 410, 412, 418, 421, 421, 415, 412, 415, 421, 417, 417, 411, 415, 417, 423, 422, 422, 416, 417, 419, 425, 424, 424, 418, 419, 420, 426, 425, 425, 419, 420, 416, 422, 426, 426, 420, 416, 418, 424, 427, 427, 421, 418, 421, 427, 423, 423, 417, 421, 899, 429, 428, 428, 900, 899, 901, 431, 430, 430, 902, 901, 903, 432, 431, 431, 901, 903, 900, 428, 432, 432, 903, 900, 902, 430, 433, 433, 904, 902, 904, 433, 429, 429, 899, 904, 905, 435, 434, 434, 906, 905, 907, 437, 436, 436, 908, 907, 909, 438, 437, 437, 907, 909, 906, 434, 438, 438, 909, 906, 908, 436, 439, 439, 910, 908, 910, 439, 435, 435, 905, 910, 911, 441, 440, 440, 912, 911, 913, 443, 442, 442, 914, 913, 915, 444, 443, 443, 913, 915, 912, 440, 444, 444, 915, 912, 914, 442, 445, 445, 916, 914, 916, 445, 441, 441, 911, 916, 446, 449, 448, 448, 447, 446, 917, 451, 450, 450, 918, 917, 919, 452, 451, 451, 917, 919, 920, 453, 921, 921, 922, 920, 923, 924, 454, 454, 925, 923, 926, 927, 455, 455, 928, 926, 929, 457, 456, 456, 453, 929, 451, 459, 458, 458, 450, 451, 452, 460, 459, 459, 451, 452, 453, 456, 930, 930, 921, 453, 924, 931, 461, 461, 454, 924, 927, 932, 933, 933, 455, 927, 457, 463, 462, 462, 456, 457, 459, 465, 464, 464, 458, 459, 460, 466, 465, 465, 459, 460, 456, 462, 934, 934, 930, 456, 931, 935, 467, 467, 461, 931, 932, 936, 937, 937, 933, 932, 463, 469, 468, 468, 462, 463, 465, 471, 470, 470, 464, 465, 466, 472, 471, 471, 465, 466, 462, 468, 938, 938, 934, 462, 935, 939, 473, 473, 467, 935, 936, 940, 941, 941, 937, 936, 472, 940, 470, 470, 471, 472, 468, 469, 473, 473, 938, 468, 942, 475, 474, 474, 920, 942, 920, 474, 476, 476, 453, 920, 453, 476, 477, 477, 929, 453, 455, 943, 944, 944, 928, 455, 475, 446, 447, 447, 474, 475, 474, 447, 448, 448, 476, 474, 945, 946, 947, 947, 943, 945, 943, 947, 948, 948, 944, 943, 344, 479, 478, 478, 949, 344, 950, 481, 480, 480, 951, 950, 861, 479, 344, 952, 341, 859, 859, 953, 952, 478, 482, 954, 954, 949, 478, 480, 483, 955, 955, 951, 480, 789, 956, 485, 485, 484, 789, 957, 486, 485, 485, 956, 957, 958, 487, 486, 486, 957, 958, 959, 488, 487, 487, 958, 959, 960, 961, 488, 488, 959, 960, 483, 489, 962, 962, 955, 483, 483, 490, 489, 963, 492, 491, 491, 964, 963, 965, 493, 492, 492, 963, 965, 962, 494, 493, 493, 965, 962, 489, 495, 494, 494, 962, 489, 496, 498, 497, 497, 490, 496, 964, 491, 498, 498, 496, 964, 490, 497, 495, 495, 489, 490, 492, 500, 499, 499, 491, 492, 493, 501, 500, 500, 492, 493, 494, 502, 501, 501, 493, 494, 495, 503, 502, 502, 494, 495, 498, 504, 497, 491, 499, 504, 504, 498, 491, 497, 504, 503, 503, 495, 497, 500, 506, 505, 505, 499, 500, 501, 507, 506, 506, 500, 501, 502, 508, 507, 507, 501, 502, 503, 509, 508, 508, 502, 503, 499, 505, 510, 510, 504, 499, 504, 510, 509, 509, 503, 504, 506, 512, 511, 511, 505, 506, 507, 513, 512, 512, 506, 507, 508, 514, 513, 513, 507, 508, 509, 515, 514, 514, 508, 509, 505, 511, 516, 516, 510, 505, 510, 516, 515, 515, 509, 510, 512, 518, 517, 517, 511, 512, 513, 519, 518, 518, 512, 513, 514, 520, 519, 519, 513, 514, 515, 521, 520, 520, 514, 515, 511, 517, 522, 522, 516, 511, 516, 522, 521, 521, 515, 516, 518, 524, 523, 523, 517, 518, 519, 525, 524, 524, 518, 519, 520, 526, 525, 525, 519, 520, 521, 527, 526, 526, 520, 521, 517, 523, 528, 528, 522, 517, 522, 528, 527, 527, 521, 522, 524, 530, 529, 529, 523, 524, 525, 531, 530, 530, 524, 525, 526, 532, 531, 531, 525, 526, 527, 533, 532, 532, 526, 527, 523, 529, 534, 534, 528, 523, 528, 534, 533, 533, 527, 528, 966, 536, 535, 535, 967, 966, 968, 537, 536, 536, 966, 968, 969, 538, 537, 537, 968, 969, 970, 539, 538, 538, 969, 970, 967, 535, 540, 540, 971, 967, 971, 540, 539, 539, 970, 971, 972, 542, 541, 541, 973, 972, 974, 543, 542, 542, 972, 974, 975, 544, 543, 543, 974, 975, 976, 546, 545, 545, 975, 976, 973, 541, 547, 547, 977, 973, 977, 547, 546, 546, 976, 977, 542, 549, 548, 548, 541, 542, 543, 550, 549, 549, 542, 543, 544, 551, 550, 550, 543, 544, 541, 548, 552, 552, 547, 541, 547, 552, 553, 553, 546, 547, 975, 545, 544, 545, 555, 554, 554, 544, 545, 544, 554, 556, 556, 551, 544, 553, 558, 557, 557, 546, 553, 546, 557, 555, 555, 545, 546, 555, 560, 559, 559, 554, 555, 554, 559, 561, 561, 556, 554, 558, 563, 562, 562, 557, 558, 557, 562, 560, 560, 555, 557, 560, 564, 559, 559, 564, 565, 565, 561, 559, 566, 565, 564, 563, 566, 564, 563, 564, 562, 562, 564, 560, 566, 561, 565, 549, 550, 553, 553, 552, 549, 558, 553, 550, 556, 558, 550, 556, 550, 551, 566, 563, 558, 566, 558, 556, 561, 566, 556, 549, 552, 548, 822, 284, 288, 323, 567, 279, 279, 842, 323, 822, 275, 271, 271, 284, 822, 274, 307, 287, 978, 979, 980, 980, 981, 978, 814, 567, 323, 323, 824, 814, 815, 817, 334, 334, 332, 815, 42, 43, 342, 342, 568, 42, 820, 821, 881, 881, 880, 820, 982, 983, 984, 984, 985, 982, 186, 187, 483, 483, 480, 186, 195, 796, 986, 986, 570, 195, 342, 337, 856, 856, 568, 342, 819, 987, 338, 41, 335, 569, 569, 43, 41, 986, 964, 496, 986, 496, 570, 813, 666, 32, 988, 978, 981, 643, 639, 653, 287, 288, 826, 571, 572, 573, 573, 574, 571, 575, 638, 576, 576, 577, 575, 572, 575, 577, 577, 573, 572, 989, 990, 578, 578, 579, 989, 991, 992, 580, 580, 581, 991, 990, 991, 581, 581, 578, 990, 581, 580, 582, 582, 583, 581, 578, 581, 583, 583, 579, 578, 989, 579, 584, 584, 993, 989, 583, 582, 585, 585, 586, 583, 579, 583, 586, 586, 584, 579, 993, 584, 597, 597, 587, 993, 587, 597, 600, 600, 593, 587, 593, 600, 589, 589, 588, 593, 588, 589, 591, 588, 591, 637, 588, 637, 590, 993, 587, 592, 592, 587, 593, 593, 594, 592, 593, 588, 595, 595, 594, 593, 588, 590, 596, 596, 595, 588, 586, 585, 598, 598, 599, 586, 584, 586, 599, 599, 597, 584, 599, 598, 627, 627, 602, 599, 597, 599, 602, 602, 600, 597, 602, 601, 603, 603, 589, 602, 589, 603, 604, 604, 591, 589, 600, 602, 589, 576, 638, 605, 994, 995, 996, 996, 606, 994, 582, 580, 997, 997, 607, 582, 605, 638, 617, 617, 608, 605, 606, 996, 998, 607, 997, 999, 999, 609, 607, 1000, 1001, 610, 609, 999, 1002, 1002, 611, 609, 1002, 1003, 612, 612, 613, 1002, 613, 612, 614, 614, 615, 613, 1004, 1005, 618, 618, 616, 1004, 1005, 1006, 619, 619, 618, 1005, 1006, 617, 619, 607, 609, 585, 585, 582, 607, 609, 611, 598, 598, 585, 609, 1004, 616, 621, 621, 620, 1004, 620, 621, 623, 623, 622, 620, 613, 615, 626, 624, 1007, 1008, 1008, 625, 624, 624, 625, 604, 604, 603, 624, 1007, 624, 626, 626, 615, 1007, 1002, 613, 626, 626, 601, 1002, 601, 626, 624, 624, 603, 601, 611, 1002, 627, 627, 598, 611, 627, 1002, 601, 601, 602, 627, 571, 1009, 629, 629, 628, 571, 1009, 1010, 630, 630, 629, 1009, 1010, 1011, 631, 631, 630, 1010, 1011, 1012, 632, 632, 631, 1011, 1013, 1014, 1015, 1015, 635, 1013, 619, 617, 628, 618, 619, 628, 628, 629, 618, 621, 616, 1016, 623, 621, 1016, 1016, 636, 623, 1017, 1018, 633, 633, 1015, 1017, 631, 632, 634, 634, 1019, 631, 633, 1020, 1021, 1021, 635, 633, 1015, 633, 635, 1022, 1023, 636, 1023, 622, 623, 623, 636, 1023, 634, 1024, 1025, 634, 1025, 1026, 634, 1026, 1022, 636, 634, 1022, 632, 1012, 1024, 1024, 634, 632, 628, 575, 572, 572, 571, 628, 628, 638, 575, 638, 628, 617], "coordinates": [{"xyz": [-0.48096, 5.59129, 1.0983], "index": 0, "uvs": [0.53022, 0.65137], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.06572, "mixamorig:Neck": 0.11948, "mixamorig:Spine2": 0.81027, "mixamorig:RightShoulder": 0.00452}}, {"xyz": [-0.32637, 5.71154, 1.33302], "index": 1, "uvs": [0.53014, 0.65263], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.45284, "mixamorig:Neck": 0.41241, "mixamorig:Spine2": 0.13475}}, {"xyz": [-0.45432, 5.29148, 0.6217], "index": 2, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.59675, 5.63759, 0.36317], "index": 3, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.53235, "mixamorig:RightShoulder": 0.46765}}, {"xyz": [-0.6628, 5.88531, 0.88485], "index": 4, "uvs": [0.52738, 0.6515], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.72965, "mixamorig:Neck": 0.11667, "mixamorig:Spine2": 0.15368}}, {"xyz": [-0.42687, 5.94522, 1.27436], "index": 5, "uvs": [0.59375, 0.64062], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.79024, "mixamorig:Neck": 0.20976}}, {"xyz": [-0.17365, 6.2471, 1.37861], "index": 6, "uvs": [0.59375, 0.64062], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.93717, "mixamorig:Neck": 0.06283}}, {"xyz": [-0.28717, 6.61202, 1.29546], "index": 7, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.48773, 6.40138, 1.21657], "index": 8, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99757, "mixamorig:Neck": 0.00243}}, {"xyz": [-0.65426, 6.24125, 0.92438], "index": 9, "uvs": [0.52503, 0.65286], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.9801, "mixamorig:Neck": 0.0199}}, {"xyz": [-0.4271, 6.65569, 1.27186], "index": 10, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.36744, 6.95668, 1.25834], "index": 11, "uvs": [0.52157, 0.65703], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.2804, 6.66357, 1.36411], "index": 12, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.10912, 6.59109, 1.40481], "index": 13, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.15379, 6.81145, 1.43744], "index": 14, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.09036, 5.93708, 1.56016], "index": 15, "uvs": [0.59375, 0.64062], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.77595, "mixamorig:Neck": 0.22405}}, {"xyz": [-0.76005, 6.20538, 0.53639], "index": 16, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99111, "mixamorig:Neck": 0.00889}}, {"xyz": [-0.68627, 6.65052, 0.53467], "index": 17, "uvs": [0.52212, 0.65421], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.4761, 7.1704, 0.84794], "index": 18, "uvs": [0.51962, 0.65725], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.27883, 7.13863, 1.16375], "index": 19, "uvs": [0.52077, 0.65817], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.64441, 6.50536, 0.90899], "index": 20, "uvs": [0.5233, 0.6539], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.52812, 6.77562, 1.08471], "index": 21, "uvs": [0.52203, 0.65551], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.43876, 7.14007, 0.28262], "index": 22, "uvs": [0.52415, 0.71242], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.50817, 6.70952, 0.0351], "index": 23, "uvs": [0.52737, 0.71119], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.53826, 6.20538, -0.02892], "index": 24, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.95105, "mixamorig:Neck": 0.04895}}, {"xyz": [-0.52437, 5.87472, 0.02262], "index": 25, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.23868, "mixamorig:Neck": 0.14337, "mixamorig:Spine2": 0.33991, "mixamorig:RightShoulder": 0.27804}}, {"xyz": [-0.54302, 5.1894, 0.56704], "index": 26, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.98808, "mixamorig:RightShoulder": 0.01192}}, {"xyz": [-0.63412, 5.58585, 0.28044], "index": 27, "uvs": [0.375, 0.60156], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.00041, "mixamorig:Spine2": 0.44517, "mixamorig:RightShoulder": 0.55441}}, {"xyz": [-0.59085, 5.82815, -0.04515], "index": 28, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.31196, "mixamorig:RightArm": 0.0, "mixamorig:Neck": 0.18738, "mixamorig:Spine2": 0.33426, "mixamorig:RightShoulder": 0.1664}}, {"xyz": [-0.44434, 4.6704, 0.80379], "index": 29, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.1694, "mixamorig:Spine2": 0.8306}}, {"xyz": [-0.22651, 5.3529, 0.90363], "index": 30, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Neck": 0.01731, "mixamorig:Spine2": 0.98269}}, {"xyz": [-0.2785, 5.1947, 0.72842], "index": 31, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.19335, 4.94464, 0.84389], "index": 32, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.06064, 4.75642, 0.79222], "index": 33, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19884, "mixamorig:Spine2": 0.80116}}, {"xyz": [-0.17466, 4.81669, 0.74523], "index": 34, "uvs": [0.38281, 0.4375], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.10146, "mixamorig:Spine2": 0.89854}}, {"xyz": [-0.0914, 4.69804, 0.7856], "index": 35, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.23418, "mixamorig:Spine2": 0.76582}}, {"xyz": [-0.11193, 4.2979, 0.98345], "index": 36, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.54804, "mixamorig:Spine": 0.09117, "mixamorig:Spine2": 0.36079}}, {"xyz": [-0.18385, 4.23331, 0.93535], "index": 37, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.5571, "mixamorig:Spine": 0.13748, "mixamorig:Spine2": 0.30542}}, {"xyz": [-0.14658, 3.78689, 1.10896], "index": 38, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00058, "mixamorig:Spine1": 0.2359, "mixamorig:Spine": 0.76027, "mixamorig:Spine2": 0.00325}}, {"xyz": [-0.23024, 3.76987, 1.05197], "index": 39, "uvs": [0.82031, 0.1875], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00028, "mixamorig:Spine1": 0.22176, "mixamorig:Spine": 0.77559, "mixamorig:Spine2": 0.00236}}, {"xyz": [-0.18877, 3.21799, 1.14581], "index": 40, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.25347, 3.20097, 1.00995], "index": 41, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.20411, 2.89948, 1.14581], "index": 42, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.29022, 2.89097, 1.00556], "index": 43, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.30438, 4.64461, 0.76944], "index": 44, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.23618, "mixamorig:Spine": 0.00058, "mixamorig:Spine2": 0.76324}}, {"xyz": [-0.39726, 4.23331, 0.93535], "index": 45, "uvs": [0.35938, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.55831, "mixamorig:Spine": 0.14588, "mixamorig:Spine2": 0.2958}}, {"xyz": [-0.40251, 3.73533, 1.03424], "index": 46, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00187, "mixamorig:Spine1": 0.19114, "mixamorig:Spine": 0.80535, "mixamorig:Spine2": 0.00164}}, {"xyz": [-0.40929, 3.22439, 0.96665], "index": 47, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.79999, 3.73699, 0.88472], "index": 48, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00607, "mixamorig:Spine1": 0.18208, "mixamorig:Spine": 0.80888, "mixamorig:Spine2": 0.00297}}, {"xyz": [-0.7637, 3.20417, 0.92992], "index": 49, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.56534, 5.20098, 0.63069], "index": 50, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.98084, "mixamorig:RightShoulder": 0.01916}}, {"xyz": [-0.70783, 5.62016, 0.32287], "index": 51, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00367, "mixamorig:Spine2": 0.39624, "mixamorig:RightShoulder": 0.60009}}, {"xyz": [-0.4743, 4.6704, 0.86842], "index": 52, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19281, "mixamorig:Spine": 0.00075, "mixamorig:Spine2": 0.80644}}, {"xyz": [-0.42722, 4.23331, 0.99998], "index": 53, "uvs": [0.16406, 0.375], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.55831, "mixamorig:Spine": 0.16544, "mixamorig:Spine2": 0.27624}}, {"xyz": [-0.72234, 4.61206, 0.7866], "index": 54, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19331, "mixamorig:Spine": 0.00127, "mixamorig:Spine2": 0.80542}}, {"xyz": [-0.46386, 3.71771, 1.1282], "index": 55, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00916, "mixamorig:Spine1": 0.15934, "mixamorig:Spine": 0.83044, "mixamorig:Spine2": 0.00106}}, {"xyz": [-0.58505, 4.2145, 0.92974], "index": 56, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.54872, "mixamorig:Spine": 0.18312, "mixamorig:Spine2": 0.26815}}, {"xyz": [-0.96279, 5.08089, 0.52566], "index": 57, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01485, "mixamorig:Spine2": 0.46413, "mixamorig:RightShoulder": 0.52102}}, {"xyz": [-0.72376, 5.40061, 0.47329], "index": 58, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00248, "mixamorig:Spine2": 0.6215, "mixamorig:RightShoulder": 0.37602}}, {"xyz": [-0.63763, 5.87011, -0.05948], "index": 59, "uvs": [0.16406, 0.375], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.49071, "mixamorig:Neck": 0.28766, "mixamorig:Spine2": 0.22162, "mixamorig:RightShoulder": 0.0}}, {"xyz": [-0.95715, 5.4222, 0.38449], "index": 60, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.03432, "mixamorig:Spine2": 0.32623, "mixamorig:RightShoulder": 0.63945}}, {"xyz": [-0.71299, 5.8038, -0.12838], "index": 61, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.02222, "mixamorig:Spine2": 0.41891, "mixamorig:RightShoulder": 0.55886}}, {"xyz": [-0.42994, 5.87178, -0.47118], "index": 62, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.17228, "mixamorig:Neck": 0.19294, "mixamorig:Spine2": 0.63477}}, {"xyz": [-0.3644, 5.98106, -0.37115], "index": 63, "uvs": [0.52815, 0.65343], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.32282, 5.94902, -0.34934], "index": 64, "uvs": [0.52856, 0.65353], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.24831, 5.98646, -0.27864], "index": 65, "uvs": [0.53101, 0.70714], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.28724, "mixamorig:Neck": 0.24124, "mixamorig:Spine2": 0.47152}}, {"xyz": [-0.2677, 6.37603, -0.23925], "index": 66, "uvs": [0.52848, 0.70868], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.98725, "mixamorig:Neck": 0.01275}}, {"xyz": [-0.50644, 6.71018, 0.03464], "index": 67, "uvs": [0.52736, 0.71118], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.50753, 6.71348, 0.03737], "index": 68, "uvs": [0.52734, 0.7112], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.98664, 4.60577, 0.46003], "index": 69, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.20015, "mixamorig:RightArm": 0.00469, "mixamorig:Spine2": 0.36736, "mixamorig:RightShoulder": 0.42779}}, {"xyz": [-1.03598, 3.99271, 0.60991], "index": 70, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00216, "mixamorig:Spine1": 0.3715, "mixamorig:Spine": 0.59814, "mixamorig:Spine2": 0.02819}}, {"xyz": [-1.20397, 5.52123, -0.57619], "index": 71, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.25316, "mixamorig:Spine2": 0.09856, "mixamorig:RightShoulder": 0.64828}}, {"xyz": [-0.55387, 5.46399, -0.94552], "index": 72, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.86563, "mixamorig:RightShoulder": 0.13437}}, {"xyz": [-1.17299, 4.70239, -0.8267], "index": 73, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:Spine1": 0.05657, "mixamorig:RightArm": 0.03989, "mixamorig:Spine2": 0.17413, "mixamorig:RightShoulder": 0.72942}}, {"xyz": [-0.56105, 4.74482, -1.17473], "index": 74, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-1.06619, 3.89427, -0.70186], "index": 75, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00872, "mixamorig:Spine1": 0.32968, "mixamorig:Spine": 0.65266, "mixamorig:Spine2": 0.00894}}, {"xyz": [-0.5111, 3.87931, -1.07392], "index": 76, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00041, "mixamorig:Spine1": 0.43732, "mixamorig:Spine": 0.53901, "mixamorig:Spine2": 0.02326}}, {"xyz": [-1.06734, 3.05325, -0.6707], "index": 77, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.91369, "mixamorig:RightUpLeg": 0.08631}}, {"xyz": [-0.51101, 3.02788, -1.01118], "index": 78, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.94691, "mixamorig:RightUpLeg": 0.05309}}, {"xyz": [-1.16092, 2.22581, -0.65806], "index": 79, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.19044, "mixamorig:RightUpLeg": 0.80956}}, {"xyz": [-0.55352, 2.1901, -0.986], "index": 80, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.27925, "mixamorig:RightUpLeg": 0.65952, "mixamorig:LeftUpLeg": 0.06124}}, {"xyz": [-1.21391, 5.69142, 0.00917], "index": 81, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.22995, "mixamorig:Spine2": 0.06873, "mixamorig:RightShoulder": 0.70132}}, {"xyz": [-1.25724, 4.53348, -0.12468], "index": 82, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.02264, "mixamorig:RightArm": 0.07244, "mixamorig:Spine2": 0.02635, "mixamorig:RightShoulder": 0.87856}}, {"xyz": [-1.2592, 3.81055, -0.03886], "index": 83, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.37888, "mixamorig:Spine": 0.62112}}, {"xyz": [-1.04575, 2.81325, 0.6486], "index": 84, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.67879, "mixamorig:RightUpLeg": 0.32121}}, {"xyz": [-1.26897, 2.97996, -0.03886], "index": 85, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.82335, "mixamorig:RightUpLeg": 0.17665}}, {"xyz": [-1.11024, 2.11559, 0.48906], "index": 86, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.12215, "mixamorig:RightUpLeg": 0.87785}}, {"xyz": [-1.30318, 2.15673, -0.02393], "index": 87, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.11342, "mixamorig:RightUpLeg": 0.88658}}, {"xyz": [-0.45375, 3.15701, 1.06265], "index": 88, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.50628, 2.81325, 0.98911], "index": 89, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.86293, "mixamorig:RightUpLeg": 0.13707}}, {"xyz": [-0.64737, 2.12213, 0.78501], "index": 90, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.18414, "mixamorig:RightUpLeg": 0.81586}}, {"xyz": [-1.27642, 5.35681, 0.31702], "index": 91, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.22745, "mixamorig:Spine2": 0.10809, "mixamorig:RightShoulder": 0.66446}}, {"xyz": [-1.23548, 5.01105, 0.32052], "index": 92, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.14115, "mixamorig:Spine2": 0.14684, "mixamorig:RightShoulder": 0.71201}}, {"xyz": [-1.38977, 5.50878, -0.43172], "index": 93, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.50749, "mixamorig:Spine2": 0.01424, "mixamorig:RightShoulder": 0.47827}}, {"xyz": [-1.39062, 4.99005, -0.58817], "index": 94, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.4311, "mixamorig:Spine2": 0.01659, "mixamorig:RightShoulder": 0.55231}}, {"xyz": [-1.3967, 5.67454, 0.00212], "index": 95, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.44848, "mixamorig:Spine2": 0.01196, "mixamorig:RightShoulder": 0.53956}}, {"xyz": [-1.3792, 4.79581, -0.0924], "index": 96, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.41309, "mixamorig:Spine2": 0.00799, "mixamorig:RightShoulder": 0.57892}}, {"xyz": [-1.61698, 5.38665, 0.18852], "index": 97, "uvs": [0.10156, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.71261, "mixamorig:RightForeArm": 0.00071, "mixamorig:Spine2": 1e-05, "mixamorig:RightShoulder": 0.28668}}, {"xyz": [-1.64659, 5.08884, 0.19125], "index": 98, "uvs": [0.10156, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.77658, "mixamorig:RightForeArm": 0.00188, "mixamorig:Spine2": 1e-05, "mixamorig:RightShoulder": 0.22154}}, {"xyz": [-1.70549, 5.55212, -0.39618], "index": 99, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.79943, "mixamorig:RightForeArm": 5e-05, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.20052}}, {"xyz": [-1.6866, 5.13883, -0.51835], "index": 100, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.83835, "mixamorig:RightForeArm": 0.0002, "mixamorig:Spine2": 2e-05, "mixamorig:RightShoulder": 0.16143}}, {"xyz": [-1.71091, 5.68157, -0.05739], "index": 101, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.76805, "mixamorig:RightForeArm": 0.00011, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.23184}}, {"xyz": [-1.75882, 4.92076, -0.1312], "index": 102, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.8897, "mixamorig:RightForeArm": 0.00229, "mixamorig:Spine2": 1e-05, "mixamorig:RightShoulder": 0.108}}, {"xyz": [-2.22416, 5.44574, 0.12023], "index": 103, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.88997, "mixamorig:RightForeArm": 0.05548, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.05455}}, {"xyz": [-2.2374, 5.15014, 0.1282], "index": 104, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.86929, "mixamorig:RightForeArm": 0.09752, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.03319}}, {"xyz": [-2.26307, 5.45692, -0.39785], "index": 105, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.92501, "mixamorig:RightForeArm": 0.0347, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.0403}}, {"xyz": [-2.26773, 5.16459, -0.38238], "index": 106, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.92723, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.04873, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.02403}}, {"xyz": [-2.26712, 5.62936, -0.15187], "index": 107, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.92119, "mixamorig:RightForeArm": 0.03053, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.04828}}, {"xyz": [-2.28291, 4.99836, -0.12894], "index": 108, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.87541, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.10868, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.01591}}, {"xyz": [-2.4345, 5.43697, 0.10401], "index": 109, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.62199, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.37801}}, {"xyz": [-2.44689, 5.16012, 0.11147], "index": 110, "uvs": [0.10156, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.57598, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.42149, "mixamorig:RightShoulder": 0.00252}}, {"xyz": [-2.47094, 5.44744, -0.3812], "index": 111, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.72313, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.26823, "mixamorig:RightShoulder": 0.00864}}, {"xyz": [-2.4753, 5.17366, -0.36672], "index": 112, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.63644, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.36186, "mixamorig:RightShoulder": 0.0017}}, {"xyz": [-2.47473, 5.60895, -0.15083], "index": 113, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.64268, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.35732}}, {"xyz": [-2.48952, 5.01797, -0.12936], "index": 114, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.48228, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.51772}}, {"xyz": [-2.64934, 5.43332, 0.09726], "index": 115, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.25218, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.74782}}, {"xyz": [-2.66138, 5.16428, 0.10451], "index": 116, "uvs": [0.10156, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.22055, "mixamorig:RightHand": 1e-05, "mixamorig:RightForeArm": 0.77945}}, {"xyz": [-2.68475, 5.4435, -0.37427], "index": 117, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.35021, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.64979}}, {"xyz": [-2.68898, 5.17743, -0.36019], "index": 118, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.28262, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.71737}}, {"xyz": [-2.68843, 5.60045, -0.1504], "index": 119, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.29036, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.70964}}, {"xyz": [-2.7028, 5.02613, -0.12953], "index": 120, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.17179, "mixamorig:RightHandIndex1": 3e-05, "mixamorig:RightHand": 1e-05, "mixamorig:RightForeArm": 0.82818}}, {"xyz": [-3.1531, 5.43332, 0.15444], "index": 121, "uvs": [0.10156, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.02621, "mixamorig:RightHandIndex1": 0.00202, "mixamorig:RightHand": 0.00018, "mixamorig:RightForeArm": 0.97158}}, {"xyz": [-3.16515, 5.16428, 0.16169], "index": 122, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01572, "mixamorig:RightHandIndex1": 0.0015, "mixamorig:RightHand": 0.00079, "mixamorig:RightForeArm": 0.98199}}, {"xyz": [-3.18851, 5.4435, -0.31709], "index": 123, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.06487, "mixamorig:RightHandIndex1": 0.00527, "mixamorig:RightHand": 0.00029, "mixamorig:RightForeArm": 0.92958}}, {"xyz": [-3.19275, 5.17743, -0.30301], "index": 124, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.04479, "mixamorig:RightHandIndex1": 0.00445, "mixamorig:RightHand": 0.00065, "mixamorig:RightForeArm": 0.9501}}, {"xyz": [-3.1922, 5.60045, -0.09322], "index": 125, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.04691, "mixamorig:RightHandIndex1": 0.00427, "mixamorig:RightHand": 9e-05, "mixamorig:RightForeArm": 0.94873}}, {"xyz": [-3.20657, 5.02613, -0.07235], "index": 126, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01663, "mixamorig:RightHandIndex1": 0.00244, "mixamorig:RightHand": 0.00098, "mixamorig:RightForeArm": 0.97995}}, {"xyz": [-3.52964, 5.43332, 0.24517], "index": 127, "uvs": [0.10156, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.00492, "mixamorig:RightHandIndex1": 0.00835, "mixamorig:RightHand": 0.03186, "mixamorig:RightForeArm": 0.95488}}, {"xyz": [-3.54369, 5.16428, 0.25445], "index": 128, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.0024, "mixamorig:RightHandIndex1": 0.00439, "mixamorig:RightHand": 0.06859, "mixamorig:RightForeArm": 0.92462}}, {"xyz": [-3.59527, 5.4435, -0.31734], "index": 129, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01879, "mixamorig:RightHandIndex1": 0.04902, "mixamorig:RightHand": 0.03004, "mixamorig:RightForeArm": 0.90215}}, {"xyz": [-3.59964, 5.17743, -0.30029], "index": 130, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01253, "mixamorig:RightHandIndex1": 0.0373, "mixamorig:RightHand": 0.04015, "mixamorig:RightForeArm": 0.91002}}, {"xyz": [-3.58864, 5.60045, -0.04926], "index": 131, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.0127, "mixamorig:RightHandIndex1": 0.0362, "mixamorig:RightHand": 0.01889, "mixamorig:RightForeArm": 0.93221}}, {"xyz": [-3.6048, 5.02613, -0.02357], "index": 132, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00389, "mixamorig:RightHandIndex1": 0.01566, "mixamorig:RightHand": 0.0737, "mixamorig:RightForeArm": 0.90675}}, {"xyz": [-3.54041, 5.39722, 0.18209], "index": 133, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.03003, "mixamorig:RightArm": 0.00267, "mixamorig:RightHandIndex1": 0.00962, "mixamorig:RightHand": 0.09806, "mixamorig:RightForeArm": 0.85962}}, {"xyz": [-3.55127, 5.20537, 0.18925], "index": 134, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.04274, "mixamorig:RightArm": 0.00093, "mixamorig:RightHandIndex1": 0.00475, "mixamorig:RightHand": 0.17295, "mixamorig:RightForeArm": 0.77863}}, {"xyz": [-3.59109, 5.40448, -0.25231], "index": 135, "uvs": [0.125, 0.49219], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.01406, "mixamorig:RightHandIndex1": 0.07099, "mixamorig:RightHand": 0.08646, "mixamorig:RightForeArm": 0.8285}}, {"xyz": [-3.59447, 5.21476, -0.23914], "index": 136, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00973, "mixamorig:RightHandIndex1": 0.05563, "mixamorig:RightHand": 0.10048, "mixamorig:RightForeArm": 0.83416}}, {"xyz": [-3.58598, 5.5164, -0.04528], "index": 137, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00864, "mixamorig:RightHandIndex1": 0.05904, "mixamorig:RightHand": 0.08941, "mixamorig:RightForeArm": 0.84291}}, {"xyz": [-3.59846, 5.10687, -0.02545], "index": 138, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00267, "mixamorig:RightHandIndex1": 0.02457, "mixamorig:RightHand": 0.1979, "mixamorig:RightForeArm": 0.77486}}, {"xyz": [-3.70758, 5.41257, 0.26489], "index": 139, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0, "mixamorig:RightForeArm": 0.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.42809, "mixamorig:RightHandThumb1": 0.57191}}, {"xyz": [-3.72029, 5.1879, 0.27304], "index": 140, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0, "mixamorig:RightForeArm": 0.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.40566, "mixamorig:RightHandThumb1": 0.59434}}, {"xyz": [-3.76693, 5.42106, -0.22976], "index": 141, "uvs": [0.34375, 0.875], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.03765, "mixamorig:RightForeArm": 0.16261, "mixamorig:RightHandIndex1": 0.16417, "mixamorig:RightHand": 0.63556}}, {"xyz": [-3.77088, 5.19889, -0.21476], "index": 142, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.02354, "mixamorig:RightForeArm": 0.19217, "mixamorig:RightHandIndex1": 0.1204, "mixamorig:RightHand": 0.66389}}, {"xyz": [-3.76093, 5.55212, 0.00598], "index": 143, "uvs": [0.34375, 0.875], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.0401, "mixamorig:RightForeArm": 0.15127, "mixamorig:RightHandIndex1": 0.12147, "mixamorig:RightHand": 0.68715}}, {"xyz": [-3.77555, 5.07255, 0.02856], "index": 144, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.00547, "mixamorig:RightForeArm": 0.15058, "mixamorig:RightHandIndex1": 0.03591, "mixamorig:RightHand": 0.80804}}, {"xyz": [-3.71769, 5.38773, 0.20731], "index": 145, "uvs": [0.38281, 0.65625], "totalWeight": 1.00001, "skin": {"mixamorig:RightHandIndex2": 0.00046, "mixamorig:RightForeArm": 0.01141, "mixamorig:RightHandIndex1": 0.00151, "mixamorig:RightHand": 0.36499, "mixamorig:RightHandThumb1": 0.62164}}, {"xyz": [-3.7274, 5.21618, 0.21353], "index": 146, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.62541, "mixamorig:RightHand": 0.37459}}, {"xyz": [-3.76301, 5.39422, -0.1704], "index": 147, "uvs": [0.38281, 0.65625], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.05704, "mixamorig:RightForeArm": 0.09057, "mixamorig:RightHandIndex1": 0.20421, "mixamorig:RightHand": 0.64817}}, {"xyz": [-3.76603, 5.22457, -0.15895], "index": 148, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0369, "mixamorig:RightForeArm": 0.09632, "mixamorig:RightHandIndex1": 0.13905, "mixamorig:RightHand": 0.72773}}, {"xyz": [-3.75843, 5.4943, 0.00961], "index": 149, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.05498, "mixamorig:RightForeArm": 0.09304, "mixamorig:RightHandIndex1": 0.16744, "mixamorig:RightHand": 0.68454}}, {"xyz": [-3.76959, 5.221, 0.02685], "index": 150, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.02117, "mixamorig:RightForeArm": 0.05537, "mixamorig:RightHandIndex1": 0.07747, "mixamorig:RightHand": 0.76624, "mixamorig:RightHandThumb1": 0.07975}}, {"xyz": [-3.98892, 5.44557, 0.20641], "index": 151, "uvs": [0.51461, 0.63232], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.16236, "mixamorig:RightHandIndex1": 0.79152, "mixamorig:RightHand": 0.04612}}, {"xyz": [-3.99862, 5.27402, 0.21263], "index": 152, "uvs": [0.55353, 0.72428], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 1.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.0, "mixamorig:RightHandIndex2": 0.0}}, {"xyz": [-4.03424, 5.45206, -0.2007], "index": 153, "uvs": [0.55251, 0.72513], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.20708, "mixamorig:RightHandIndex1": 0.70838, "mixamorig:RightHand": 0.08454}}, {"xyz": [-4.03726, 5.28241, -0.18925], "index": 154, "uvs": [0.55366, 0.72452], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.21984, "mixamorig:RightForeArm": 0.00101, "mixamorig:RightHandIndex1": 0.63316, "mixamorig:RightHand": 0.14585, "mixamorig:RightHandIndex3": 0.00014}}, {"xyz": [-4.02966, 5.55214, 0.0087], "index": 155, "uvs": [0.55181, 0.72548], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.17597, "mixamorig:RightHandIndex1": 0.7762, "mixamorig:RightHand": 0.04783}}, {"xyz": [-4.04082, 5.34353, 0.02595], "index": 156, "uvs": [0.51505, 0.63166], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.28383, "mixamorig:RightHandIndex1": 0.68016, "mixamorig:RightHand": 0.03483, "mixamorig:RightHandIndex3": 0.00118}}, {"xyz": [-4.13382, 5.42911, 0.2226], "index": 157, "uvs": [0.51404, 0.63149], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.39322, "mixamorig:RightHandIndex1": 0.60085, "mixamorig:RightHand": 0.00572, "mixamorig:RightHandIndex3": 0.00021}}, {"xyz": [-4.08965, 5.29515, 0.22937], "index": 158, "uvs": [0.51515, 0.63123], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.34826, "mixamorig:RightHandIndex1": 0.64208, "mixamorig:RightHand": 0.00901, "mixamorig:RightHandIndex3": 0.00065}}, {"xyz": [-4.1691, 5.42045, -0.188], "index": 159, "uvs": [0.55335, 0.72573], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.41942, "mixamorig:RightHandIndex1": 0.56673, "mixamorig:RightHand": 0.0137, "mixamorig:RightHandIndex3": 0.00014}}, {"xyz": [-4.14252, 5.28883, -0.17555], "index": 160, "uvs": [0.55411, 0.7251], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.41072, "mixamorig:RightHandIndex1": 0.56675, "mixamorig:RightHand": 0.02158, "mixamorig:RightHandIndex3": 0.00095}}, {"xyz": [-4.14876, 5.51947, 0.00768], "index": 161, "uvs": [0.55259, 0.72599], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.29252, "mixamorig:RightHandIndex1": 0.69651, "mixamorig:RightHand": 0.01092, "mixamorig:RightHandIndex3": 5e-05}}, {"xyz": [-4.14108, 5.34911, 0.02643], "index": 162, "uvs": [0.51454, 0.63116], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.38168, "mixamorig:RightHandIndex1": 0.5958, "mixamorig:RightHand": 0.02016, "mixamorig:RightHandIndex3": 0.00236}}, {"xyz": [-4.33782, 5.37248, 0.26595], "index": 163, "uvs": [0.51345, 0.6302], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.90924, "mixamorig:RightHandIndex1": 0.05794, "mixamorig:RightHandIndex3": 0.03281}}, {"xyz": [-4.23678, 5.26794, 0.27151], "index": 164, "uvs": [0.51464, 0.63035], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.85315, "mixamorig:RightHandIndex1": 0.10277, "mixamorig:RightHandIndex3": 0.04408}}, {"xyz": [-4.35471, 5.32796, -0.14811], "index": 165, "uvs": [0.55485, 0.72636], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.83188, "mixamorig:RightHandIndex1": 0.01685, "mixamorig:RightHandIndex3": 0.15127}}, {"xyz": [-4.2505, 5.22907, -0.14975], "index": 166, "uvs": [0.55503, 0.72545], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.78621, "mixamorig:RightHandIndex1": 0.13898, "mixamorig:RightHandIndex3": 0.07481}}, {"xyz": [-4.40204, 5.36914, 0.06099], "index": 167, "uvs": [0.5548, 0.72676], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.49596, "mixamorig:RightHandIndex1": 0.00574, "mixamorig:RightHandIndex3": 0.4983}}, {"xyz": [-4.29813, 5.25082, 0.05502], "index": 168, "uvs": [0.51446, 0.62996], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.68211, "mixamorig:RightHandIndex1": 0.09867, "mixamorig:RightHandIndex3": 0.21921}}, {"xyz": [-4.40691, 5.25759, 0.25498], "index": 169, "uvs": [0.5139, 0.62941], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.09971, "mixamorig:RightHandIndex3": 0.90029}}, {"xyz": [-4.34257, 5.19491, 0.26054], "index": 170, "uvs": [0.51463, 0.62952], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.12761, "mixamorig:RightHandIndex3": 0.87239}}, {"xyz": [-4.39296, 5.22388, -0.14373], "index": 171, "uvs": [0.55574, 0.72618], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.14714, "mixamorig:RightHandIndex3": 0.85286}}, {"xyz": [-4.3252, 5.16684, -0.14119], "index": 172, "uvs": [0.5558, 0.72561], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.20502, "mixamorig:RightHandIndex3": 0.79498}}, {"xyz": [-4.47045, 5.23288, 0.08476], "index": 173, "uvs": [0.55604, 0.72662], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.01525, "mixamorig:RightHandIndex3": 0.98475}}, {"xyz": [-4.3995, 5.15893, 0.08544], "index": 174, "uvs": [0.5146, 0.62909], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.01775, "mixamorig:RightHandIndex3": 0.98225}}, {"xyz": [-3.84362, 5.21951, 0.30595], "index": 175, "uvs": [0.51682, 0.63225], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.54124, "mixamorig:RightHandThumb1": 0.41571, "mixamorig:RightHand": 0.04305}}, {"xyz": [-3.81019, 5.39106, 0.29972], "index": 176, "uvs": [0.51582, 0.63306], "totalWeight": 1.00001, "skin": {"mixamorig:RightHandThumb2": 0.12952, "mixamorig:RightHandThumb1": 0.82418, "mixamorig:RightHand": 0.04631}}, {"xyz": [-3.97407, 5.4203, 0.25293], "index": 177, "uvs": [0.51485, 0.6323], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandThumb2": 0.20506, "mixamorig:RightHandThumb1": 0.07427, "mixamorig:RightHandIndex1": 0.60199, "mixamorig:RightHand": 0.03147, "mixamorig:RightHandIndex2": 0.0872}}, {"xyz": [-3.9956, 5.27736, 0.25915], "index": 178, "uvs": [0.51571, 0.63166], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.96076, "mixamorig:RightHandThumb1": 0.00302, "mixamorig:RightHandThumb3": 0.03622}}, {"xyz": [-3.96134, 5.16675, 0.36786], "index": 179, "uvs": [0.51662, 0.63143], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.43665, "mixamorig:RightHandThumb1": 0.00526, "mixamorig:RightHandThumb3": 0.55809}}, {"xyz": [-3.99843, 5.26277, 0.42275], "index": 180, "uvs": [0.5158, 0.63159], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.07411, "mixamorig:RightHandThumb3": 0.92589}}, {"xyz": [-4.10129, 5.27465, 0.38086], "index": 181, "uvs": [0.51523, 0.63109], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.01069, "mixamorig:RightHandThumb3": 0.98931}}, {"xyz": [-4.07837, 5.18693, 0.32597], "index": 182, "uvs": [0.51593, 0.63089], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.02743, "mixamorig:RightHandThumb3": 0.97257}}, {"xyz": [-0.41597, 3.09403, 0.96059], "index": 183, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.4685, 2.75312, 0.79538], "index": 184, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.85496, "mixamorig:RightUpLeg": 0.14504}}, {"xyz": [-0.22362, 3.08667, 0.96152], "index": 185, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.21848, 2.72999, 0.83921], "index": 186, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.95892, "mixamorig:RightUpLeg": 0.04108}}, {"xyz": [-0.2564, 2.34666, 0.59432], "index": 187, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.48996, "mixamorig:RightUpLeg": 0.45315, "mixamorig:LeftUpLeg": 0.05689}}, {"xyz": [-0.43939, 2.35604, -0.63038], "index": 188, "uvs": [0.16406, 0.375], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.15066, "mixamorig:RightUpLeg": 0.74636, "mixamorig:LeftUpLeg": 0.10299}}, {"xyz": [-0.886, 2.3785, -0.45811], "index": 189, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.10834, "mixamorig:RightUpLeg": 0.89166}}, {"xyz": [-1.10492, 2.15673, -0.02704], "index": 190, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.07482, "mixamorig:RightUpLeg": 0.92518}}, {"xyz": [-0.82492, 2.14793, 0.40738], "index": 191, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08043, "mixamorig:RightUpLeg": 0.91957}}, {"xyz": [-0.53112, 2.36288, 0.57285], "index": 192, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.31787, "mixamorig:RightUpLeg": 0.68213}}, {"xyz": [-0.38062, 2.12376, 0.44059], "index": 193, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.20008, "mixamorig:RightUpLeg": 0.73819, "mixamorig:LeftUpLeg": 0.06173}}, {"xyz": [-0.19928, 2.0904, 0.19568], "index": 194, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.13754, "mixamorig:RightUpLeg": 0.63744, "mixamorig:LeftUpLeg": 0.22502}}, {"xyz": [-0.16253, 2.13825, -0.32713], "index": 195, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0818, "mixamorig:RightUpLeg": 0.59302, "mixamorig:LeftUpLeg": 0.32518}}, {"xyz": [-0.50039, 1.97834, -0.43016], "index": 196, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08081, "mixamorig:RightUpLeg": 0.83714, "mixamorig:RightLeg": 0.00216, "mixamorig:LeftUpLeg": 0.07989}}, {"xyz": [-0.83824, 1.96892, -0.39365], "index": 197, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.06566, "mixamorig:RightLeg": 0.00383, "mixamorig:RightUpLeg": 0.93051}}, {"xyz": [-1.03587, 1.94282, -0.03106], "index": 198, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.04465, "mixamorig:RightLeg": 0.00528, "mixamorig:RightUpLeg": 0.95007}}, {"xyz": [-0.7934, 1.9352, 0.38538], "index": 199, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.05738, "mixamorig:RightLeg": 0.00726, "mixamorig:RightUpLeg": 0.93536}}, {"xyz": [-0.40866, 1.91427, 0.37389], "index": 200, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.10294, "mixamorig:RightUpLeg": 0.84482, "mixamorig:RightLeg": 0.00644, "mixamorig:LeftUpLeg": 0.0458}}, {"xyz": [-0.25162, 1.88538, 0.1618], "index": 201, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.09094, "mixamorig:RightUpLeg": 0.77811, "mixamorig:RightLeg": 0.00884, "mixamorig:LeftUpLeg": 0.12211}}, {"xyz": [-0.26213, 1.92682, -0.22941], "index": 202, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.06968, "mixamorig:RightUpLeg": 0.75809, "mixamorig:RightLeg": 0.00269, "mixamorig:LeftUpLeg": 0.16954}}, {"xyz": [-0.53291, 1.63255, -0.32912], "index": 203, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.03525, "mixamorig:RightUpLeg": 0.87777, "mixamorig:RightLeg": 0.06529, "mixamorig:LeftUpLeg": 0.02169}}, {"xyz": [-0.83001, 1.62403, -0.31164], "index": 204, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.02515, "mixamorig:RightLeg": 0.06778, "mixamorig:RightUpLeg": 0.90706}}, {"xyz": [-0.99465, 1.60043, 0.01608], "index": 205, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.01771, "mixamorig:RightLeg": 0.07376, "mixamorig:RightUpLeg": 0.90853}}, {"xyz": [-0.79266, 1.56682, 0.38482], "index": 206, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.02404, "mixamorig:RightLeg": 0.08409, "mixamorig:RightUpLeg": 0.89187}}, {"xyz": [-0.47216, 1.57463, 0.38207], "index": 207, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.03695, "mixamorig:RightUpLeg": 0.88807, "mixamorig:RightLeg": 0.07494, "mixamorig:LeftUpLeg": 5e-05}}, {"xyz": [-0.29712, 1.59421, 0.01359], "index": 208, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.03872, "mixamorig:RightUpLeg": 0.83618, "mixamorig:RightLeg": 0.07723, "mixamorig:LeftUpLeg": 0.04787}}, {"xyz": [-0.53847, 1.48336, -0.30459], "index": 209, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.01398, "mixamorig:RightLeg": 0.19741, "mixamorig:RightFoot": 0.00017, "mixamorig:RightUpLeg": 0.78844}}, {"xyz": [-0.82186, 1.47524, -0.28792], "index": 210, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00767, "mixamorig:RightLeg": 0.20993, "mixamorig:RightFoot": 0.0001, "mixamorig:RightUpLeg": 0.78229}}, {"xyz": [-0.97889, 1.45274, 0.02467], "index": 211, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00429, "mixamorig:RightLeg": 0.20576, "mixamorig:RightFoot": 5e-05, "mixamorig:RightUpLeg": 0.7899}}, {"xyz": [-0.78623, 1.42067, 0.37641], "index": 212, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00748, "mixamorig:RightLeg": 0.19187, "mixamorig:RightFoot": 8e-05, "mixamorig:RightUpLeg": 0.80057}}, {"xyz": [-0.48051, 1.42812, 0.37378], "index": 213, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.01293, "mixamorig:RightLeg": 0.18588, "mixamorig:RightUpLeg": 0.80119}}, {"xyz": [-0.31355, 1.4468, 0.02231], "index": 214, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00853, "mixamorig:RightUpLeg": 0.74565, "mixamorig:RightFoot": 0.00084, "mixamorig:RightLeg": 0.23656, "mixamorig:LeftUpLeg": 0.00841}}, {"xyz": [-0.49472, 1.30706, -0.34664], "index": 215, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.0031, "mixamorig:RightToeBase": 0.00133, "mixamorig:RightLeg": 0.57807, "mixamorig:RightUpLeg": 0.4175}}, {"xyz": [-0.81036, 1.29948, -0.33111], "index": 216, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.00117, "mixamorig:RightToeBase": 0.00106, "mixamorig:RightLeg": 0.58056, "mixamorig:RightUpLeg": 0.41722}}, {"xyz": [-0.9567, 1.27851, -0.03982], "index": 217, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.00129, "mixamorig:RightToeBase": 0.00084, "mixamorig:RightLeg": 0.55074, "mixamorig:RightUpLeg": 0.44713}}, {"xyz": [-0.77717, 1.24864, 0.28794], "index": 218, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.00522, "mixamorig:RightToeBase": 0.00088, "mixamorig:RightLeg": 0.51187, "mixamorig:RightUpLeg": 0.48203}}, {"xyz": [-0.49229, 1.25558, 0.28549], "index": 219, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.00913, "mixamorig:RightToeBase": 0.00099, "mixamorig:RightLeg": 0.5134, "mixamorig:RightUpLeg": 0.47649}}, {"xyz": [-0.33671, 1.27298, -0.04202], "index": 220, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.00827, "mixamorig:RightToeBase": 0.00155, "mixamorig:RightLeg": 0.5662, "mixamorig:RightUpLeg": 0.42398}}, {"xyz": [-0.50666, 0.9411, -0.389], "index": 221, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.01965, "mixamorig:RightToeBase": 0.00799, "mixamorig:RightLeg": 0.9001, "mixamorig:RightUpLeg": 0.07226}}, {"xyz": [-0.79282, 0.93353, -0.37346], "index": 222, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.01055, "mixamorig:RightToeBase": 0.00711, "mixamorig:RightLeg": 0.91336, "mixamorig:RightUpLeg": 0.06898}}, {"xyz": [-0.92282, 0.91256, -0.08218], "index": 223, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.01112, "mixamorig:RightToeBase": 0.00799, "mixamorig:RightLeg": 0.90313, "mixamorig:RightUpLeg": 0.07777}}, {"xyz": [-0.76333, 0.88269, 0.24558], "index": 224, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.03831, "mixamorig:RightToeBase": 0.0102, "mixamorig:RightLeg": 0.855, "mixamorig:RightUpLeg": 0.09649}}, {"xyz": [-0.51026, 0.88963, 0.24313], "index": 225, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.05883, "mixamorig:RightToeBase": 0.01094, "mixamorig:RightLeg": 0.82942, "mixamorig:RightUpLeg": 0.1008}}, {"xyz": [-0.37205, 0.90703, -0.08438], "index": 226, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.04608, "mixamorig:RightToeBase": 0.01064, "mixamorig:RightLeg": 0.86541, "mixamorig:RightUpLeg": 0.07787}}, {"xyz": [-0.51423, 0.55973, -0.39364], "index": 227, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.13091, "mixamorig:RightToeBase": 0.02113, "mixamorig:RightLeg": 0.83764, "mixamorig:RightUpLeg": 0.01032}}, {"xyz": [-0.78469, 0.55614, -0.37771], "index": 228, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.10582, "mixamorig:RightToeBase": 0.02116, "mixamorig:RightLeg": 0.86279, "mixamorig:RightUpLeg": 0.01023}}, {"xyz": [-0.90756, 0.60012, -0.10522], "index": 229, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.06984, "mixamorig:RightToeBase": 0.03159, "mixamorig:RightLeg": 0.88192, "mixamorig:RightUpLeg": 0.01665}}, {"xyz": [-0.75682, 0.64384, 0.20275], "index": 230, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.12237, "mixamorig:RightToeBase": 0.03851, "mixamorig:RightLeg": 0.81326, "mixamorig:RightUpLeg": 0.02587}}, {"xyz": [-0.51763, 0.64969, 0.19899], "index": 231, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.16615, "mixamorig:RightToeBase": 0.03675, "mixamorig:RightLeg": 0.76955, "mixamorig:RightUpLeg": 0.02755}}, {"xyz": [-0.38701, 0.59455, -0.10604], "index": 232, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.16334, "mixamorig:RightToeBase": 0.03175, "mixamorig:RightLeg": 0.78877, "mixamorig:RightUpLeg": 0.01613}}, {"xyz": [-0.49909, 0.37974, -0.42416], "index": 233, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.34558, "mixamorig:RightToeBase": 0.02558, "mixamorig:RightLeg": 0.62883, "mixamorig:RightUpLeg": 0.0}}, {"xyz": [-0.80095, 0.37682, -0.40617], "index": 234, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:RightToeBase": 0.02707, "mixamorig:RightLeg": 0.66142, "mixamorig:RightFoot": 0.3115}}, {"xyz": [-0.93808, 0.44425, -0.10558], "index": 235, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.16544, "mixamorig:RightToeBase": 0.05659, "mixamorig:RightLeg": 0.77146, "mixamorig:RightUpLeg": 0.00651}}, {"xyz": [-0.76984, 0.51379, 0.23456], "index": 236, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.22703, "mixamorig:RightToeBase": 0.07533, "mixamorig:RightLeg": 0.69414, "mixamorig:RightUpLeg": 0.0035}}, {"xyz": [-0.50288, 0.52006, 0.22998], "index": 237, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.26068, "mixamorig:RightToeBase": 0.06534, "mixamorig:RightLeg": 0.66206, "mixamorig:RightUpLeg": 0.01192}}, {"xyz": [-0.35709, 0.43799, -0.10612], "index": 238, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.27629, "mixamorig:RightToeBase": 0.04835, "mixamorig:RightLeg": 0.67015, "mixamorig:RightUpLeg": 0.00522}}, {"xyz": [-0.54692, 0.40473, -0.29945], "index": 239, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.03495, "mixamorig:RightLeg": 0.49252, "mixamorig:RightFoot": 0.47253}}, {"xyz": [-0.73398, 0.40292, -0.2883], "index": 240, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.45449, "mixamorig:RightToeBase": 0.03892, "mixamorig:RightLeg": 0.50645, "mixamorig:RightUpLeg": 0.00014}}, {"xyz": [-0.81895, 0.4447, -0.10203], "index": 241, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.34023, "mixamorig:RightToeBase": 0.09027, "mixamorig:RightLeg": 0.56733, "mixamorig:RightUpLeg": 0.00217}}, {"xyz": [-0.7147, 0.48779, 0.10875], "index": 242, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.36746, "mixamorig:RightToeBase": 0.13863, "mixamorig:RightLeg": 0.49238, "mixamorig:RightUpLeg": 0.00153}}, {"xyz": [-0.54927, 0.49168, 0.10591], "index": 243, "uvs": [0.10156, 0.42969], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.41845, "mixamorig:RightToeBase": 0.12125, "mixamorig:RightLeg": 0.45788, "mixamorig:RightUpLeg": 0.00241}}, {"xyz": [-0.45893, 0.44082, -0.10236], "index": 244, "uvs": [0.10156, 0.42969], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.3869, "mixamorig:RightToeBase": 0.06307, "mixamorig:RightLeg": 0.54767, "mixamorig:RightUpLeg": 0.00235}}, {"xyz": [-0.53732, 0.24032, -0.30963], "index": 245, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.04777, "mixamorig:RightLeg": 0.26803, "mixamorig:RightFoot": 0.6842}}, {"xyz": [-0.74429, 0.23915, -0.29719], "index": 246, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:RightToeBase": 0.05457, "mixamorig:RightLeg": 0.24787, "mixamorig:RightFoot": 0.69757}}, {"xyz": [-0.8383, 0.2992, -0.09468], "index": 247, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.11678, "mixamorig:RightLeg": 0.26291, "mixamorig:RightFoot": 0.62031}}, {"xyz": [-0.87024, 0.2377, 0.16084], "index": 248, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.3244, "mixamorig:RightLeg": 0.07212, "mixamorig:RightFoot": 0.60348}}, {"xyz": [-0.47538, 0.24426, 0.14717], "index": 249, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.23338, "mixamorig:RightLeg": 0.10666, "mixamorig:RightFoot": 0.65996}}, {"xyz": [-0.43997, 0.29489, -0.09477], "index": 250, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.08749, "mixamorig:RightLeg": 0.28993, "mixamorig:RightFoot": 0.62258}}, {"xyz": [-0.53732, 0.00439, -0.29607], "index": 251, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.06203, "mixamorig:RightLeg": 0.12811, "mixamorig:RightFoot": 0.80986}}, {"xyz": [-0.72476, -0.00039, -0.28697], "index": 252, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.0716, "mixamorig:RightLeg": 0.12142, "mixamorig:RightFoot": 0.80698}}, {"xyz": [-0.84833, 0.00475, -0.04702], "index": 253, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.16233, "mixamorig:RightLeg": 0.07728, "mixamorig:RightFoot": 0.76039}}, {"xyz": [-0.87468, 0.01765, 0.19177], "index": 254, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:RightToeBase": 0.38451, "mixamorig:RightLeg": 0.03623, "mixamorig:RightFoot": 0.57927}}, {"xyz": [-0.53873, 0.01983, 0.08796], "index": 255, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.22135, "mixamorig:RightLeg": 0.06136, "mixamorig:RightFoot": 0.71729}}, {"xyz": [-0.46887, 0.00777, -0.08815], "index": 256, "uvs": [0.11719, 0.44531], "totalWeight": 0.99999, "skin": {"mixamorig:RightToeBase": 0.10696, "mixamorig:RightLeg": 0.10162, "mixamorig:RightFoot": 0.79141}}, {"xyz": [-0.65277, 0.36476, 0.1811], "index": 257, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.28292, "mixamorig:RightLeg": 0.1488, "mixamorig:RightFoot": 0.56828}}, {"xyz": [-0.87653, 0.16071, 0.46724], "index": 258, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.6486, "mixamorig:RightLeg": 0.00341, "mixamorig:RightFoot": 0.34799}}, {"xyz": [-0.50385, 0.17233, 0.45356], "index": 259, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.58244, "mixamorig:RightLeg": 0.00945, "mixamorig:RightFoot": 0.40811}}, {"xyz": [-0.87468, 0.0023, 0.49816], "index": 260, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.6926, "mixamorig:RightFoot": 0.3074}}, {"xyz": [-0.47412, 0.0024, 0.44677], "index": 261, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.57975, "mixamorig:RightLeg": 0.00644, "mixamorig:RightFoot": 0.41381}}, {"xyz": [-0.69602, 0.24458, 0.48749], "index": 262, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.64019, "mixamorig:RightLeg": 0.0057, "mixamorig:RightFoot": 0.35411}}, {"xyz": [-0.85617, 0.12735, 0.68632], "index": 263, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.77559, "mixamorig:RightFoot": 0.22441}}, {"xyz": [-0.56913, 0.11563, 0.68349], "index": 264, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.78436, "mixamorig:RightFoot": 0.21564}}, {"xyz": [-0.84368, 0.00549, 0.67742], "index": 265, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.77768, "mixamorig:RightFoot": 0.22232}}, {"xyz": [-0.5807, 0.00557, 0.67432], "index": 266, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.78669, "mixamorig:RightFoot": 0.21331}}, {"xyz": [-0.70355, 0.19493, 0.63134], "index": 267, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.75834, "mixamorig:RightFoot": 0.24166}}, {"xyz": [-0.70352, 0.0825, 0.77666], "index": 268, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.80597, "mixamorig:RightFoot": 0.19403}}, {"xyz": [-0.70333, 0.0216, 0.77371], "index": 269, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.80651, "mixamorig:RightFoot": 0.19349}}, {"xyz": [-0.584, 0.006, 0.67699], "index": 270, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.78761, "mixamorig:RightFoot": 0.21239}}, {"xyz": [-0.0, 5.6542, 1.49151], "index": 271, "uvs": [0.53207, 0.65414], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.46424, "mixamorig:Neck": 0.4386, "mixamorig:Spine2": 0.09716}}, {"xyz": [-0.0, 6.03096, 1.5468], "index": 272, "uvs": [0.52953, 0.65554], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.83089, "mixamorig:Neck": 0.16911}}, {"xyz": [-0.0, 6.83206, 1.37349], "index": 273, "uvs": [0.52415, 0.65851], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 6.30323, 1.59549], "index": 274, "uvs": [0.5277, 0.65655], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97808, "mixamorig:Neck": 0.02192}}, {"xyz": [-0.0, 5.94968, 1.5829], "index": 275, "uvs": [0.53008, 0.65524], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78602, "mixamorig:Neck": 0.21398}}, {"xyz": [-0.0, 7.17618, 1.19265], "index": 276, "uvs": [0.52183, 0.65978], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 7.29242, 0.26803], "index": 277, "uvs": [0.52105, 0.71067], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 6.42241, -0.21533], "index": 278, "uvs": [0.5269, 0.70744], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 5.08022, 0.80591], "index": 279, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.0, 5.98425, -0.51249], "index": 280, "uvs": [0.52985, 0.65536], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.63616, "mixamorig:Neck": 0.27782, "mixamorig:Spine2": 0.08602}}, {"xyz": [-0.0, 5.8172, -0.66758], "index": 281, "uvs": [0.10938, 0.91406], "totalWeight": 1.00001, "skin": {"mixamorig:Head": 0.07713, "mixamorig:Neck": 0.07414, "mixamorig:Spine2": 0.84874}}, {"xyz": [-0.0, 3.88935, -1.16033], "index": 282, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0004, "mixamorig:Spine1": 0.46934, "mixamorig:Spine": 0.49799, "mixamorig:Spine2": 0.03227}}, {"xyz": [-0.0, 2.20011, -1.07202], "index": 283, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.33726, "mixamorig:RightUpLeg": 0.30665, "mixamorig:LeftUpLeg": 0.35609}}, {"xyz": [0.32637, 5.71154, 1.33302], "index": 284, "uvs": [0.53323, 0.65608], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.47813, "mixamorig:Neck": 0.4037, "mixamorig:Spine2": 0.11817}}, {"xyz": [0.48096, 5.59129, 1.0983], "index": 285, "uvs": [0.53477, 0.65645], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.09459, "mixamorig:Neck": 0.1736, "mixamorig:LeftShoulder": 0.0, "mixamorig:Spine2": 0.73181}}, {"xyz": [-0.0, 5.43197, 1.24481], "index": 286, "uvs": [0.53356, 0.65332], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.14872, "mixamorig:Neck": 0.382, "mixamorig:Spine2": 0.46928}}, {"xyz": [0.17365, 6.2471, 1.37861], "index": 287, "uvs": [0.5289, 0.65725], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.94572, "mixamorig:Neck": 0.05428}}, {"xyz": [0.42687, 5.94522, 1.27436], "index": 288, "uvs": [0.53213, 0.65747], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.79594, "mixamorig:Neck": 0.20406}}, {"xyz": [0.09035, 5.93708, 1.56016], "index": 289, "uvs": [0.59375, 0.67969], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.77787, "mixamorig:Neck": 0.22213}}, {"xyz": [0.6628, 5.88531, 0.88485], "index": 290, "uvs": [0.53365, 0.6585], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.8817, "mixamorig:Neck": 0.09895, "mixamorig:Spine2": 0.01935}}, {"xyz": [0.59675, 5.63759, 0.36317], "index": 291, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.63131, "mixamorig:Spine2": 0.36869}}, {"xyz": [0.45432, 5.29148, 0.6217], "index": 292, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.00356, "mixamorig:Spine2": 0.99644}}, {"xyz": [0.28717, 6.61202, 1.29546], "index": 293, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.48773, 6.40138, 1.21657], "index": 294, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99671, "mixamorig:Neck": 0.00329}}, {"xyz": [0.65426, 6.24125, 0.92438], "index": 295, "uvs": [0.53121, 0.65977], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97773, "mixamorig:Neck": 0.02227}}, {"xyz": [0.76005, 6.20538, 0.53639], "index": 296, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.98833, "mixamorig:Neck": 0.01167}}, {"xyz": [0.52812, 6.77562, 1.08471], "index": 297, "uvs": [0.52702, 0.66109], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.4761, 7.1704, 0.84794], "index": 298, "uvs": [0.52412, 0.66228], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.68627, 6.65052, 0.53467], "index": 299, "uvs": [0.52861, 0.66146], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.64441, 6.50536, 0.90899], "index": 300, "uvs": [0.52939, 0.6607], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.36744, 6.95668, 1.25834], "index": 301, "uvs": [0.52504, 0.66091], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.15379, 6.81145, 1.43744], "index": 302, "uvs": [0.52501, 0.65924], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.2804, 6.66357, 1.36411], "index": 303, "uvs": [0.52661, 0.65936], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.10912, 6.59109, 1.40481], "index": 304, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.4271, 6.65569, 1.27186], "index": 305, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 6.59672, 1.43281], "index": 306, "uvs": [0.52573, 0.65764], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 6.14889, 1.46238], "index": 307, "uvs": [0.52874, 0.65597], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.90673, "mixamorig:Neck": 0.09327}}, {"xyz": [0.27883, 7.13863, 1.16375], "index": 308, "uvs": [0.5234, 0.66112], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 7.32199, 0.81364], "index": 309, "uvs": [0.52085, 0.66032], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.53826, 6.20538, -0.02892], "index": 310, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.98199, "mixamorig:Neck": 0.01801}}, {"xyz": [0.52437, 5.87472, 0.02262], "index": 311, "uvs": [0.59375, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:Head": 0.34748, "mixamorig:Neck": 0.61035, "mixamorig:Spine2": 0.04218}}, {"xyz": [0.43876, 7.14007, 0.28262], "index": 312, "uvs": [0.52, 0.70779], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.50753, 6.71348, 0.03737], "index": 313, "uvs": [0.52254, 0.70584], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.50817, 6.70952, 0.0351], "index": 314, "uvs": [0.52257, 0.70583], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 6.90418, -0.09829], "index": 315, "uvs": [0.52366, 0.70923], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.50644, 6.71018, 0.03464], "index": 316, "uvs": [0.52257, 0.70584], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.2677, 6.37603, -0.23925], "index": 317, "uvs": [0.52595, 0.70586], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 5.97168, -0.33119], "index": 318, "uvs": [0.52993, 0.70577], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.8502, "mixamorig:Neck": 0.1498}}, {"xyz": [0.24831, 5.98646, -0.27864], "index": 319, "uvs": [0.52866, 0.70452], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78537, "mixamorig:Neck": 0.21463}}, {"xyz": [0.63412, 5.58585, 0.28044], "index": 320, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.68917, "mixamorig:LeftArm": 0.00199, "mixamorig:Spine2": 0.30884}}, {"xyz": [0.54301, 5.1894, 0.56704], "index": 321, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.01391, "mixamorig:Spine2": 0.98609}}, {"xyz": [0.59085, 5.82815, -0.04515], "index": 322, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.36555, "mixamorig:Neck": 0.59581, "mixamorig:Spine2": 0.03864}}, {"xyz": [0.22651, 5.3529, 0.90363], "index": 323, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Neck": 0.05657, "mixamorig:Spine2": 0.94343}}, {"xyz": [0.2785, 5.1947, 0.72842], "index": 324, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.19335, 4.94464, 0.84389], "index": 325, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.44434, 4.6704, 0.80379], "index": 326, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.18698, "mixamorig:Spine2": 0.81302}}, {"xyz": [0.30438, 4.64461, 0.76944], "index": 327, "uvs": [0.36719, 0.89062], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.25075, "mixamorig:Spine": 0.00104, "mixamorig:Spine2": 0.7482}}, {"xyz": [0.17466, 4.81669, 0.74523], "index": 328, "uvs": [0.38281, 0.4375], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.0328, "mixamorig:Spine2": 0.9672}}, {"xyz": [0.0914, 4.69804, 0.7856], "index": 329, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.25582, "mixamorig:Spine2": 0.74418}}, {"xyz": [0.06064, 4.75642, 0.79222], "index": 330, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21123, "mixamorig:Spine2": 0.78877}}, {"xyz": [0.18385, 4.23331, 0.93535], "index": 331, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.5901, "mixamorig:Spine": 0.13049, "mixamorig:Spine2": 0.27941}}, {"xyz": [0.11193, 4.2979, 0.98345], "index": 332, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.57624, "mixamorig:Spine": 0.07535, "mixamorig:Spine2": 0.34841}}, {"xyz": [0.23024, 3.76987, 1.05197], "index": 333, "uvs": [0.82031, 0.1875], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.00403, "mixamorig:Spine1": 0.24966, "mixamorig:Spine": 0.74387, "mixamorig:Spine2": 0.00245}}, {"xyz": [0.14658, 3.78689, 1.10896], "index": 334, "uvs": [0.61719, 0.14062], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.00435, "mixamorig:Spine1": 0.25791, "mixamorig:Spine": 0.73491, "mixamorig:Spine2": 0.00284}}, {"xyz": [0.25347, 3.20097, 1.00995], "index": 335, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.18877, 3.21799, 1.14581], "index": 336, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.29022, 2.89097, 1.05189], "index": 337, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.2041, 2.89948, 1.14581], "index": 338, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.39726, 4.23331, 0.93535], "index": 339, "uvs": [0.33594, 0.60938], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.56788, "mixamorig:Spine": 0.15778, "mixamorig:Spine2": 0.27433}}, {"xyz": [0.40251, 3.73533, 1.03424], "index": 340, "uvs": [0.33594, 0.60938], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.01578, "mixamorig:Spine1": 0.20928, "mixamorig:Spine": 0.77357, "mixamorig:Spine2": 0.00138}}, {"xyz": [0.40929, 3.22439, 0.96665], "index": 341, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.0, 2.70539, 1.05582], "index": 342, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.46386, 3.71771, 1.1282], "index": 343, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00889, "mixamorig:Spine1": 0.19848, "mixamorig:Spine": 0.7916, "mixamorig:Spine2": 0.00103}}, {"xyz": [0.45375, 3.15701, 1.06265], "index": 344, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.79999, 3.73699, 0.88472], "index": 345, "uvs": [0.125, 0.65625], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.01148, "mixamorig:Spine1": 0.1899, "mixamorig:Spine": 0.79706, "mixamorig:Spine2": 0.00155}}, {"xyz": [0.76369, 3.20417, 0.92992], "index": 346, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.99672, "mixamorig:Spine": 0.00328}}, {"xyz": [0.70783, 5.62016, 0.32287], "index": 347, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.67211, "mixamorig:LeftArm": 0.00371, "mixamorig:Spine2": 0.32418}}, {"xyz": [0.56534, 5.20098, 0.63069], "index": 348, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.04533, "mixamorig:Spine2": 0.95467}}, {"xyz": [0.4743, 4.6704, 0.86842], "index": 349, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21066, "mixamorig:Spine": 0.00131, "mixamorig:Spine2": 0.78803}}, {"xyz": [0.42722, 4.23331, 0.99998], "index": 350, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.56417, "mixamorig:Spine": 0.19379, "mixamorig:Spine2": 0.24204}}, {"xyz": [0.96279, 5.08089, 0.52566], "index": 351, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.44705, "mixamorig:LeftArm": 0.00857, "mixamorig:Spine2": 0.54438}}, {"xyz": [0.72234, 4.61206, 0.7866], "index": 352, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21015, "mixamorig:Spine": 0.00413, "mixamorig:LeftShoulder": 0.01995, "mixamorig:Spine2": 0.76577}}, {"xyz": [0.58505, 4.2145, 0.92974], "index": 353, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0, "mixamorig:Spine1": 0.54793, "mixamorig:Spine": 0.23453, "mixamorig:Spine2": 0.21754}}, {"xyz": [1.03598, 3.99271, 0.60991], "index": 354, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00013, "mixamorig:Spine1": 0.35687, "mixamorig:Spine": 0.62155, "mixamorig:Spine2": 0.02145}}, {"xyz": [0.95715, 5.4222, 0.38449], "index": 355, "uvs": [0.10938, 0.91406], "totalWeight": 1.00001, "skin": {"mixamorig:LeftShoulder": 0.65404, "mixamorig:LeftArm": 0.01779, "mixamorig:Spine2": 0.32818}}, {"xyz": [0.72376, 5.40061, 0.47329], "index": 356, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.40769, "mixamorig:LeftArm": 0.00149, "mixamorig:Spine2": 0.59082}}, {"xyz": [0.63763, 5.87011, -0.05948], "index": 357, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.40996, "mixamorig:Neck": 0.52836, "mixamorig:Spine2": 0.06168}}, {"xyz": [0.71299, 5.8038, -0.12838], "index": 358, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:LeftShoulder": 0.71874, "mixamorig:LeftArm": 0.02891, "mixamorig:Spine2": 0.25234}}, {"xyz": [0.32282, 5.94902, -0.34934], "index": 359, "uvs": [0.53161, 0.65694], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78212, "mixamorig:Neck": 0.21788}}, {"xyz": [-0.0, 5.95836, -0.45758], "index": 360, "uvs": [0.53002, 0.65527], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.85469, "mixamorig:Neck": 0.14531}}, {"xyz": [0.3644, 5.98106, -0.37115], "index": 361, "uvs": [0.53159, 0.65728], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78271, "mixamorig:Neck": 0.21729}}, {"xyz": [0.42994, 5.87178, -0.47118], "index": 362, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.27847, "mixamorig:Neck": 0.1804, "mixamorig:Spine2": 0.54113}}, {"xyz": [0.98663, 4.60577, 0.46003], "index": 363, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.17508, "mixamorig:LeftShoulder": 0.40446, "mixamorig:LeftArm": 0.0043, "mixamorig:Spine2": 0.41616}}, {"xyz": [-0.0, 5.45167, -1.00489], "index": 364, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.55387, 5.46399, -0.94552], "index": 365, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.17785, "mixamorig:Spine2": 0.82215}}, {"xyz": [1.20397, 5.52123, -0.57619], "index": 366, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.681, "mixamorig:LeftArm": 0.26585, "mixamorig:Spine2": 0.05315}}, {"xyz": [-0.0, 4.74644, -1.23456], "index": 367, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.00106, "mixamorig:Spine2": 0.99894}}, {"xyz": [0.56105, 4.74482, -1.17473], "index": 368, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [1.17299, 4.70239, -0.8267], "index": 369, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:Spine1": 0.02882, "mixamorig:LeftShoulder": 0.69519, "mixamorig:LeftArm": 0.05765, "mixamorig:Spine2": 0.21835}}, {"xyz": [0.5111, 3.87931, -1.07392], "index": 370, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00017, "mixamorig:Spine1": 0.42149, "mixamorig:Spine": 0.57035, "mixamorig:Spine2": 0.00798}}, {"xyz": [1.06619, 3.89427, -0.70186], "index": 371, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00067, "mixamorig:Spine1": 0.2571, "mixamorig:Spine": 0.73531, "mixamorig:Spine2": 0.00692}}, {"xyz": [-0.0, 3.03791, -1.09758], "index": 372, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.99925, "mixamorig:Spine": 0.00075}}, {"xyz": [0.51101, 3.02788, -1.01118], "index": 373, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [1.06734, 3.05325, -0.6707], "index": 374, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.95372, "mixamorig:LeftUpLeg": 0.04628}}, {"xyz": [0.55352, 2.1901, -0.986], "index": 375, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.21639, "mixamorig:RightUpLeg": 0.04071, "mixamorig:LeftUpLeg": 0.7429}}, {"xyz": [1.16092, 2.22581, -0.65806], "index": 376, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.1911, "mixamorig:LeftUpLeg": 0.8089}}, {"xyz": [1.21391, 5.69142, 0.00917], "index": 377, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.77367, "mixamorig:LeftArm": 0.19239, "mixamorig:Spine2": 0.03394}}, {"xyz": [1.2592, 3.81055, -0.03886], "index": 378, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.37179, "mixamorig:Spine": 0.62821}}, {"xyz": [1.25724, 4.53348, -0.12468], "index": 379, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.01376, "mixamorig:LeftShoulder": 0.82581, "mixamorig:LeftArm": 0.0853, "mixamorig:Spine2": 0.07512}}, {"xyz": [1.26897, 2.97996, -0.03886], "index": 380, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.9044, "mixamorig:LeftUpLeg": 0.0956}}, {"xyz": [1.04575, 2.81325, 0.6486], "index": 381, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.73795, "mixamorig:LeftUpLeg": 0.26205}}, {"xyz": [1.30318, 2.15673, -0.02393], "index": 382, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.13264, "mixamorig:LeftUpLeg": 0.86736}}, {"xyz": [1.11024, 2.11559, 0.48906], "index": 383, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.11898, "mixamorig:LeftUpLeg": 0.88102}}, {"xyz": [0.64737, 2.12213, 0.78501], "index": 384, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.14824, "mixamorig:LeftUpLeg": 0.85176}}, {"xyz": [0.50628, 2.81325, 0.98911], "index": 385, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.90863, "mixamorig:LeftUpLeg": 0.09137}}, {"xyz": [1.27642, 5.35681, 0.31702], "index": 386, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.69724, "mixamorig:LeftArm": 0.17971, "mixamorig:Spine2": 0.12305}}, {"xyz": [1.23548, 5.01105, 0.32052], "index": 387, "uvs": [0.125, 0.65625], "totalWeight": 1.00001, "skin": {"mixamorig:LeftShoulder": 0.66611, "mixamorig:LeftArm": 0.1145, "mixamorig:Spine2": 0.2194}}, {"xyz": [1.39062, 4.99005, -0.58817], "index": 388, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.48994, "mixamorig:LeftArm": 0.49412, "mixamorig:Spine2": 0.01594}}, {"xyz": [1.38976, 5.50878, -0.43172], "index": 389, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.44341, "mixamorig:LeftArm": 0.54744, "mixamorig:Spine2": 0.00915}}, {"xyz": [1.3967, 5.67454, 0.00212], "index": 390, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.53234, "mixamorig:LeftArm": 0.45969, "mixamorig:Spine2": 0.00797}}, {"xyz": [1.3792, 4.79581, -0.0924], "index": 391, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.56602, "mixamorig:LeftArm": 0.42109, "mixamorig:Spine2": 0.01289}}, {"xyz": [1.61698, 5.38665, 0.18852], "index": 392, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:LeftForeArm": 0.00124, "mixamorig:LeftShoulder": 0.26718, "mixamorig:LeftArm": 0.73152, "mixamorig:Spine2": 5e-05}}, {"xyz": [1.64659, 5.08884, 0.19125], "index": 393, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.00161, "mixamorig:LeftShoulder": 0.23572, "mixamorig:LeftArm": 0.76265, "mixamorig:Spine2": 2e-05}}, {"xyz": [1.6866, 5.13883, -0.51835], "index": 394, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 4e-05, "mixamorig:LeftShoulder": 0.11926, "mixamorig:LeftArm": 0.88067, "mixamorig:Spine2": 3e-05}}, {"xyz": [1.70549, 5.55212, -0.39618], "index": 395, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 6e-05, "mixamorig:LeftShoulder": 0.12395, "mixamorig:LeftArm": 0.87586, "mixamorig:Spine2": 0.00013}}, {"xyz": [1.71091, 5.68157, -0.05739], "index": 396, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.00036, "mixamorig:LeftShoulder": 0.15591, "mixamorig:LeftArm": 0.84372, "mixamorig:Spine2": 1e-05}}, {"xyz": [1.75882, 4.92076, -0.1312], "index": 397, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.00113, "mixamorig:LeftShoulder": 0.11999, "mixamorig:LeftArm": 0.87888, "mixamorig:Spine2": 0.0}}, {"xyz": [2.22416, 5.44574, 0.12023], "index": 398, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.0778, "mixamorig:LeftShoulder": 0.03695, "mixamorig:LeftArm": 0.88525}}, {"xyz": [2.2374, 5.15014, 0.1282], "index": 399, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.09724, "mixamorig:LeftShoulder": 0.03303, "mixamorig:LeftArm": 0.86973}}, {"xyz": [2.26773, 5.16459, -0.38238], "index": 400, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.03584, "mixamorig:LeftShoulder": 0.01567, "mixamorig:LeftArm": 0.94849}}, {"xyz": [2.26307, 5.45692, -0.39785], "index": 401, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.03181, "mixamorig:LeftShoulder": 0.01803, "mixamorig:LeftArm": 0.95016}}, {"xyz": [2.26712, 5.62936, -0.15187], "index": 402, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.0401, "mixamorig:LeftShoulder": 0.02293, "mixamorig:LeftArm": 0.93697}}, {"xyz": [2.28291, 4.99836, -0.12894], "index": 403, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftForeArm": 0.07208, "mixamorig:LeftShoulder": 0.01848, "mixamorig:LeftArm": 0.90944}}, {"xyz": [2.4345, 5.43697, 0.10401], "index": 404, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.40948, "mixamorig:LeftShoulder": 0.00317, "mixamorig:LeftArm": 0.58735}}, {"xyz": [2.44689, 5.16012, 0.11147], "index": 405, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.43632, "mixamorig:LeftShoulder": 0.00199, "mixamorig:LeftArm": 0.56168}}, {"xyz": [2.4753, 5.17366, -0.36672], "index": 406, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.33246, "mixamorig:LeftArm": 0.66754}}, {"xyz": [2.47094, 5.44744, -0.3812], "index": 407, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.27357, "mixamorig:LeftShoulder": 0.00158, "mixamorig:LeftArm": 0.72485}}, {"xyz": [2.47473, 5.60895, -0.15083], "index": 408, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.40435, "mixamorig:LeftArm": 0.59565}}, {"xyz": [2.48952, 5.01797, -0.12936], "index": 409, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.46556, "mixamorig:LeftArm": 0.53444}}, {"xyz": [2.64934, 5.43332, 0.09726], "index": 410, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.80529, "mixamorig:LeftArm": 0.19471}}, {"xyz": [2.66138, 5.16428, 0.10451], "index": 411, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.79066, "mixamorig:LeftArm": 0.20934}}, {"xyz": [2.68898, 5.17743, -0.36019], "index": 412, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.7069, "mixamorig:LeftArm": 0.2931}}, {"xyz": [2.68475, 5.4435, -0.37427], "index": 413, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.68256, "mixamorig:LeftArm": 0.31744}}, {"xyz": [2.68843, 5.60045, -0.1504], "index": 414, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.78364, "mixamorig:LeftArm": 0.21636}}, {"xyz": [2.7028, 5.02613, -0.12953], "index": 415, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftForeArm": 0.80263, "mixamorig:LeftArm": 0.19737}}, {"xyz": [3.1531, 5.43332, 0.15444], "index": 416, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 9e-05, "mixamorig:LeftForeArm": 0.98626, "mixamorig:LeftArm": 0.01331, "mixamorig:LeftHandIndex1": 0.00034}}, {"xyz": [3.16515, 5.16428, 0.16169], "index": 417, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 3e-05, "mixamorig:LeftForeArm": 0.98826, "mixamorig:LeftArm": 0.01153, "mixamorig:LeftHandIndex1": 0.00019}}, {"xyz": [3.19275, 5.17743, -0.30301], "index": 418, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0001, "mixamorig:LeftForeArm": 0.95808, "mixamorig:LeftArm": 0.04115, "mixamorig:LeftHandIndex1": 0.00067}}, {"xyz": [3.18851, 5.4435, -0.31709], "index": 419, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00023, "mixamorig:LeftForeArm": 0.95335, "mixamorig:LeftArm": 0.04548, "mixamorig:LeftHandIndex1": 0.00094}}, {"xyz": [3.1922, 5.60045, -0.09322], "index": 420, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00025, "mixamorig:LeftForeArm": 0.97368, "mixamorig:LeftArm": 0.02525, "mixamorig:LeftHandIndex1": 0.00082}}, {"xyz": [3.20657, 5.02613, -0.07235], "index": 421, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 3e-05, "mixamorig:LeftForeArm": 0.9792, "mixamorig:LeftArm": 0.02038, "mixamorig:LeftHandIndex1": 0.0004}}, {"xyz": [3.52964, 5.43332, 0.24517], "index": 422, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.02199, "mixamorig:LeftForeArm": 0.97305, "mixamorig:LeftArm": 0.00244, "mixamorig:LeftHandIndex1": 0.00252}}, {"xyz": [3.54369, 5.16428, 0.25445], "index": 423, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.01864, "mixamorig:LeftForeArm": 0.97855, "mixamorig:LeftArm": 0.0019, "mixamorig:LeftHandIndex1": 0.00091}}, {"xyz": [3.59964, 5.17743, -0.30029], "index": 424, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.01788, "mixamorig:LeftForeArm": 0.96144, "mixamorig:LeftArm": 0.01234, "mixamorig:LeftHandIndex1": 0.00834}}, {"xyz": [3.59527, 5.4435, -0.31734], "index": 425, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.02113, "mixamorig:LeftForeArm": 0.95605, "mixamorig:LeftArm": 0.01159, "mixamorig:LeftHandIndex1": 0.01123}}, {"xyz": [3.58864, 5.60045, -0.04926], "index": 426, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.03561, "mixamorig:LeftForeArm": 0.94745, "mixamorig:LeftArm": 0.00647, "mixamorig:LeftHandIndex1": 0.01047}}, {"xyz": [3.6048, 5.02613, -0.02357], "index": 427, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00926, "mixamorig:LeftForeArm": 0.98324, "mixamorig:LeftArm": 0.0045, "mixamorig:LeftHandIndex1": 0.003}}, {"xyz": [3.54041, 5.39722, 0.18209], "index": 428, "uvs": [0.10938, 0.39062], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.07968, "mixamorig:LeftForeArm": 0.89024, "mixamorig:LeftArm": 0.00165, "mixamorig:LeftHandThumb1": 0.02511, "mixamorig:LeftHandIndex1": 0.00331}}, {"xyz": [3.55127, 5.20537, 0.18925], "index": 429, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.06459, "mixamorig:LeftForeArm": 0.92675, "mixamorig:LeftArm": 0.00129, "mixamorig:LeftHandThumb1": 0.00624, "mixamorig:LeftHandIndex1": 0.00113}}, {"xyz": [3.59447, 5.21476, -0.23914], "index": 430, "uvs": [0.10938, 0.39062], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.05036, "mixamorig:LeftForeArm": 0.92739, "mixamorig:LeftArm": 0.00969, "mixamorig:LeftHandIndex1": 0.01257}}, {"xyz": [3.59109, 5.40448, -0.25231], "index": 431, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.07765, "mixamorig:LeftForeArm": 0.89428, "mixamorig:LeftArm": 0.00932, "mixamorig:LeftHandIndex1": 0.01875}}, {"xyz": [3.58598, 5.5164, -0.04528], "index": 432, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.13593, "mixamorig:LeftForeArm": 0.84127, "mixamorig:LeftArm": 0.00414, "mixamorig:LeftHandIndex1": 0.01866}}, {"xyz": [3.59846, 5.10687, -0.02545], "index": 433, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.04569, "mixamorig:LeftForeArm": 0.94602, "mixamorig:LeftArm": 0.00356, "mixamorig:LeftHandIndex1": 0.00473}}, {"xyz": [3.70758, 5.41257, 0.26489], "index": 434, "uvs": [0.35938, 0.89844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.61712, "mixamorig:LeftForeArm": 0.11982, "mixamorig:LeftHandIndex1": 0.0019, "mixamorig:LeftHandThumb1": 0.26117}}, {"xyz": [3.72029, 5.1879, 0.27304], "index": 435, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.619, "mixamorig:LeftForeArm": 0.18847, "mixamorig:LeftHandIndex1": 0.00048, "mixamorig:LeftHandThumb1": 0.19205}}, {"xyz": [3.77088, 5.19889, -0.21476], "index": 436, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.67726, "mixamorig:LeftForeArm": 0.2936, "mixamorig:LeftHandIndex1": 0.02914}}, {"xyz": [3.76693, 5.42106, -0.22976], "index": 437, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.73172, "mixamorig:LeftForeArm": 0.21256, "mixamorig:LeftHandIndex1": 0.05572}}, {"xyz": [3.76093, 5.55212, 0.00598], "index": 438, "uvs": [0.35938, 0.89844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.8173, "mixamorig:LeftForeArm": 0.12969, "mixamorig:LeftHandIndex1": 0.05302}}, {"xyz": [3.77555, 5.07255, 0.02856], "index": 439, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.73244, "mixamorig:LeftForeArm": 0.26083, "mixamorig:LeftHandIndex1": 0.00673}}, {"xyz": [3.71769, 5.38773, 0.20731], "index": 440, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.59919, "mixamorig:LeftForeArm": 0.05802, "mixamorig:LeftHandIndex1": 0.00171, "mixamorig:LeftHandThumb1": 0.34108}}, {"xyz": [3.7274, 5.21618, 0.21353], "index": 441, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.65164, "mixamorig:LeftForeArm": 0.0839, "mixamorig:LeftHandIndex1": 0.00043, "mixamorig:LeftHandThumb1": 0.26403}}, {"xyz": [3.76603, 5.22457, -0.15895], "index": 442, "uvs": [0.32031, 0.57812], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.78324, "mixamorig:LeftForeArm": 0.17407, "mixamorig:LeftHandIndex1": 0.04268}}, {"xyz": [3.76301, 5.39422, -0.1704], "index": 443, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.79606, "mixamorig:LeftForeArm": 0.11801, "mixamorig:LeftHandIndex1": 0.08593}}, {"xyz": [3.75843, 5.4943, 0.00961], "index": 444, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.82984, "mixamorig:LeftForeArm": 0.08316, "mixamorig:LeftHandIndex1": 0.07087, "mixamorig:LeftHandThumb1": 0.01613}}, {"xyz": [3.76959, 5.221, 0.02685], "index": 445, "uvs": [0.32031, 0.57812], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.82595, "mixamorig:LeftForeArm": 0.10635, "mixamorig:LeftHandIndex1": 0.02373, "mixamorig:LeftHandThumb1": 0.04396}}, {"xyz": [3.96134, 5.16675, 0.36786], "index": 446, "uvs": [0.55408, 0.67323], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.71466, "mixamorig:LeftHandThumb2": 0.28534, "mixamorig:LeftHandThumb1": 0.0}}, {"xyz": [3.99843, 5.26277, 0.42275], "index": 447, "uvs": [0.55361, 0.67379], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.90033, "mixamorig:LeftHandThumb2": 0.09967}}, {"xyz": [4.10129, 5.27465, 0.38086], "index": 448, "uvs": [0.55401, 0.67437], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.96067, "mixamorig:LeftHandThumb2": 0.03933}}, {"xyz": [4.07837, 5.18693, 0.32597], "index": 449, "uvs": [0.5545, 0.67393], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.94424, "mixamorig:LeftHandThumb2": 0.05576}}, {"xyz": [4.03726, 5.28241, -0.18925], "index": 450, "uvs": [0.51548, 0.68191], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.04792, "mixamorig:LeftHandIndex1": 0.74763, "mixamorig:LeftHandIndex2": 0.20445}}, {"xyz": [4.03424, 5.45206, -0.2007], "index": 451, "uvs": [0.51435, 0.68256], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.01188, "mixamorig:LeftHandIndex1": 0.83555, "mixamorig:LeftHandIndex2": 0.15257}}, {"xyz": [4.02966, 5.55214, 0.0087], "index": 452, "uvs": [0.5137, 0.68295], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.0016, "mixamorig:LeftHandIndex1": 0.8754, "mixamorig:LeftHandIndex2": 0.12299}}, {"xyz": [3.98892, 5.44557, 0.20641], "index": 453, "uvs": [0.55233, 0.67441], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHandThumb3": 0.00014, "mixamorig:LeftHandThumb1": 0.52908, "mixamorig:LeftHandIndex2": 0.00055, "mixamorig:LeftHand": 0.02235, "mixamorig:LeftHandThumb2": 0.44363, "mixamorig:LeftHandIndex1": 0.00424}}, {"xyz": [4.04082, 5.34353, 0.02595], "index": 454, "uvs": [0.55327, 0.67431], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00031, "mixamorig:LeftHandIndex3": 0.00134, "mixamorig:LeftHandIndex1": 0.74512, "mixamorig:LeftHandIndex2": 0.25323}}, {"xyz": [3.99862, 5.27402, 0.21263], "index": 455, "uvs": [0.51572, 0.68208], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.00946, "mixamorig:LeftHandThumb2": 0.89561, "mixamorig:LeftHandThumb1": 0.09493}}, {"xyz": [4.13382, 5.42911, 0.2226], "index": 456, "uvs": [0.55313, 0.67512], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftHandIndex3": 0.00216, "mixamorig:LeftHandIndex1": 0.51334, "mixamorig:LeftHandIndex2": 0.4845}}, {"xyz": [4.08965, 5.29515, 0.22937], "index": 457, "uvs": [0.55382, 0.67439], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandThumb3": 0.26099, "mixamorig:LeftHandThumb2": 0.72846, "mixamorig:LeftHandThumb1": 0.01056}}, {"xyz": [4.14252, 5.28883, -0.17555], "index": 458, "uvs": [0.51494, 0.68138], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.001, "mixamorig:LeftHandIndex3": 0.00304, "mixamorig:LeftHandIndex1": 0.43481, "mixamorig:LeftHandIndex2": 0.56116}}, {"xyz": [4.1691, 5.42045, -0.188], "index": 459, "uvs": [0.51393, 0.68173], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00013, "mixamorig:LeftHandIndex3": 0.00052, "mixamorig:LeftHandIndex1": 0.62323, "mixamorig:LeftHandIndex2": 0.37612}}, {"xyz": [4.14876, 5.51947, 0.00768], "index": 460, "uvs": [0.51336, 0.6822], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftHandIndex1": 0.76392, "mixamorig:LeftHandIndex2": 0.23607}}, {"xyz": [4.14108, 5.34911, 0.02643], "index": 461, "uvs": [0.5537, 0.67486], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00019, "mixamorig:LeftHandIndex3": 0.01044, "mixamorig:LeftHandIndex1": 0.56969, "mixamorig:LeftHandIndex2": 0.41968}}, {"xyz": [4.33782, 5.37248, 0.26595], "index": 462, "uvs": [0.55448, 0.67598], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHandIndex3": 0.24574, "mixamorig:LeftHandIndex1": 0.00141, "mixamorig:LeftHandIndex2": 0.75284}}, {"xyz": [4.23678, 5.26794, 0.27151], "index": 463, "uvs": [0.5547, 0.67506], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.18244, "mixamorig:LeftHandIndex1": 0.00871, "mixamorig:LeftHandIndex2": 0.80885}}, {"xyz": [4.2505, 5.22907, -0.14975], "index": 464, "uvs": [0.51483, 0.68059], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.07782, "mixamorig:LeftHandIndex1": 0.01108, "mixamorig:LeftHandIndex2": 0.9111}}, {"xyz": [4.35471, 5.32796, -0.14811], "index": 465, "uvs": [0.51367, 0.68041], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.18628, "mixamorig:LeftHandIndex1": 0.00353, "mixamorig:LeftHandIndex2": 0.81019}}, {"xyz": [4.40204, 5.36914, 0.06099], "index": 466, "uvs": [0.51317, 0.68031], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.48852, "mixamorig:LeftHandIndex2": 0.51148}}, {"xyz": [4.29813, 5.25082, 0.05502], "index": 467, "uvs": [0.55511, 0.67532], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.35073, "mixamorig:LeftHandIndex1": 0.01137, "mixamorig:LeftHandIndex2": 0.6379}}, {"xyz": [4.40691, 5.25759, 0.25498], "index": 468, "uvs": [0.55558, 0.67592], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.81983, "mixamorig:LeftHandIndex2": 0.18017}}, {"xyz": [4.34257, 5.19491, 0.26054], "index": 469, "uvs": [0.55569, 0.67535], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.81428, "mixamorig:LeftHandIndex2": 0.18572}}, {"xyz": [4.3252, 5.16684, -0.14119], "index": 470, "uvs": [0.5149, 0.67996], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.59237, "mixamorig:LeftHandIndex2": 0.40763}}, {"xyz": [4.39296, 5.22388, -0.14373], "index": 471, "uvs": [0.51419, 0.67982], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.70871, "mixamorig:LeftHandIndex2": 0.29129}}, {"xyz": [4.47045, 5.23288, 0.08476], "index": 472, "uvs": [0.51377, 0.67944], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandIndex3": 0.9241, "mixamorig:LeftHandIndex2": 0.07591}}, {"xyz": [4.3995, 5.15893, 0.08544], "index": 473, "uvs": [0.5562, 0.67552], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.91886, "mixamorig:LeftHandIndex2": 0.08114}}, {"xyz": [3.81019, 5.39106, 0.29972], "index": 474, "uvs": [0.55186, 0.67327], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.15226, "mixamorig:LeftHandThumb3": 0.00014, "mixamorig:LeftHandThumb2": 0.26364, "mixamorig:LeftHandThumb1": 0.58396}}, {"xyz": [3.84362, 5.21951, 0.30595], "index": 475, "uvs": [0.55317, 0.67281], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.00237, "mixamorig:LeftHandThumb2": 0.77022, "mixamorig:LeftHandThumb1": 0.22741}}, {"xyz": [3.97407, 5.4203, 0.25293], "index": 476, "uvs": [0.55243, 0.67424], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandThumb3": 0.00076, "mixamorig:LeftHandThumb2": 0.7536, "mixamorig:LeftHandThumb1": 0.24565}}, {"xyz": [3.9956, 5.27736, 0.25915], "index": 477, "uvs": [0.5535, 0.67383], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.17126, "mixamorig:LeftHandThumb2": 0.79102, "mixamorig:LeftHandThumb1": 0.03772}}, {"xyz": [0.4685, 2.75312, 0.79538], "index": 478, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.8349, "mixamorig:LeftUpLeg": 0.1651}}, {"xyz": [0.41597, 3.09403, 0.96059], "index": 479, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.21848, 2.72999, 0.83921], "index": 480, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.93653, "mixamorig:LeftUpLeg": 0.06347}}, {"xyz": [0.22361, 3.08667, 0.96152], "index": 481, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.53112, 2.36288, 0.57285], "index": 482, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.20696, "mixamorig:LeftUpLeg": 0.79304}}, {"xyz": [0.2564, 2.34666, 0.59432], "index": 483, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.44937, "mixamorig:RightUpLeg": 0.07889, "mixamorig:LeftUpLeg": 0.47174}}, {"xyz": [-0.0, 2.36021, -0.66227], "index": 484, "uvs": [0.16406, 0.375], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.14729, "mixamorig:RightUpLeg": 0.39681, "mixamorig:LeftUpLeg": 0.45589}}, {"xyz": [0.43939, 2.35604, -0.63038], "index": 485, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08782, "mixamorig:RightUpLeg": 0.1013, "mixamorig:LeftUpLeg": 0.81088}}, {"xyz": [0.886, 2.3785, -0.45811], "index": 486, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.09934, "mixamorig:LeftUpLeg": 0.90066}}, {"xyz": [1.10492, 2.15673, -0.02704], "index": 487, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08241, "mixamorig:LeftUpLeg": 0.91759}}, {"xyz": [0.82492, 2.14793, 0.40738], "index": 488, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.06991, "mixamorig:LeftUpLeg": 0.93009}}, {"xyz": [0.38062, 2.12376, 0.44059], "index": 489, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.14016, "mixamorig:RightUpLeg": 0.08376, "mixamorig:LeftUpLeg": 0.77609}}, {"xyz": [0.19928, 2.0904, 0.19568], "index": 490, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.10463, "mixamorig:RightUpLeg": 0.22955, "mixamorig:LeftUpLeg": 0.66582}}, {"xyz": [0.50039, 1.97834, -0.43016], "index": 491, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.03924, "mixamorig:RightUpLeg": 0.04515, "mixamorig:LeftLeg": 0.00032, "mixamorig:LeftUpLeg": 0.9153}}, {"xyz": [0.83824, 1.96892, -0.39365], "index": 492, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.04696, "mixamorig:LeftLeg": 0.00098, "mixamorig:LeftUpLeg": 0.95205}}, {"xyz": [1.03587, 1.94282, -0.03106], "index": 493, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.04421, "mixamorig:LeftLeg": 0.00178, "mixamorig:LeftUpLeg": 0.95402}}, {"xyz": [0.7934, 1.9352, 0.38538], "index": 494, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.04377, "mixamorig:LeftLeg": 0.0028, "mixamorig:LeftUpLeg": 0.95343}}, {"xyz": [0.40866, 1.91427, 0.37389], "index": 495, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.06126, "mixamorig:RightUpLeg": 0.06271, "mixamorig:LeftLeg": 0.00321, "mixamorig:LeftUpLeg": 0.87283}}, {"xyz": [0.16253, 2.13825, -0.32713], "index": 496, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.05119, "mixamorig:RightUpLeg": 0.25009, "mixamorig:LeftUpLeg": 0.69872}}, {"xyz": [0.25162, 1.88538, 0.1618], "index": 497, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0503, "mixamorig:RightUpLeg": 0.12601, "mixamorig:LeftLeg": 0.00458, "mixamorig:LeftUpLeg": 0.81911}}, {"xyz": [0.26213, 1.92682, -0.22941], "index": 498, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.03299, "mixamorig:RightUpLeg": 0.13329, "mixamorig:LeftLeg": 0.00118, "mixamorig:LeftUpLeg": 0.83254}}, {"xyz": [0.53291, 1.63255, -0.32912], "index": 499, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.01453, "mixamorig:RightUpLeg": 0.02383, "mixamorig:LeftLeg": 0.03724, "mixamorig:LeftUpLeg": 0.92439}}, {"xyz": [0.83001, 1.62403, -0.31164], "index": 500, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.01729, "mixamorig:LeftLeg": 0.03836, "mixamorig:LeftUpLeg": 0.94434}}, {"xyz": [0.99465, 1.60043, 0.01608], "index": 501, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.01658, "mixamorig:LeftLeg": 0.04087, "mixamorig:LeftUpLeg": 0.94255}}, {"xyz": [0.79266, 1.56682, 0.38482], "index": 502, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.01518, "mixamorig:LeftLeg": 0.05428, "mixamorig:LeftUpLeg": 0.93055}}, {"xyz": [0.47216, 1.57463, 0.38207], "index": 503, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.01756, "mixamorig:RightUpLeg": 0.01434, "mixamorig:LeftLeg": 0.05615, "mixamorig:LeftUpLeg": 0.91195}}, {"xyz": [0.29712, 1.59421, 0.01359], "index": 504, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.015, "mixamorig:RightUpLeg": 0.05402, "mixamorig:LeftLeg": 0.051, "mixamorig:LeftUpLeg": 0.87998}}, {"xyz": [0.53847, 1.48336, -0.30459], "index": 505, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.0047, "mixamorig:RightUpLeg": 0.00942, "mixamorig:LeftFoot": 0.00043, "mixamorig:LeftLeg": 0.15347, "mixamorig:LeftUpLeg": 0.83199}}, {"xyz": [0.82186, 1.47524, -0.28792], "index": 506, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00571, "mixamorig:LeftFoot": 0.00035, "mixamorig:LeftLeg": 0.14924, "mixamorig:LeftUpLeg": 0.84469}}, {"xyz": [0.97889, 1.45274, 0.02467], "index": 507, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00525, "mixamorig:LeftFoot": 0.00061, "mixamorig:LeftLeg": 0.14077, "mixamorig:LeftUpLeg": 0.85337}}, {"xyz": [0.78623, 1.42067, 0.37641], "index": 508, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.00452, "mixamorig:LeftFoot": 0.00084, "mixamorig:LeftLeg": 0.15603, "mixamorig:LeftUpLeg": 0.83862}}, {"xyz": [0.48051, 1.42812, 0.37378], "index": 509, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00505, "mixamorig:LeftFoot": 0.00066, "mixamorig:LeftLeg": 0.15857, "mixamorig:LeftUpLeg": 0.83572}}, {"xyz": [0.31355, 1.4468, 0.02231], "index": 510, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00377, "mixamorig:LeftToeBase": 2e-05, "mixamorig:RightUpLeg": 0.03104, "mixamorig:LeftUpLeg": 0.78621, "mixamorig:LeftFoot": 0.00078, "mixamorig:LeftLeg": 0.17817}}, {"xyz": [0.49472, 1.30706, -0.34664], "index": 511, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00163, "mixamorig:LeftFoot": 0.00438, "mixamorig:LeftLeg": 0.55779, "mixamorig:LeftUpLeg": 0.4362}}, {"xyz": [0.81036, 1.29948, -0.33111], "index": 512, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00165, "mixamorig:LeftFoot": 0.00308, "mixamorig:LeftLeg": 0.52191, "mixamorig:LeftUpLeg": 0.47336}}, {"xyz": [0.9567, 1.27851, -0.03982], "index": 513, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00162, "mixamorig:LeftFoot": 0.00429, "mixamorig:LeftLeg": 0.474, "mixamorig:LeftUpLeg": 0.52009}}, {"xyz": [0.77717, 1.24864, 0.28794], "index": 514, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00152, "mixamorig:LeftFoot": 0.01064, "mixamorig:LeftLeg": 0.48165, "mixamorig:LeftUpLeg": 0.50619}}, {"xyz": [0.49229, 1.25558, 0.28549], "index": 515, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00146, "mixamorig:LeftFoot": 0.01415, "mixamorig:LeftLeg": 0.48855, "mixamorig:LeftUpLeg": 0.49584}}, {"xyz": [0.33671, 1.27298, -0.04202], "index": 516, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:RightUpLeg": 0.00261, "mixamorig:LeftToeBase": 0.00162, "mixamorig:LeftFoot": 0.01024, "mixamorig:LeftLeg": 0.52488, "mixamorig:LeftUpLeg": 0.46065}}, {"xyz": [0.50666, 0.9411, -0.389], "index": 517, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00792, "mixamorig:LeftFoot": 0.02787, "mixamorig:LeftLeg": 0.89122, "mixamorig:LeftUpLeg": 0.07299}}, {"xyz": [0.79282, 0.93353, -0.37346], "index": 518, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.00841, "mixamorig:LeftFoot": 0.02329, "mixamorig:LeftLeg": 0.88682, "mixamorig:LeftUpLeg": 0.08148}}, {"xyz": [0.92282, 0.91256, -0.08218], "index": 519, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.01022, "mixamorig:LeftFoot": 0.02709, "mixamorig:LeftLeg": 0.8785, "mixamorig:LeftUpLeg": 0.08419}}, {"xyz": [0.76333, 0.88269, 0.24558], "index": 520, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.01197, "mixamorig:LeftFoot": 0.06118, "mixamorig:LeftLeg": 0.83985, "mixamorig:LeftUpLeg": 0.087}}, {"xyz": [0.51026, 0.88963, 0.24313], "index": 521, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.01165, "mixamorig:LeftFoot": 0.07771, "mixamorig:LeftLeg": 0.82394, "mixamorig:LeftUpLeg": 0.08671}}, {"xyz": [0.37205, 0.90703, -0.08438], "index": 522, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.01007, "mixamorig:LeftFoot": 0.05529, "mixamorig:LeftLeg": 0.86816, "mixamorig:LeftUpLeg": 0.06648}}, {"xyz": [0.51423, 0.55973, -0.39364], "index": 523, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.018, "mixamorig:LeftFoot": 0.16488, "mixamorig:LeftLeg": 0.8083, "mixamorig:LeftUpLeg": 0.00882}}, {"xyz": [0.78469, 0.55614, -0.37771], "index": 524, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.02028, "mixamorig:LeftFoot": 0.12744, "mixamorig:LeftLeg": 0.8418, "mixamorig:LeftUpLeg": 0.01048}}, {"xyz": [0.90756, 0.60012, -0.10522], "index": 525, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:LeftToeBase": 0.03235, "mixamorig:LeftFoot": 0.10523, "mixamorig:LeftLeg": 0.84669, "mixamorig:LeftUpLeg": 0.01572}}, {"xyz": [0.75682, 0.64384, 0.20275], "index": 526, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.03907, "mixamorig:LeftFoot": 0.15948, "mixamorig:LeftLeg": 0.78173, "mixamorig:LeftUpLeg": 0.01973}}, {"xyz": [0.51763, 0.64969, 0.19899], "index": 527, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.03559, "mixamorig:LeftFoot": 0.18831, "mixamorig:LeftLeg": 0.75466, "mixamorig:LeftUpLeg": 0.02144}}, {"xyz": [0.38701, 0.59455, -0.10604], "index": 528, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.02881, "mixamorig:LeftFoot": 0.18871, "mixamorig:LeftLeg": 0.76986, "mixamorig:LeftUpLeg": 0.01263}}, {"xyz": [0.49909, 0.37974, -0.42416], "index": 529, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.01888, "mixamorig:LeftFoot": 0.38657, "mixamorig:LeftLeg": 0.59455}}, {"xyz": [0.80095, 0.37682, -0.40617], "index": 530, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.02502, "mixamorig:LeftFoot": 0.32495, "mixamorig:LeftLeg": 0.65003}}, {"xyz": [0.93808, 0.44425, -0.10558], "index": 531, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.05308, "mixamorig:LeftFoot": 0.19902, "mixamorig:LeftLeg": 0.74244, "mixamorig:LeftUpLeg": 0.00546}}, {"xyz": [0.76984, 0.51379, 0.23456], "index": 532, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.06854, "mixamorig:LeftFoot": 0.25448, "mixamorig:LeftLeg": 0.67395, "mixamorig:LeftUpLeg": 0.00303}}, {"xyz": [0.50288, 0.52006, 0.22998], "index": 533, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:LeftToeBase": 0.06134, "mixamorig:LeftFoot": 0.27844, "mixamorig:LeftLeg": 0.65134, "mixamorig:LeftUpLeg": 0.00887}}, {"xyz": [0.35709, 0.43799, -0.10612], "index": 534, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.04213, "mixamorig:LeftFoot": 0.31068, "mixamorig:LeftLeg": 0.64269, "mixamorig:LeftUpLeg": 0.0045}}, {"xyz": [0.54692, 0.40473, -0.29945], "index": 535, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.02464, "mixamorig:LeftFoot": 0.49683, "mixamorig:LeftLeg": 0.47853, "mixamorig:LeftUpLeg": 0.0}}, {"xyz": [0.73398, 0.40292, -0.2883], "index": 536, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.03545, "mixamorig:LeftFoot": 0.48501, "mixamorig:LeftLeg": 0.4795, "mixamorig:LeftUpLeg": 4e-05}}, {"xyz": [0.81895, 0.4447, -0.10203], "index": 537, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.077, "mixamorig:LeftFoot": 0.34092, "mixamorig:LeftLeg": 0.57997, "mixamorig:LeftUpLeg": 0.00211}}, {"xyz": [0.7147, 0.48779, 0.10875], "index": 538, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.12992, "mixamorig:LeftFoot": 0.39348, "mixamorig:LeftLeg": 0.47574, "mixamorig:LeftUpLeg": 0.00086}}, {"xyz": [0.54927, 0.49168, 0.10591], "index": 539, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.1009, "mixamorig:LeftFoot": 0.39837, "mixamorig:LeftLeg": 0.49795, "mixamorig:LeftUpLeg": 0.00278}}, {"xyz": [0.45893, 0.44082, -0.10236], "index": 540, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.05513, "mixamorig:LeftFoot": 0.41714, "mixamorig:LeftLeg": 0.52575, "mixamorig:LeftUpLeg": 0.00198}}, {"xyz": [0.53732, 0.24032, -0.30963], "index": 541, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.03438, "mixamorig:LeftFoot": 0.69369, "mixamorig:LeftLeg": 0.27194}}, {"xyz": [0.74429, 0.23915, -0.29719], "index": 542, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.04778, "mixamorig:LeftFoot": 0.68659, "mixamorig:LeftLeg": 0.26564}}, {"xyz": [0.8383, 0.2992, -0.09468], "index": 543, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.10982, "mixamorig:LeftFoot": 0.62548, "mixamorig:LeftLeg": 0.2647}}, {"xyz": [0.87024, 0.2377, 0.16084], "index": 544, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.31491, "mixamorig:LeftFoot": 0.60549, "mixamorig:LeftLeg": 0.0796}}, {"xyz": [0.65277, 0.36476, 0.1811], "index": 545, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.26713, "mixamorig:LeftFoot": 0.55655, "mixamorig:LeftLeg": 0.17633}}, {"xyz": [0.47538, 0.24426, 0.14717], "index": 546, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.2165, "mixamorig:LeftFoot": 0.64967, "mixamorig:LeftLeg": 0.13384}}, {"xyz": [0.43997, 0.29489, -0.09477], "index": 547, "uvs": [0.11719, 0.44531], "totalWeight": 0.99999, "skin": {"mixamorig:LeftToeBase": 0.07043, "mixamorig:LeftFoot": 0.6308, "mixamorig:LeftLeg": 0.29876}}, {"xyz": [0.53732, 0.00439, -0.29607], "index": 548, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.05007, "mixamorig:LeftFoot": 0.80031, "mixamorig:LeftLeg": 0.14962}}, {"xyz": [0.72476, -0.00039, -0.28697], "index": 549, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.06102, "mixamorig:LeftFoot": 0.80056, "mixamorig:LeftLeg": 0.13842}}, {"xyz": [0.84833, 0.00475, -0.04702], "index": 550, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.15574, "mixamorig:LeftFoot": 0.75345, "mixamorig:LeftLeg": 0.09081}}, {"xyz": [0.87468, 0.01765, 0.19177], "index": 551, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.36253, "mixamorig:LeftFoot": 0.59348, "mixamorig:LeftLeg": 0.04399}}, {"xyz": [0.46887, 0.00777, -0.08815], "index": 552, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.08692, "mixamorig:LeftFoot": 0.78928, "mixamorig:LeftLeg": 0.12381}}, {"xyz": [0.53873, 0.01983, 0.08796], "index": 553, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.20061, "mixamorig:LeftFoot": 0.71741, "mixamorig:LeftLeg": 0.08198}}, {"xyz": [0.87653, 0.16071, 0.46724], "index": 554, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.66317, "mixamorig:LeftFoot": 0.33167, "mixamorig:LeftLeg": 0.00516}}, {"xyz": [0.69602, 0.24458, 0.48749], "index": 555, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.62926, "mixamorig:LeftFoot": 0.3622, "mixamorig:LeftLeg": 0.00854}}, {"xyz": [0.87468, 0.0023, 0.49816], "index": 556, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.72658, "mixamorig:LeftFoot": 0.27342}}, {"xyz": [0.50385, 0.17233, 0.45356], "index": 557, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.55244, "mixamorig:LeftFoot": 0.43323, "mixamorig:LeftLeg": 0.01433}}, {"xyz": [0.47412, 0.0024, 0.44677], "index": 558, "uvs": [0.11719, 0.44531], "totalWeight": 1.00001, "skin": {"mixamorig:LeftToeBase": 0.55573, "mixamorig:LeftFoot": 0.43341, "mixamorig:LeftLeg": 0.01087}}, {"xyz": [0.85617, 0.12735, 0.68632], "index": 559, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.81249, "mixamorig:LeftFoot": 0.18751}}, {"xyz": [0.70355, 0.19493, 0.63134], "index": 560, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.76036, "mixamorig:LeftFoot": 0.23964}}, {"xyz": [0.84368, 0.00549, 0.67742], "index": 561, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.81336, "mixamorig:LeftFoot": 0.18664}}, {"xyz": [0.56913, 0.11563, 0.68349], "index": 562, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.76445, "mixamorig:LeftFoot": 0.23555}}, {"xyz": [0.5807, 0.00557, 0.67432], "index": 563, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.76953, "mixamorig:LeftFoot": 0.23047}}, {"xyz": [0.70352, 0.0825, 0.77666], "index": 564, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.80828, "mixamorig:LeftFoot": 0.19172}}, {"xyz": [0.70333, 0.0216, 0.77371], "index": 565, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.80944, "mixamorig:LeftFoot": 0.19056}}, {"xyz": [0.584, 0.006, 0.67699], "index": 566, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.7711, "mixamorig:LeftFoot": 0.2289}}, {"xyz": [-0.0, 5.3357, 1.11299], "index": 567, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.04291, "mixamorig:Neck": 0.25493, "mixamorig:Spine2": 0.70216}}, {"xyz": [-0.0, 2.70599, 1.15044], "index": 568, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.29022, 2.89097, 1.00556], "index": 569, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.16253, 2.13825, -0.32713], "index": 570, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0818, "mixamorig:RightUpLeg": 0.59302, "mixamorig:LeftUpLeg": 0.32518}}, {"xyz": [-0.68518, 6.96281, 1.06568], "index": 571, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.42089, 6.92616, 1.35601], "index": 572, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.20007, 6.78498, 1.82946], "index": 573, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.49199, 6.934, 1.52902], "index": 574, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.11338, 6.8829, 1.45099], "index": 575, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.26047, 6.77469, 1.93668], "index": 576, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.03096, 6.7481, 1.92444], "index": 577, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.22051, 6.94718, 1.73382], "index": 578, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.47538, 7.08466, 1.53409], "index": 579, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.271, 6.9146, 1.89121], "index": 580, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.02977, 6.93233, 1.87897], "index": 581, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.07704, 7.23882, 1.56778], "index": 582, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.20522, 7.20079, 1.55555], "index": 583, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.75546, 7.20463, 1.10355], "index": 584, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.13131, 7.45818, 1.05164], "index": 585, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.52835, 7.38913, 1.125], "index": 586, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.91109, 6.91568, 0.44924], "index": 587, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.54349, 6.09266, -0.23125], "index": 588, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.9114, "mixamorig:Neck": 0.0886}}, {"xyz": [-0.20215, 6.29291, -0.42082], "index": 589, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97246, "mixamorig:Neck": 0.02754}}, {"xyz": [-0.54585, 6.04999, -0.60436], "index": 590, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.80333, "mixamorig:Neck": 0.19667}}, {"xyz": [-0.20179, 6.11097, -0.71243], "index": 591, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.87052, "mixamorig:Neck": 0.12948}}, {"xyz": [-0.70911, 6.59166, 0.70011], "index": 592, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.85248, 6.6216, 0.07108], "index": 593, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.92852, 6.3777, 0.37052], "index": 594, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.7516, 6.04591, -0.02252], "index": 595, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.90935, "mixamorig:Neck": 0.09065}}, {"xyz": [-0.80086, 5.91215, -0.23275], "index": 596, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.63833, "mixamorig:Neck": 0.33048, "mixamorig:Spine2": 0.03119}}, {"xyz": [-0.85016, 7.19265, 0.33431], "index": 597, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.1744, 7.42243, 0.25234], "index": 598, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.619, 7.38192, 0.33561], "index": 599, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.71453, 6.85946, -0.13146], "index": 600, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.17717, 6.99165, -0.24108], "index": 601, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.31936, 7.04145, -0.21286], "index": 602, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.1287, 6.30985, -0.46456], "index": 603, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99227, "mixamorig:Neck": 0.00773}}, {"xyz": [0.1287, 6.12975, -0.74627], "index": 604, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.91327, "mixamorig:Neck": 0.08673}}, {"xyz": [0.53392, 6.88202, 1.62988], "index": 605, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.57867, 6.98685, 1.59879], "index": 606, "uvs": [0.85938, 0.85156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.50626, 7.1794, 1.37765], "index": 607, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.81678, 7.13671, 0.87326], "index": 608, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.54241, 7.37755, 0.85471], "index": 609, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.54546, 7.13981, 0.29111], "index": 610, "uvs": [0.82031, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.32152, 7.39039, 0.35769], "index": 611, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.88207, 6.8119, 0.8347], "index": 612, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.83771, 7.03358, 0.28317], "index": 613, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.82046, 6.60694, 0.72338], "index": 614, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.89123, 6.52025, 0.32462], "index": 615, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.71864, 6.22635, 0.30423], "index": 616, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97995, "mixamorig:Neck": 0.02005}}, {"xyz": [0.63042, 7.02348, 0.8216], "index": 617, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.65782, 6.44695, 0.81364], "index": 618, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.67444, 6.74701, 0.92303], "index": 619, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.79092, 6.12756, -0.11339], "index": 620, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.93659, "mixamorig:Neck": 0.06341}}, {"xyz": [0.70114, 6.03395, -0.0007], "index": 621, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.85655, "mixamorig:Neck": 0.14345}}, {"xyz": [0.79737, 6.06123, -0.34985], "index": 622, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.91877, "mixamorig:Neck": 0.08123}}, {"xyz": [0.70623, 5.94416, -0.25529], "index": 623, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.85781, "mixamorig:Neck": 0.14219}}, {"xyz": [0.46525, 6.25721, -0.29242], "index": 624, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99442, "mixamorig:Neck": 0.00558}}, {"xyz": [0.52915, 6.19393, -0.58471], "index": 625, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.94467, "mixamorig:Neck": 0.05533}}, {"xyz": [0.64242, 6.76123, -0.09342], "index": 626, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.04693, 7.2703, 0.08348], "index": 627, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.05034, 6.89687, 0.87976], "index": 628, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.09174, 6.50802, 0.52552], "index": 629, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.5759, 6.29641, 0.45302], "index": 630, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99882, "mixamorig:Neck": 0.00118}}, {"xyz": [-0.58296, 5.96462, 0.05999], "index": 631, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.72209, "mixamorig:Neck": 0.20726, "mixamorig:Spine2": 0.07065, "mixamorig:RightShoulder": 0.0}}, {"xyz": [-0.65835, 5.83086, -0.18438], "index": 632, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.01274, "mixamorig:Neck": 0.01061, "mixamorig:Spine2": 0.67418, "mixamorig:RightShoulder": 0.30247}}, {"xyz": [-0.1248, 6.17387, -0.00326], "index": 633, "uvs": [0.58871, 0.71364], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.95808, "mixamorig:Neck": 0.04192}}, {"xyz": [-0.25654, 5.96113, -0.38214], "index": 634, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.28724, "mixamorig:Neck": 0.24124, "mixamorig:Spine2": 0.47152}}, {"xyz": [0.20394, 6.19475, 0.05351], "index": 635, "uvs": [0.57615, 0.71377], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.60174, "mixamorig:Neck": 0.39395, "mixamorig:Spine2": 0.00431}}, {"xyz": [0.30422, 5.98755, -0.37612], "index": 636, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78993, "mixamorig:Neck": 0.21007}}, {"xyz": [-0.20179, 6.11097, -0.71243], "index": 637, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.87052, "mixamorig:Neck": 0.12948}}, {"xyz": [0.21804, 6.87425, 1.33419], "index": 638, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.42687, 5.94522, 1.27436], "index": 639, "uvs": [0.52809, 0.65297], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.79024, "mixamorig:Neck": 0.20976}}, {"xyz": [-0.48096, 5.59129, 1.0983], "index": 640, "uvs": [0.59375, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.06572, "mixamorig:Neck": 0.11948, "mixamorig:Spine2": 0.81027, "mixamorig:RightShoulder": 0.00452}}, {"xyz": [-0.6628, 5.88531, 0.88485], "index": 641, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.72965, "mixamorig:Neck": 0.11667, "mixamorig:Spine2": 0.15368}}, {"xyz": [-0.17365, 6.2471, 1.37861], "index": 642, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.93717, "mixamorig:Neck": 0.06283}}, {"xyz": [-0.48773, 6.40138, 1.21657], "index": 643, "uvs": [0.52474, 0.65434], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99757, "mixamorig:Neck": 0.00243}}, {"xyz": [-0.65426, 6.24125, 0.92438], "index": 644, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.9801, "mixamorig:Neck": 0.0199}}, {"xyz": [-0.36744, 6.95668, 1.25834], "index": 645, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.28717, 6.61202, 1.29546], "index": 646, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.10912, 6.59109, 1.40481], "index": 647, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.2804, 6.66357, 1.36411], "index": 648, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.4271, 6.65569, 1.27186], "index": 649, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.15379, 6.81145, 1.43744], "index": 650, "uvs": [0.52356, 0.65762], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.10912, 6.59109, 1.40481], "index": 651, "uvs": [0.52525, 0.65704], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.09036, 5.93708, 1.56016], "index": 652, "uvs": [0.52974, 0.65471], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.77595, "mixamorig:Neck": 0.22405}}, {"xyz": [-0.17365, 6.2471, 1.37861], "index": 653, "uvs": [0.52726, 0.65542], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.93717, "mixamorig:Neck": 0.06283}}, {"xyz": [-0.64441, 6.50536, 0.90899], "index": 654, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.68627, 6.65052, 0.53467], "index": 655, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.65426, 6.24125, 0.92438], "index": 656, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.9801, "mixamorig:Neck": 0.0199}}, {"xyz": [-0.64441, 6.50536, 0.90899], "index": 657, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.52812, 6.77562, 1.08471], "index": 658, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.68627, 6.65052, 0.53467], "index": 659, "uvs": [0.52861, 0.71191], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.4761, 7.1704, 0.84794], "index": 660, "uvs": [0.52412, 0.71273], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.50817, 6.70952, 0.0351], "index": 661, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.0, 7.32199, 0.81364], "index": 662, "uvs": [0.52085, 0.71078], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.45432, 5.29148, 0.6217], "index": 663, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.59675, 5.63759, 0.36317], "index": 664, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.53235, "mixamorig:RightShoulder": 0.46765}}, {"xyz": [-0.52437, 5.87472, 0.02262], "index": 665, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.23868, "mixamorig:Neck": 0.14337, "mixamorig:Spine2": 0.33991, "mixamorig:RightShoulder": 0.27804}}, {"xyz": [-0.2785, 5.1947, 0.72842], "index": 666, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.45432, 5.29148, 0.6217], "index": 667, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.54302, 5.1894, 0.56704], "index": 668, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.98808, "mixamorig:RightShoulder": 0.01192}}, {"xyz": [-0.30438, 4.64461, 0.76944], "index": 669, "uvs": [0.38281, 0.4375], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.23618, "mixamorig:Spine": 0.00058, "mixamorig:Spine2": 0.76324}}, {"xyz": [-0.19335, 4.94464, 0.84389], "index": 670, "uvs": [0.38281, 0.4375], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.17466, 4.81669, 0.74523], "index": 671, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.10146, "mixamorig:Spine2": 0.89854}}, {"xyz": [-0.30438, 4.64461, 0.76944], "index": 672, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.23618, "mixamorig:Spine": 0.00058, "mixamorig:Spine2": 0.76324}}, {"xyz": [-0.17466, 4.81669, 0.74523], "index": 673, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.10146, "mixamorig:Spine2": 0.89854}}, {"xyz": [-0.0914, 4.69804, 0.7856], "index": 674, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.23418, "mixamorig:Spine2": 0.76582}}, {"xyz": [-0.19335, 4.94464, 0.84389], "index": 675, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.18385, 4.23331, 0.93535], "index": 676, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.5571, "mixamorig:Spine": 0.13748, "mixamorig:Spine2": 0.30542}}, {"xyz": [-0.23024, 3.76987, 1.05197], "index": 677, "uvs": [0.35938, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.00028, "mixamorig:Spine1": 0.22176, "mixamorig:Spine": 0.77559, "mixamorig:Spine2": 0.00236}}, {"xyz": [-0.25347, 3.20097, 1.00995], "index": 678, "uvs": [0.35938, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.39726, 4.23331, 0.93535], "index": 679, "uvs": [0.36719, 0.89062], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.55831, "mixamorig:Spine": 0.14588, "mixamorig:Spine2": 0.2958}}, {"xyz": [-0.40929, 3.22439, 0.96665], "index": 680, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.40251, 3.73533, 1.03424], "index": 681, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00187, "mixamorig:Spine1": 0.19114, "mixamorig:Spine": 0.80535, "mixamorig:Spine2": 0.00164}}, {"xyz": [-0.45375, 3.15701, 1.06265], "index": 682, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.46386, 3.71771, 1.1282], "index": 683, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00916, "mixamorig:Spine1": 0.15934, "mixamorig:Spine": 0.83044, "mixamorig:Spine2": 0.00106}}, {"xyz": [-0.54302, 5.1894, 0.56704], "index": 684, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.98808, "mixamorig:RightShoulder": 0.01192}}, {"xyz": [-0.63412, 5.58585, 0.28044], "index": 685, "uvs": [0.16406, 0.375], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.00041, "mixamorig:Spine2": 0.44517, "mixamorig:RightShoulder": 0.55441}}, {"xyz": [-0.44434, 4.6704, 0.80379], "index": 686, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.1694, "mixamorig:Spine2": 0.8306}}, {"xyz": [-0.39726, 4.23331, 0.93535], "index": 687, "uvs": [0.16406, 0.375], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.55831, "mixamorig:Spine": 0.14588, "mixamorig:Spine2": 0.2958}}, {"xyz": [-0.4743, 4.6704, 0.86842], "index": 688, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19281, "mixamorig:Spine": 0.00075, "mixamorig:Spine2": 0.80644}}, {"xyz": [-0.56534, 5.20098, 0.63069], "index": 689, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 0.98084, "mixamorig:RightShoulder": 0.01916}}, {"xyz": [-0.42722, 4.23331, 0.99998], "index": 690, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.55831, "mixamorig:Spine": 0.16544, "mixamorig:Spine2": 0.27624}}, {"xyz": [-0.46386, 3.71771, 1.1282], "index": 691, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00916, "mixamorig:Spine1": 0.15934, "mixamorig:Spine": 0.83044, "mixamorig:Spine2": 0.00106}}, {"xyz": [-0.58505, 4.2145, 0.92974], "index": 692, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.54872, "mixamorig:Spine": 0.18312, "mixamorig:Spine2": 0.26815}}, {"xyz": [-0.70783, 5.62016, 0.32287], "index": 693, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00367, "mixamorig:Spine2": 0.39624, "mixamorig:RightShoulder": 0.60009}}, {"xyz": [-0.59085, 5.82815, -0.04515], "index": 694, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.31196, "mixamorig:RightArm": 0.0, "mixamorig:Neck": 0.18738, "mixamorig:Spine2": 0.33426, "mixamorig:RightShoulder": 0.1664}}, {"xyz": [-0.63763, 5.87011, -0.05948], "index": 695, "uvs": [0.10938, 0.91406], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.49071, "mixamorig:Neck": 0.28766, "mixamorig:Spine2": 0.22162, "mixamorig:RightShoulder": 0.0}}, {"xyz": [-0.0, 5.98425, -0.51249], "index": 696, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.63616, "mixamorig:Neck": 0.27782, "mixamorig:Spine2": 0.08602}}, {"xyz": [-0.3644, 5.98106, -0.37115], "index": 697, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.3644, 5.98106, -0.37115], "index": 698, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.32282, 5.94902, -0.34934], "index": 699, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.32282, 5.94902, -0.34934], "index": 700, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.32344, "mixamorig:Neck": 0.27611, "mixamorig:Spine2": 0.40045}}, {"xyz": [-0.24831, 5.98646, -0.27864], "index": 701, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.28724, "mixamorig:Neck": 0.24124, "mixamorig:Spine2": 0.47152}}, {"xyz": [-0.52437, 5.87472, 0.02262], "index": 702, "uvs": [0.53307, 0.70818], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.23868, "mixamorig:Neck": 0.14337, "mixamorig:Spine2": 0.33991, "mixamorig:RightShoulder": 0.27804}}, {"xyz": [-0.53826, 6.20538, -0.02892], "index": 703, "uvs": [0.53091, 0.70948], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.95105, "mixamorig:Neck": 0.04895}}, {"xyz": [-0.72234, 4.61206, 0.7866], "index": 704, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19331, "mixamorig:Spine": 0.00127, "mixamorig:Spine2": 0.80542}}, {"xyz": [-0.96279, 5.08089, 0.52566], "index": 705, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01485, "mixamorig:Spine2": 0.46413, "mixamorig:RightShoulder": 0.52102}}, {"xyz": [-0.98664, 4.60577, 0.46003], "index": 706, "uvs": [0.10156, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.20015, "mixamorig:RightArm": 0.00469, "mixamorig:Spine2": 0.36736, "mixamorig:RightShoulder": 0.42779}}, {"xyz": [-0.72234, 4.61206, 0.7866], "index": 707, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19331, "mixamorig:Spine": 0.00127, "mixamorig:Spine2": 0.80542}}, {"xyz": [-0.72376, 5.40061, 0.47329], "index": 708, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00248, "mixamorig:Spine2": 0.6215, "mixamorig:RightShoulder": 0.37602}}, {"xyz": [-0.95715, 5.4222, 0.38449], "index": 709, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.03432, "mixamorig:Spine2": 0.32623, "mixamorig:RightShoulder": 0.63945}}, {"xyz": [-0.0, 5.8172, -0.66758], "index": 710, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:Head": 0.07713, "mixamorig:Neck": 0.07414, "mixamorig:Spine2": 0.84874}}, {"xyz": [-0.42994, 5.87178, -0.47118], "index": 711, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Head": 0.17228, "mixamorig:Neck": 0.19294, "mixamorig:Spine2": 0.63477}}, {"xyz": [-0.71299, 5.8038, -0.12838], "index": 712, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.02222, "mixamorig:Spine2": 0.41891, "mixamorig:RightShoulder": 0.55886}}, {"xyz": [-0.71299, 5.8038, -0.12838], "index": 713, "uvs": [0.10156, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:RightArm": 0.02222, "mixamorig:Spine2": 0.41891, "mixamorig:RightShoulder": 0.55886}}, {"xyz": [-1.21391, 5.69142, 0.00917], "index": 714, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.22995, "mixamorig:Spine2": 0.06873, "mixamorig:RightShoulder": 0.70132}}, {"xyz": [-1.3967, 5.67454, 0.00212], "index": 715, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.44848, "mixamorig:Spine2": 0.01196, "mixamorig:RightShoulder": 0.53956}}, {"xyz": [-1.25724, 4.53348, -0.12468], "index": 716, "uvs": [0.10156, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.02264, "mixamorig:RightArm": 0.07244, "mixamorig:Spine2": 0.02635, "mixamorig:RightShoulder": 0.87856}}, {"xyz": [-1.3792, 4.79581, -0.0924], "index": 717, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.41309, "mixamorig:Spine2": 0.00799, "mixamorig:RightShoulder": 0.57892}}, {"xyz": [-1.71091, 5.68157, -0.05739], "index": 718, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.76805, "mixamorig:RightForeArm": 0.00011, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.23184}}, {"xyz": [-1.75882, 4.92076, -0.1312], "index": 719, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.8897, "mixamorig:RightForeArm": 0.00229, "mixamorig:Spine2": 1e-05, "mixamorig:RightShoulder": 0.108}}, {"xyz": [-2.26712, 5.62936, -0.15187], "index": 720, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.92119, "mixamorig:RightForeArm": 0.03053, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.04828}}, {"xyz": [-2.28291, 4.99836, -0.12894], "index": 721, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.87541, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.10868, "mixamorig:Spine2": 0.0, "mixamorig:RightShoulder": 0.01591}}, {"xyz": [-2.47473, 5.60895, -0.15083], "index": 722, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.64268, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.35732}}, {"xyz": [-2.48952, 5.01797, -0.12936], "index": 723, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.48228, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.51772}}, {"xyz": [-2.68843, 5.60045, -0.1504], "index": 724, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.29036, "mixamorig:RightHand": 0.0, "mixamorig:RightForeArm": 0.70964}}, {"xyz": [-2.7028, 5.02613, -0.12953], "index": 725, "uvs": [0.10156, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.17179, "mixamorig:RightHandIndex1": 3e-05, "mixamorig:RightHand": 1e-05, "mixamorig:RightForeArm": 0.82818}}, {"xyz": [-3.1922, 5.60045, -0.09322], "index": 726, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.04691, "mixamorig:RightHandIndex1": 0.00427, "mixamorig:RightHand": 9e-05, "mixamorig:RightForeArm": 0.94873}}, {"xyz": [-3.20657, 5.02613, -0.07235], "index": 727, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01663, "mixamorig:RightHandIndex1": 0.00244, "mixamorig:RightHand": 0.00098, "mixamorig:RightForeArm": 0.97995}}, {"xyz": [-3.58864, 5.60045, -0.04926], "index": 728, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.0127, "mixamorig:RightHandIndex1": 0.0362, "mixamorig:RightHand": 0.01889, "mixamorig:RightForeArm": 0.93221}}, {"xyz": [-3.6048, 5.02613, -0.02357], "index": 729, "uvs": [0.10156, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00389, "mixamorig:RightHandIndex1": 0.01566, "mixamorig:RightHand": 0.0737, "mixamorig:RightForeArm": 0.90675}}, {"xyz": [-3.54369, 5.16428, 0.25445], "index": 730, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.0024, "mixamorig:RightHandIndex1": 0.00439, "mixamorig:RightHand": 0.06859, "mixamorig:RightForeArm": 0.92462}}, {"xyz": [-3.52964, 5.43332, 0.24517], "index": 731, "uvs": [0.125, 0.49219], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.00492, "mixamorig:RightHandIndex1": 0.00835, "mixamorig:RightHand": 0.03186, "mixamorig:RightForeArm": 0.95488}}, {"xyz": [-3.59527, 5.4435, -0.31734], "index": 732, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01879, "mixamorig:RightHandIndex1": 0.04902, "mixamorig:RightHand": 0.03004, "mixamorig:RightForeArm": 0.90215}}, {"xyz": [-3.59964, 5.17743, -0.30029], "index": 733, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.01253, "mixamorig:RightHandIndex1": 0.0373, "mixamorig:RightHand": 0.04015, "mixamorig:RightForeArm": 0.91002}}, {"xyz": [-3.58864, 5.60045, -0.04926], "index": 734, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.0127, "mixamorig:RightHandIndex1": 0.0362, "mixamorig:RightHand": 0.01889, "mixamorig:RightForeArm": 0.93221}}, {"xyz": [-3.6048, 5.02613, -0.02357], "index": 735, "uvs": [0.125, 0.49219], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00389, "mixamorig:RightHandIndex1": 0.01566, "mixamorig:RightHand": 0.0737, "mixamorig:RightForeArm": 0.90675}}, {"xyz": [-3.55127, 5.20537, 0.18925], "index": 736, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.04274, "mixamorig:RightArm": 0.00093, "mixamorig:RightHandIndex1": 0.00475, "mixamorig:RightHand": 0.17295, "mixamorig:RightForeArm": 0.77863}}, {"xyz": [-3.54041, 5.39722, 0.18209], "index": 737, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.03003, "mixamorig:RightArm": 0.00267, "mixamorig:RightHandIndex1": 0.00962, "mixamorig:RightHand": 0.09806, "mixamorig:RightForeArm": 0.85962}}, {"xyz": [-3.59109, 5.40448, -0.25231], "index": 738, "uvs": [0.34375, 0.875], "totalWeight": 1.00001, "skin": {"mixamorig:RightArm": 0.01406, "mixamorig:RightHandIndex1": 0.07099, "mixamorig:RightHand": 0.08646, "mixamorig:RightForeArm": 0.8285}}, {"xyz": [-3.59447, 5.21476, -0.23914], "index": 739, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00973, "mixamorig:RightHandIndex1": 0.05563, "mixamorig:RightHand": 0.10048, "mixamorig:RightForeArm": 0.83416}}, {"xyz": [-3.58598, 5.5164, -0.04528], "index": 740, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00864, "mixamorig:RightHandIndex1": 0.05904, "mixamorig:RightHand": 0.08941, "mixamorig:RightForeArm": 0.84291}}, {"xyz": [-3.59846, 5.10687, -0.02545], "index": 741, "uvs": [0.34375, 0.875], "totalWeight": 1.0, "skin": {"mixamorig:RightArm": 0.00267, "mixamorig:RightHandIndex1": 0.02457, "mixamorig:RightHand": 0.1979, "mixamorig:RightForeArm": 0.77486}}, {"xyz": [-3.72029, 5.1879, 0.27304], "index": 742, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0, "mixamorig:RightForeArm": 0.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.40566, "mixamorig:RightHandThumb1": 0.59434}}, {"xyz": [-3.70758, 5.41257, 0.26489], "index": 743, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0, "mixamorig:RightForeArm": 0.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.42809, "mixamorig:RightHandThumb1": 0.57191}}, {"xyz": [-3.76693, 5.42106, -0.22976], "index": 744, "uvs": [0.38281, 0.65625], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.03765, "mixamorig:RightForeArm": 0.16261, "mixamorig:RightHandIndex1": 0.16417, "mixamorig:RightHand": 0.63556}}, {"xyz": [-3.77088, 5.19889, -0.21476], "index": 745, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.02354, "mixamorig:RightForeArm": 0.19217, "mixamorig:RightHandIndex1": 0.1204, "mixamorig:RightHand": 0.66389}}, {"xyz": [-3.76093, 5.55212, 0.00598], "index": 746, "uvs": [0.38281, 0.65625], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.0401, "mixamorig:RightForeArm": 0.15127, "mixamorig:RightHandIndex1": 0.12147, "mixamorig:RightHand": 0.68715}}, {"xyz": [-3.77555, 5.07255, 0.02856], "index": 747, "uvs": [0.38281, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.00547, "mixamorig:RightForeArm": 0.15058, "mixamorig:RightHandIndex1": 0.03591, "mixamorig:RightHand": 0.80804}}, {"xyz": [-3.76301, 5.39422, -0.1704], "index": 748, "uvs": [0.55161, 0.72349], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.05704, "mixamorig:RightForeArm": 0.09057, "mixamorig:RightHandIndex1": 0.20421, "mixamorig:RightHand": 0.64817}}, {"xyz": [-3.76603, 5.22457, -0.15895], "index": 749, "uvs": [0.55277, 0.72287], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0369, "mixamorig:RightForeArm": 0.09632, "mixamorig:RightHandIndex1": 0.13905, "mixamorig:RightHand": 0.72773}}, {"xyz": [-3.75843, 5.4943, 0.00961], "index": 750, "uvs": [0.55092, 0.72383], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.05498, "mixamorig:RightForeArm": 0.09304, "mixamorig:RightHandIndex1": 0.16744, "mixamorig:RightHand": 0.68454}}, {"xyz": [-3.71769, 5.38773, 0.20731], "index": 751, "uvs": [0.51628, 0.63353], "totalWeight": 1.00001, "skin": {"mixamorig:RightHandIndex2": 0.00046, "mixamorig:RightForeArm": 0.01141, "mixamorig:RightHandIndex1": 0.00151, "mixamorig:RightHand": 0.36499, "mixamorig:RightHandThumb1": 0.62164}}, {"xyz": [-3.75843, 5.4943, 0.00961], "index": 752, "uvs": [0.51537, 0.63371], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.05498, "mixamorig:RightForeArm": 0.09304, "mixamorig:RightHandIndex1": 0.16744, "mixamorig:RightHand": 0.68454}}, {"xyz": [-4.02966, 5.55214, 0.0087], "index": 753, "uvs": [0.5137, 0.6325], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.17597, "mixamorig:RightHandIndex1": 0.7762, "mixamorig:RightHand": 0.04783}}, {"xyz": [-3.76603, 5.22457, -0.15895], "index": 754, "uvs": [0.51715, 0.63267], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.0369, "mixamorig:RightForeArm": 0.09632, "mixamorig:RightHandIndex1": 0.13905, "mixamorig:RightHand": 0.72773}}, {"xyz": [-3.76959, 5.221, 0.02685], "index": 755, "uvs": [0.51716, 0.63264], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.02117, "mixamorig:RightForeArm": 0.05537, "mixamorig:RightHandIndex1": 0.07747, "mixamorig:RightHand": 0.76624, "mixamorig:RightHandThumb1": 0.07975}}, {"xyz": [-4.03726, 5.28241, -0.18925], "index": 756, "uvs": [0.51548, 0.63146], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.21984, "mixamorig:RightForeArm": 0.00101, "mixamorig:RightHandIndex1": 0.63316, "mixamorig:RightHand": 0.14585, "mixamorig:RightHandIndex3": 0.00014}}, {"xyz": [-3.76959, 5.221, 0.02685], "index": 757, "uvs": [0.55281, 0.72288], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.02117, "mixamorig:RightForeArm": 0.05537, "mixamorig:RightHandIndex1": 0.07747, "mixamorig:RightHand": 0.76624, "mixamorig:RightHandThumb1": 0.07975}}, {"xyz": [-3.7274, 5.21618, 0.21353], "index": 758, "uvs": [0.55264, 0.72264], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.62541, "mixamorig:RightHand": 0.37459}}, {"xyz": [-4.04082, 5.34353, 0.02595], "index": 759, "uvs": [0.55327, 0.72476], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.28383, "mixamorig:RightHandIndex1": 0.68016, "mixamorig:RightHand": 0.03483, "mixamorig:RightHandIndex3": 0.00118}}, {"xyz": [-3.99862, 5.27402, 0.21263], "index": 760, "uvs": [0.51572, 0.63163], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 1.0, "mixamorig:RightHandIndex1": 0.0, "mixamorig:RightHand": 0.0, "mixamorig:RightHandIndex2": 0.0}}, {"xyz": [-4.14876, 5.51947, 0.00768], "index": 761, "uvs": [0.51336, 0.63175], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.29252, "mixamorig:RightHandIndex1": 0.69651, "mixamorig:RightHand": 0.01092, "mixamorig:RightHandIndex3": 5e-05}}, {"xyz": [-4.14252, 5.28883, -0.17555], "index": 762, "uvs": [0.51494, 0.63093], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.41072, "mixamorig:RightHandIndex1": 0.56675, "mixamorig:RightHand": 0.02158, "mixamorig:RightHandIndex3": 0.00095}}, {"xyz": [-4.08965, 5.29515, 0.22937], "index": 763, "uvs": [0.55382, 0.72484], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.34826, "mixamorig:RightHandIndex1": 0.64208, "mixamorig:RightHand": 0.00901, "mixamorig:RightHandIndex3": 0.00065}}, {"xyz": [-4.14108, 5.34911, 0.02643], "index": 764, "uvs": [0.5537, 0.72531], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.38168, "mixamorig:RightHandIndex1": 0.5958, "mixamorig:RightHand": 0.02016, "mixamorig:RightHandIndex3": 0.00236}}, {"xyz": [-4.40204, 5.36914, 0.06099], "index": 765, "uvs": [0.51317, 0.62985], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.49596, "mixamorig:RightHandIndex1": 0.00574, "mixamorig:RightHandIndex3": 0.4983}}, {"xyz": [-4.2505, 5.22907, -0.14975], "index": 766, "uvs": [0.51483, 0.63013], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.78621, "mixamorig:RightHandIndex1": 0.13898, "mixamorig:RightHandIndex3": 0.07481}}, {"xyz": [-4.23678, 5.26794, 0.27151], "index": 767, "uvs": [0.5547, 0.72552], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.85315, "mixamorig:RightHandIndex1": 0.10277, "mixamorig:RightHandIndex3": 0.04408}}, {"xyz": [-4.29813, 5.25082, 0.05502], "index": 768, "uvs": [0.55511, 0.72578], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandIndex2": 0.68211, "mixamorig:RightHandIndex1": 0.09867, "mixamorig:RightHandIndex3": 0.21921}}, {"xyz": [-4.47045, 5.23288, 0.08476], "index": 769, "uvs": [0.51377, 0.62899], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.01525, "mixamorig:RightHandIndex3": 0.98475}}, {"xyz": [-4.3252, 5.16684, -0.14119], "index": 770, "uvs": [0.5149, 0.62951], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.20502, "mixamorig:RightHandIndex3": 0.79498}}, {"xyz": [-4.34257, 5.19491, 0.26054], "index": 771, "uvs": [0.55569, 0.7258], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.12761, "mixamorig:RightHandIndex3": 0.87239}}, {"xyz": [-4.3995, 5.15893, 0.08544], "index": 772, "uvs": [0.5562, 0.72597], "totalWeight": 1.0, "skin": {"mixamorig:RightHandIndex2": 0.01775, "mixamorig:RightHandIndex3": 0.98225}}, {"xyz": [-3.7274, 5.21618, 0.21353], "index": 773, "uvs": [0.51739, 0.63285], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb1": 0.62541, "mixamorig:RightHand": 0.37459}}, {"xyz": [-3.84362, 5.21951, 0.30595], "index": 774, "uvs": [0.55317, 0.72326], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.54124, "mixamorig:RightHandThumb1": 0.41571, "mixamorig:RightHand": 0.04305}}, {"xyz": [-3.9956, 5.27736, 0.25915], "index": 775, "uvs": [0.5535, 0.72428], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.96076, "mixamorig:RightHandThumb1": 0.00302, "mixamorig:RightHandThumb3": 0.03622}}, {"xyz": [-3.97407, 5.4203, 0.25293], "index": 776, "uvs": [0.55243, 0.7247], "totalWeight": 0.99999, "skin": {"mixamorig:RightHandThumb2": 0.20506, "mixamorig:RightHandThumb1": 0.07427, "mixamorig:RightHandIndex1": 0.60199, "mixamorig:RightHand": 0.03147, "mixamorig:RightHandIndex2": 0.0872}}, {"xyz": [-4.07837, 5.18693, 0.32597], "index": 777, "uvs": [0.5545, 0.72438], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.02743, "mixamorig:RightHandThumb3": 0.97257}}, {"xyz": [-4.10129, 5.27465, 0.38086], "index": 778, "uvs": [0.55401, 0.72483], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.01069, "mixamorig:RightHandThumb3": 0.98931}}, {"xyz": [-3.96134, 5.16675, 0.36786], "index": 779, "uvs": [0.55408, 0.72369], "totalWeight": 1.0, "skin": {"mixamorig:RightHandThumb2": 0.43665, "mixamorig:RightHandThumb1": 0.00526, "mixamorig:RightHandThumb3": 0.55809}}, {"xyz": [-0.50628, 2.81325, 0.98911], "index": 780, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.86293, "mixamorig:RightUpLeg": 0.13707}}, {"xyz": [-0.41597, 3.09403, 0.96059], "index": 781, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.4685, 2.75312, 0.79538], "index": 782, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.85496, "mixamorig:RightUpLeg": 0.14504}}, {"xyz": [-0.41597, 3.09403, 0.96059], "index": 783, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.22362, 3.08667, 0.96152], "index": 784, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.25347, 3.20097, 1.00995], "index": 785, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.40929, 3.22439, 0.96665], "index": 786, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.64737, 2.12213, 0.78501], "index": 787, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.18414, "mixamorig:RightUpLeg": 0.81586}}, {"xyz": [-0.53112, 2.36288, 0.57285], "index": 788, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.31787, "mixamorig:RightUpLeg": 0.68213}}, {"xyz": [-0.0, 2.20011, -1.07202], "index": 789, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.33726, "mixamorig:RightUpLeg": 0.30665, "mixamorig:LeftUpLeg": 0.35609}}, {"xyz": [-0.55352, 2.1901, -0.986], "index": 790, "uvs": [0.16406, 0.375], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.27925, "mixamorig:RightUpLeg": 0.65952, "mixamorig:LeftUpLeg": 0.06124}}, {"xyz": [-1.16092, 2.22581, -0.65806], "index": 791, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.19044, "mixamorig:RightUpLeg": 0.80956}}, {"xyz": [-1.30318, 2.15673, -0.02393], "index": 792, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.11342, "mixamorig:RightUpLeg": 0.88658}}, {"xyz": [-1.11024, 2.11559, 0.48906], "index": 793, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.12215, "mixamorig:RightUpLeg": 0.87785}}, {"xyz": [-0.82492, 2.14793, 0.40738], "index": 794, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08043, "mixamorig:RightUpLeg": 0.91957}}, {"xyz": [-0.886, 2.3785, -0.45811], "index": 795, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.10834, "mixamorig:RightUpLeg": 0.89166}}, {"xyz": [-0.43939, 2.35604, -0.63038], "index": 796, "uvs": [0.125, 0.61719], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.15066, "mixamorig:RightUpLeg": 0.74636, "mixamorig:LeftUpLeg": 0.10299}}, {"xyz": [-1.10492, 2.15673, -0.02704], "index": 797, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.07482, "mixamorig:RightUpLeg": 0.92518}}, {"xyz": [-0.80095, 0.37682, -0.40617], "index": 798, "uvs": [0.10156, 0.42969], "totalWeight": 0.99999, "skin": {"mixamorig:RightToeBase": 0.02707, "mixamorig:RightLeg": 0.66142, "mixamorig:RightFoot": 0.3115}}, {"xyz": [-0.49909, 0.37974, -0.42416], "index": 799, "uvs": [0.10156, 0.42969], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.34558, "mixamorig:RightToeBase": 0.02558, "mixamorig:RightLeg": 0.62883, "mixamorig:RightUpLeg": 0.0}}, {"xyz": [-0.93808, 0.44425, -0.10558], "index": 800, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.16544, "mixamorig:RightToeBase": 0.05659, "mixamorig:RightLeg": 0.77146, "mixamorig:RightUpLeg": 0.00651}}, {"xyz": [-0.76984, 0.51379, 0.23456], "index": 801, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.22703, "mixamorig:RightToeBase": 0.07533, "mixamorig:RightLeg": 0.69414, "mixamorig:RightUpLeg": 0.0035}}, {"xyz": [-0.50288, 0.52006, 0.22998], "index": 802, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.26068, "mixamorig:RightToeBase": 0.06534, "mixamorig:RightLeg": 0.66206, "mixamorig:RightUpLeg": 0.01192}}, {"xyz": [-0.35709, 0.43799, -0.10612], "index": 803, "uvs": [0.10156, 0.42969], "totalWeight": 1.00001, "skin": {"mixamorig:RightFoot": 0.27629, "mixamorig:RightToeBase": 0.04835, "mixamorig:RightLeg": 0.67015, "mixamorig:RightUpLeg": 0.00522}}, {"xyz": [-0.73398, 0.40292, -0.2883], "index": 804, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.45449, "mixamorig:RightToeBase": 0.03892, "mixamorig:RightLeg": 0.50645, "mixamorig:RightUpLeg": 0.00014}}, {"xyz": [-0.54692, 0.40473, -0.29945], "index": 805, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightToeBase": 0.03495, "mixamorig:RightLeg": 0.49252, "mixamorig:RightFoot": 0.47253}}, {"xyz": [-0.81895, 0.4447, -0.10203], "index": 806, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.34023, "mixamorig:RightToeBase": 0.09027, "mixamorig:RightLeg": 0.56733, "mixamorig:RightUpLeg": 0.00217}}, {"xyz": [-0.7147, 0.48779, 0.10875], "index": 807, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:RightFoot": 0.36746, "mixamorig:RightToeBase": 0.13863, "mixamorig:RightLeg": 0.49238, "mixamorig:RightUpLeg": 0.00153}}, {"xyz": [-0.54927, 0.49168, 0.10591], "index": 808, "uvs": [0.11719, 0.44531], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.41845, "mixamorig:RightToeBase": 0.12125, "mixamorig:RightLeg": 0.45788, "mixamorig:RightUpLeg": 0.00241}}, {"xyz": [-0.45893, 0.44082, -0.10236], "index": 809, "uvs": [0.11719, 0.44531], "totalWeight": 0.99999, "skin": {"mixamorig:RightFoot": 0.3869, "mixamorig:RightToeBase": 0.06307, "mixamorig:RightLeg": 0.54767, "mixamorig:RightUpLeg": 0.00235}}, {"xyz": [-0.0, 5.94968, 1.5829], "index": 810, "uvs": [0.59375, 0.67969], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78602, "mixamorig:Neck": 0.21398}}, {"xyz": [-0.0, 6.03096, 1.5468], "index": 811, "uvs": [0.59375, 0.67969], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.83089, "mixamorig:Neck": 0.16911}}, {"xyz": [-0.09036, 5.93708, 1.56016], "index": 812, "uvs": [0.59375, 0.67969], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.77595, "mixamorig:Neck": 0.22405}}, {"xyz": [-0.0, 5.08022, 0.80591], "index": 813, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.0, 5.43197, 1.24481], "index": 814, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.14872, "mixamorig:Neck": 0.382, "mixamorig:Spine2": 0.46928}}, {"xyz": [-0.11193, 4.2979, 0.98345], "index": 815, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.54804, "mixamorig:Spine": 0.09117, "mixamorig:Spine2": 0.36079}}, {"xyz": [-0.06064, 4.75642, 0.79222], "index": 816, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19884, "mixamorig:Spine2": 0.80116}}, {"xyz": [-0.14658, 3.78689, 1.10896], "index": 817, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00058, "mixamorig:Spine1": 0.2359, "mixamorig:Spine": 0.76027, "mixamorig:Spine2": 0.00325}}, {"xyz": [-0.18877, 3.21799, 1.14581], "index": 818, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.20411, 2.89948, 1.14581], "index": 819, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.0, 5.95836, -0.45758], "index": 820, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.85469, "mixamorig:Neck": 0.14531}}, {"xyz": [-0.0, 5.97168, -0.33119], "index": 821, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.8502, "mixamorig:Neck": 0.1498}}, {"xyz": [0.09035, 5.93708, 1.56016], "index": 822, "uvs": [0.53059, 0.65567], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.77787, "mixamorig:Neck": 0.22213}}, {"xyz": [0.6628, 5.88531, 0.88485], "index": 823, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.8817, "mixamorig:Neck": 0.09895, "mixamorig:Spine2": 0.01935}}, {"xyz": [0.48096, 5.59129, 1.0983], "index": 824, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.09459, "mixamorig:Neck": 0.1736, "mixamorig:LeftShoulder": 0.0, "mixamorig:Spine2": 0.73181}}, {"xyz": [0.17365, 6.2471, 1.37861], "index": 825, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.94572, "mixamorig:Neck": 0.05428}}, {"xyz": [0.48773, 6.40138, 1.21657], "index": 826, "uvs": [0.52935, 0.65948], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99671, "mixamorig:Neck": 0.00329}}, {"xyz": [0.65426, 6.24125, 0.92438], "index": 827, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97773, "mixamorig:Neck": 0.02227}}, {"xyz": [0.10912, 6.59109, 1.40481], "index": 828, "uvs": [0.52628, 0.65819], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.28717, 6.61202, 1.29546], "index": 829, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.2804, 6.66357, 1.36411], "index": 830, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.10912, 6.59109, 1.40481], "index": 831, "uvs": [0.58594, 0.42188], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.4271, 6.65569, 1.27186], "index": 832, "uvs": [0.60938, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.68627, 6.65052, 0.53467], "index": 833, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.64441, 6.50536, 0.90899], "index": 834, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.4271, 6.65569, 1.27186], "index": 835, "uvs": [0.52735, 0.66011], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.68627, 6.65052, 0.53467], "index": 836, "uvs": [0.52212, 0.70467], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.4761, 7.1704, 0.84794], "index": 837, "uvs": [0.51962, 0.70771], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.50817, 6.70952, 0.0351], "index": 838, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.45432, 5.29148, 0.6217], "index": 839, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.00356, "mixamorig:Spine2": 0.99644}}, {"xyz": [0.59675, 5.63759, 0.36317], "index": 840, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.63131, "mixamorig:Spine2": 0.36869}}, {"xyz": [0.52437, 5.87472, 0.02262], "index": 841, "uvs": [0.375, 0.60156], "totalWeight": 1.00001, "skin": {"mixamorig:Head": 0.34748, "mixamorig:Neck": 0.61035, "mixamorig:Spine2": 0.04218}}, {"xyz": [0.2785, 5.1947, 0.72842], "index": 842, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.54301, 5.1894, 0.56704], "index": 843, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.01391, "mixamorig:Spine2": 0.98609}}, {"xyz": [0.45432, 5.29148, 0.6217], "index": 844, "uvs": [0.36719, 0.89062], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.00356, "mixamorig:Spine2": 0.99644}}, {"xyz": [0.30438, 4.64461, 0.76944], "index": 845, "uvs": [0.38281, 0.4375], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.25075, "mixamorig:Spine": 0.00104, "mixamorig:Spine2": 0.7482}}, {"xyz": [0.19335, 4.94464, 0.84389], "index": 846, "uvs": [0.38281, 0.4375], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.17466, 4.81669, 0.74523], "index": 847, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.0328, "mixamorig:Spine2": 0.9672}}, {"xyz": [0.30438, 4.64461, 0.76944], "index": 848, "uvs": [0.33594, 0.60938], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.25075, "mixamorig:Spine": 0.00104, "mixamorig:Spine2": 0.7482}}, {"xyz": [0.17466, 4.81669, 0.74523], "index": 849, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.0328, "mixamorig:Spine2": 0.9672}}, {"xyz": [0.19335, 4.94464, 0.84389], "index": 850, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [0.06064, 4.75642, 0.79222], "index": 851, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21123, "mixamorig:Spine2": 0.78877}}, {"xyz": [0.0914, 4.69804, 0.7856], "index": 852, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.25582, "mixamorig:Spine2": 0.74418}}, {"xyz": [0.11193, 4.2979, 0.98345], "index": 853, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.57624, "mixamorig:Spine": 0.07535, "mixamorig:Spine2": 0.34841}}, {"xyz": [0.14658, 3.78689, 1.10896], "index": 854, "uvs": [0.82031, 0.1875], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.00435, "mixamorig:Spine1": 0.25791, "mixamorig:Spine": 0.73491, "mixamorig:Spine2": 0.00284}}, {"xyz": [0.18877, 3.21799, 1.14581], "index": 855, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.2041, 2.89948, 1.14581], "index": 856, "uvs": [0.82031, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.18385, 4.23331, 0.93535], "index": 857, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.5901, "mixamorig:Spine": 0.13049, "mixamorig:Spine2": 0.27941}}, {"xyz": [0.23024, 3.76987, 1.05197], "index": 858, "uvs": [0.33594, 0.60938], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.00403, "mixamorig:Spine1": 0.24966, "mixamorig:Spine": 0.74387, "mixamorig:Spine2": 0.00245}}, {"xyz": [0.25347, 3.20097, 1.00995], "index": 859, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.39726, 4.23331, 0.93535], "index": 860, "uvs": [0.36719, 0.89062], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.56788, "mixamorig:Spine": 0.15778, "mixamorig:Spine2": 0.27433}}, {"xyz": [0.40929, 3.22439, 0.96665], "index": 861, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.40251, 3.73533, 1.03424], "index": 862, "uvs": [0.10156, 0.45312], "totalWeight": 1.00001, "skin": {"mixamorig:Hips": 0.01578, "mixamorig:Spine1": 0.20928, "mixamorig:Spine": 0.77357, "mixamorig:Spine2": 0.00138}}, {"xyz": [0.45375, 3.15701, 1.06265], "index": 863, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.46386, 3.71771, 1.1282], "index": 864, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00889, "mixamorig:Spine1": 0.19848, "mixamorig:Spine": 0.7916, "mixamorig:Spine2": 0.00103}}, {"xyz": [0.54301, 5.1894, 0.56704], "index": 865, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.01391, "mixamorig:Spine2": 0.98609}}, {"xyz": [0.63412, 5.58585, 0.28044], "index": 866, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.68917, "mixamorig:LeftArm": 0.00199, "mixamorig:Spine2": 0.30884}}, {"xyz": [0.44434, 4.6704, 0.80379], "index": 867, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.18698, "mixamorig:Spine2": 0.81302}}, {"xyz": [0.39726, 4.23331, 0.93535], "index": 868, "uvs": [0.10156, 0.45312], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.56788, "mixamorig:Spine": 0.15778, "mixamorig:Spine2": 0.27433}}, {"xyz": [0.4743, 4.6704, 0.86842], "index": 869, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21066, "mixamorig:Spine": 0.00131, "mixamorig:Spine2": 0.78803}}, {"xyz": [0.56534, 5.20098, 0.63069], "index": 870, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.04533, "mixamorig:Spine2": 0.95467}}, {"xyz": [0.42722, 4.23331, 0.99998], "index": 871, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.56417, "mixamorig:Spine": 0.19379, "mixamorig:Spine2": 0.24204}}, {"xyz": [0.46386, 3.71771, 1.1282], "index": 872, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.00889, "mixamorig:Spine1": 0.19848, "mixamorig:Spine": 0.7916, "mixamorig:Spine2": 0.00103}}, {"xyz": [0.58505, 4.2145, 0.92974], "index": 873, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.0, "mixamorig:Spine1": 0.54793, "mixamorig:Spine": 0.23453, "mixamorig:Spine2": 0.21754}}, {"xyz": [0.70783, 5.62016, 0.32287], "index": 874, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.67211, "mixamorig:LeftArm": 0.00371, "mixamorig:Spine2": 0.32418}}, {"xyz": [0.59085, 5.82815, -0.04515], "index": 875, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.36555, "mixamorig:Neck": 0.59581, "mixamorig:Spine2": 0.03864}}, {"xyz": [0.63763, 5.87011, -0.05948], "index": 876, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.40996, "mixamorig:Neck": 0.52836, "mixamorig:Spine2": 0.06168}}, {"xyz": [0.3644, 5.98106, -0.37115], "index": 877, "uvs": [0.10938, 0.91406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78271, "mixamorig:Neck": 0.21729}}, {"xyz": [0.59085, 5.82815, -0.04515], "index": 878, "uvs": [0.53369, 0.6579], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.36555, "mixamorig:Neck": 0.59581, "mixamorig:Spine2": 0.03864}}, {"xyz": [0.63763, 5.87011, -0.05948], "index": 879, "uvs": [0.53363, 0.6583], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.40996, "mixamorig:Neck": 0.52836, "mixamorig:Spine2": 0.06168}}, {"xyz": [0.32282, 5.94902, -0.34934], "index": 880, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78212, "mixamorig:Neck": 0.21788}}, {"xyz": [0.24831, 5.98646, -0.27864], "index": 881, "uvs": [0.375, 0.60156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78537, "mixamorig:Neck": 0.21463}}, {"xyz": [0.24831, 5.98646, -0.27864], "index": 882, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78537, "mixamorig:Neck": 0.21463}}, {"xyz": [0.2677, 6.37603, -0.23925], "index": 883, "uvs": [0.59375, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.53826, 6.20538, -0.02892], "index": 884, "uvs": [0.52582, 0.7038], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.98199, "mixamorig:Neck": 0.01801}}, {"xyz": [0.72234, 4.61206, 0.7866], "index": 885, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21015, "mixamorig:Spine": 0.00413, "mixamorig:LeftShoulder": 0.01995, "mixamorig:Spine2": 0.76577}}, {"xyz": [0.96279, 5.08089, 0.52566], "index": 886, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.44705, "mixamorig:LeftArm": 0.00857, "mixamorig:Spine2": 0.54438}}, {"xyz": [0.72376, 5.40061, 0.47329], "index": 887, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.40769, "mixamorig:LeftArm": 0.00149, "mixamorig:Spine2": 0.59082}}, {"xyz": [0.96279, 5.08089, 0.52566], "index": 888, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.44705, "mixamorig:LeftArm": 0.00857, "mixamorig:Spine2": 0.54438}}, {"xyz": [0.95715, 5.4222, 0.38449], "index": 889, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftShoulder": 0.65404, "mixamorig:LeftArm": 0.01779, "mixamorig:Spine2": 0.32818}}, {"xyz": [0.42994, 5.87178, -0.47118], "index": 890, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.27847, "mixamorig:Neck": 0.1804, "mixamorig:Spine2": 0.54113}}, {"xyz": [0.71299, 5.8038, -0.12838], "index": 891, "uvs": [0.15625, 0.64844], "totalWeight": 0.99999, "skin": {"mixamorig:LeftShoulder": 0.71874, "mixamorig:LeftArm": 0.02891, "mixamorig:Spine2": 0.25234}}, {"xyz": [1.25724, 4.53348, -0.12468], "index": 892, "uvs": [0.125, 0.65625], "totalWeight": 0.99999, "skin": {"mixamorig:Spine1": 0.01376, "mixamorig:LeftShoulder": 0.82581, "mixamorig:LeftArm": 0.0853, "mixamorig:Spine2": 0.07512}}, {"xyz": [1.2592, 3.81055, -0.03886], "index": 893, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.37179, "mixamorig:Spine": 0.62821}}, {"xyz": [1.26897, 2.97996, -0.03886], "index": 894, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.9044, "mixamorig:LeftUpLeg": 0.0956}}, {"xyz": [1.30318, 2.15673, -0.02393], "index": 895, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.13264, "mixamorig:LeftUpLeg": 0.86736}}, {"xyz": [1.27642, 5.35681, 0.31702], "index": 896, "uvs": [0.15625, 0.64844], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.69724, "mixamorig:LeftArm": 0.17971, "mixamorig:Spine2": 0.12305}}, {"xyz": [1.3792, 4.79581, -0.0924], "index": 897, "uvs": [0.125, 0.65625], "totalWeight": 1.0, "skin": {"mixamorig:LeftShoulder": 0.56602, "mixamorig:LeftArm": 0.42109, "mixamorig:Spine2": 0.01289}}, {"xyz": [1.23548, 5.01105, 0.32052], "index": 898, "uvs": [0.15625, 0.64844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftShoulder": 0.66611, "mixamorig:LeftArm": 0.1145, "mixamorig:Spine2": 0.2194}}, {"xyz": [3.54369, 5.16428, 0.25445], "index": 899, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.01864, "mixamorig:LeftForeArm": 0.97855, "mixamorig:LeftArm": 0.0019, "mixamorig:LeftHandIndex1": 0.00091}}, {"xyz": [3.52964, 5.43332, 0.24517], "index": 900, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.02199, "mixamorig:LeftForeArm": 0.97305, "mixamorig:LeftArm": 0.00244, "mixamorig:LeftHandIndex1": 0.00252}}, {"xyz": [3.59527, 5.4435, -0.31734], "index": 901, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.02113, "mixamorig:LeftForeArm": 0.95605, "mixamorig:LeftArm": 0.01159, "mixamorig:LeftHandIndex1": 0.01123}}, {"xyz": [3.59964, 5.17743, -0.30029], "index": 902, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.01788, "mixamorig:LeftForeArm": 0.96144, "mixamorig:LeftArm": 0.01234, "mixamorig:LeftHandIndex1": 0.00834}}, {"xyz": [3.58864, 5.60045, -0.04926], "index": 903, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.03561, "mixamorig:LeftForeArm": 0.94745, "mixamorig:LeftArm": 0.00647, "mixamorig:LeftHandIndex1": 0.01047}}, {"xyz": [3.6048, 5.02613, -0.02357], "index": 904, "uvs": [0.10938, 0.39062], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00926, "mixamorig:LeftForeArm": 0.98324, "mixamorig:LeftArm": 0.0045, "mixamorig:LeftHandIndex1": 0.003}}, {"xyz": [3.55127, 5.20537, 0.18925], "index": 905, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.06459, "mixamorig:LeftForeArm": 0.92675, "mixamorig:LeftArm": 0.00129, "mixamorig:LeftHandThumb1": 0.00624, "mixamorig:LeftHandIndex1": 0.00113}}, {"xyz": [3.54041, 5.39722, 0.18209], "index": 906, "uvs": [0.35938, 0.89844], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.07968, "mixamorig:LeftForeArm": 0.89024, "mixamorig:LeftArm": 0.00165, "mixamorig:LeftHandThumb1": 0.02511, "mixamorig:LeftHandIndex1": 0.00331}}, {"xyz": [3.59109, 5.40448, -0.25231], "index": 907, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.07765, "mixamorig:LeftForeArm": 0.89428, "mixamorig:LeftArm": 0.00932, "mixamorig:LeftHandIndex1": 0.01875}}, {"xyz": [3.59447, 5.21476, -0.23914], "index": 908, "uvs": [0.35938, 0.89844], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.05036, "mixamorig:LeftForeArm": 0.92739, "mixamorig:LeftArm": 0.00969, "mixamorig:LeftHandIndex1": 0.01257}}, {"xyz": [3.58598, 5.5164, -0.04528], "index": 909, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.13593, "mixamorig:LeftForeArm": 0.84127, "mixamorig:LeftArm": 0.00414, "mixamorig:LeftHandIndex1": 0.01866}}, {"xyz": [3.59846, 5.10687, -0.02545], "index": 910, "uvs": [0.35938, 0.89844], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.04569, "mixamorig:LeftForeArm": 0.94602, "mixamorig:LeftArm": 0.00356, "mixamorig:LeftHandIndex1": 0.00473}}, {"xyz": [3.72029, 5.1879, 0.27304], "index": 911, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.619, "mixamorig:LeftForeArm": 0.18847, "mixamorig:LeftHandIndex1": 0.00048, "mixamorig:LeftHandThumb1": 0.19205}}, {"xyz": [3.70758, 5.41257, 0.26489], "index": 912, "uvs": [0.32031, 0.57812], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.61712, "mixamorig:LeftForeArm": 0.11982, "mixamorig:LeftHandIndex1": 0.0019, "mixamorig:LeftHandThumb1": 0.26117}}, {"xyz": [3.76693, 5.42106, -0.22976], "index": 913, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.73172, "mixamorig:LeftForeArm": 0.21256, "mixamorig:LeftHandIndex1": 0.05572}}, {"xyz": [3.77088, 5.19889, -0.21476], "index": 914, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.67726, "mixamorig:LeftForeArm": 0.2936, "mixamorig:LeftHandIndex1": 0.02914}}, {"xyz": [3.76093, 5.55212, 0.00598], "index": 915, "uvs": [0.32031, 0.57812], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.8173, "mixamorig:LeftForeArm": 0.12969, "mixamorig:LeftHandIndex1": 0.05302}}, {"xyz": [3.77555, 5.07255, 0.02856], "index": 916, "uvs": [0.32031, 0.57812], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.73244, "mixamorig:LeftForeArm": 0.26083, "mixamorig:LeftHandIndex1": 0.00673}}, {"xyz": [3.76301, 5.39422, -0.1704], "index": 917, "uvs": [0.51603, 0.68377], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.79606, "mixamorig:LeftForeArm": 0.11801, "mixamorig:LeftHandIndex1": 0.08593}}, {"xyz": [3.76603, 5.22457, -0.15895], "index": 918, "uvs": [0.51715, 0.68313], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.78324, "mixamorig:LeftForeArm": 0.17407, "mixamorig:LeftHandIndex1": 0.04268}}, {"xyz": [3.75843, 5.4943, 0.00961], "index": 919, "uvs": [0.51537, 0.68417], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.82984, "mixamorig:LeftForeArm": 0.08316, "mixamorig:LeftHandIndex1": 0.07087, "mixamorig:LeftHandThumb1": 0.01613}}, {"xyz": [3.71769, 5.38773, 0.20731], "index": 920, "uvs": [0.55144, 0.67277], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.59919, "mixamorig:LeftForeArm": 0.05802, "mixamorig:LeftHandIndex1": 0.00171, "mixamorig:LeftHandThumb1": 0.34108}}, {"xyz": [4.02966, 5.55214, 0.0087], "index": 921, "uvs": [0.55181, 0.67502], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.0016, "mixamorig:LeftHandIndex1": 0.8754, "mixamorig:LeftHandIndex2": 0.12299}}, {"xyz": [3.75843, 5.4943, 0.00961], "index": 922, "uvs": [0.55092, 0.67338], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.82984, "mixamorig:LeftForeArm": 0.08316, "mixamorig:LeftHandIndex1": 0.07087, "mixamorig:LeftHandThumb1": 0.01613}}, {"xyz": [3.76603, 5.22457, -0.15895], "index": 923, "uvs": [0.55277, 0.67242], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.78324, "mixamorig:LeftForeArm": 0.17407, "mixamorig:LeftHandIndex1": 0.04268}}, {"xyz": [4.03726, 5.28241, -0.18925], "index": 924, "uvs": [0.55366, 0.67406], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.04792, "mixamorig:LeftHandIndex1": 0.74763, "mixamorig:LeftHandIndex2": 0.20445}}, {"xyz": [3.76959, 5.221, 0.02685], "index": 925, "uvs": [0.55281, 0.67242], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.82595, "mixamorig:LeftForeArm": 0.10635, "mixamorig:LeftHandIndex1": 0.02373, "mixamorig:LeftHandThumb1": 0.04396}}, {"xyz": [3.76959, 5.221, 0.02685], "index": 926, "uvs": [0.51716, 0.6831], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.82595, "mixamorig:LeftForeArm": 0.10635, "mixamorig:LeftHandIndex1": 0.02373, "mixamorig:LeftHandThumb1": 0.04396}}, {"xyz": [4.04082, 5.34353, 0.02595], "index": 927, "uvs": [0.51505, 0.68212], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00031, "mixamorig:LeftHandIndex3": 0.00134, "mixamorig:LeftHandIndex1": 0.74512, "mixamorig:LeftHandIndex2": 0.25323}}, {"xyz": [3.7274, 5.21618, 0.21353], "index": 928, "uvs": [0.51739, 0.6833], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.65164, "mixamorig:LeftForeArm": 0.0839, "mixamorig:LeftHandIndex1": 0.00043, "mixamorig:LeftHandThumb1": 0.26403}}, {"xyz": [3.99862, 5.27402, 0.21263], "index": 929, "uvs": [0.55353, 0.67383], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.00946, "mixamorig:LeftHandThumb2": 0.89561, "mixamorig:LeftHandThumb1": 0.09493}}, {"xyz": [4.14876, 5.51947, 0.00768], "index": 930, "uvs": [0.55259, 0.67553], "totalWeight": 0.99999, "skin": {"mixamorig:LeftHand": 0.0, "mixamorig:LeftHandIndex1": 0.76392, "mixamorig:LeftHandIndex2": 0.23607}}, {"xyz": [4.14252, 5.28883, -0.17555], "index": 931, "uvs": [0.55411, 0.67464], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHand": 0.001, "mixamorig:LeftHandIndex3": 0.00304, "mixamorig:LeftHandIndex1": 0.43481, "mixamorig:LeftHandIndex2": 0.56116}}, {"xyz": [4.14108, 5.34911, 0.02643], "index": 932, "uvs": [0.51454, 0.68161], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.00019, "mixamorig:LeftHandIndex3": 0.01044, "mixamorig:LeftHandIndex1": 0.56969, "mixamorig:LeftHandIndex2": 0.41968}}, {"xyz": [4.08965, 5.29515, 0.22937], "index": 933, "uvs": [0.51515, 0.68168], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandThumb3": 0.26099, "mixamorig:LeftHandThumb2": 0.72846, "mixamorig:LeftHandThumb1": 0.01056}}, {"xyz": [4.40204, 5.36914, 0.06099], "index": 934, "uvs": [0.5548, 0.67631], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.48852, "mixamorig:LeftHandIndex2": 0.51148}}, {"xyz": [4.2505, 5.22907, -0.14975], "index": 935, "uvs": [0.55503, 0.67499], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.07782, "mixamorig:LeftHandIndex1": 0.01108, "mixamorig:LeftHandIndex2": 0.9111}}, {"xyz": [4.29813, 5.25082, 0.05502], "index": 936, "uvs": [0.51446, 0.68042], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.35073, "mixamorig:LeftHandIndex1": 0.01137, "mixamorig:LeftHandIndex2": 0.6379}}, {"xyz": [4.23678, 5.26794, 0.27151], "index": 937, "uvs": [0.51464, 0.6808], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.18244, "mixamorig:LeftHandIndex1": 0.00871, "mixamorig:LeftHandIndex2": 0.80885}}, {"xyz": [4.47045, 5.23288, 0.08476], "index": 938, "uvs": [0.55604, 0.67617], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandIndex3": 0.9241, "mixamorig:LeftHandIndex2": 0.07591}}, {"xyz": [4.3252, 5.16684, -0.14119], "index": 939, "uvs": [0.5558, 0.67516], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.59237, "mixamorig:LeftHandIndex2": 0.40763}}, {"xyz": [4.3995, 5.15893, 0.08544], "index": 940, "uvs": [0.5146, 0.67954], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.91886, "mixamorig:LeftHandIndex2": 0.08114}}, {"xyz": [4.34257, 5.19491, 0.26054], "index": 941, "uvs": [0.51463, 0.67997], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandIndex3": 0.81428, "mixamorig:LeftHandIndex2": 0.18572}}, {"xyz": [3.7274, 5.21618, 0.21353], "index": 942, "uvs": [0.55264, 0.67218], "totalWeight": 1.0, "skin": {"mixamorig:LeftHand": 0.65164, "mixamorig:LeftForeArm": 0.0839, "mixamorig:LeftHandIndex1": 0.00043, "mixamorig:LeftHandThumb1": 0.26403}}, {"xyz": [3.9956, 5.27736, 0.25915], "index": 943, "uvs": [0.51571, 0.68211], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.17126, "mixamorig:LeftHandThumb2": 0.79102, "mixamorig:LeftHandThumb1": 0.03772}}, {"xyz": [3.84362, 5.21951, 0.30595], "index": 944, "uvs": [0.51682, 0.6827], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.00237, "mixamorig:LeftHandThumb2": 0.77022, "mixamorig:LeftHandThumb1": 0.22741}}, {"xyz": [3.97407, 5.4203, 0.25293], "index": 945, "uvs": [0.51485, 0.68276], "totalWeight": 1.00001, "skin": {"mixamorig:LeftHandThumb3": 0.00076, "mixamorig:LeftHandThumb2": 0.7536, "mixamorig:LeftHandThumb1": 0.24565}}, {"xyz": [4.10129, 5.27465, 0.38086], "index": 946, "uvs": [0.51523, 0.68154], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.96067, "mixamorig:LeftHandThumb2": 0.03933}}, {"xyz": [4.07837, 5.18693, 0.32597], "index": 947, "uvs": [0.51593, 0.68134], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.94424, "mixamorig:LeftHandThumb2": 0.05576}}, {"xyz": [3.96134, 5.16675, 0.36786], "index": 948, "uvs": [0.51662, 0.68188], "totalWeight": 1.0, "skin": {"mixamorig:LeftHandThumb3": 0.71466, "mixamorig:LeftHandThumb2": 0.28534, "mixamorig:LeftHandThumb1": 0.0}}, {"xyz": [0.50628, 2.81325, 0.98911], "index": 949, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.90863, "mixamorig:LeftUpLeg": 0.09137}}, {"xyz": [0.41597, 3.09403, 0.96059], "index": 950, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.4685, 2.75312, 0.79538], "index": 951, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.8349, "mixamorig:LeftUpLeg": 0.1651}}, {"xyz": [0.41597, 3.09403, 0.96059], "index": 952, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.22361, 3.08667, 0.96152], "index": 953, "uvs": [0.33594, 0.60938], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.64737, 2.12213, 0.78501], "index": 954, "uvs": [0.10156, 0.45312], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.14824, "mixamorig:LeftUpLeg": 0.85176}}, {"xyz": [0.53112, 2.36288, 0.57285], "index": 955, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.20696, "mixamorig:LeftUpLeg": 0.79304}}, {"xyz": [0.55352, 2.1901, -0.986], "index": 956, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.21639, "mixamorig:RightUpLeg": 0.04071, "mixamorig:LeftUpLeg": 0.7429}}, {"xyz": [1.16092, 2.22581, -0.65806], "index": 957, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.1911, "mixamorig:LeftUpLeg": 0.8089}}, {"xyz": [1.30318, 2.15673, -0.02393], "index": 958, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.13264, "mixamorig:LeftUpLeg": 0.86736}}, {"xyz": [1.11024, 2.11559, 0.48906], "index": 959, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.11898, "mixamorig:LeftUpLeg": 0.88102}}, {"xyz": [0.64737, 2.12213, 0.78501], "index": 960, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.14824, "mixamorig:LeftUpLeg": 0.85176}}, {"xyz": [0.53112, 2.36288, 0.57285], "index": 961, "uvs": [0.16406, 0.375], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.20696, "mixamorig:LeftUpLeg": 0.79304}}, {"xyz": [0.82492, 2.14793, 0.40738], "index": 962, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.06991, "mixamorig:LeftUpLeg": 0.93009}}, {"xyz": [0.886, 2.3785, -0.45811], "index": 963, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.09934, "mixamorig:LeftUpLeg": 0.90066}}, {"xyz": [0.43939, 2.35604, -0.63038], "index": 964, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08782, "mixamorig:RightUpLeg": 0.1013, "mixamorig:LeftUpLeg": 0.81088}}, {"xyz": [1.10492, 2.15673, -0.02704], "index": 965, "uvs": [0.125, 0.61719], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 0.08241, "mixamorig:LeftUpLeg": 0.91759}}, {"xyz": [0.80095, 0.37682, -0.40617], "index": 966, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.02502, "mixamorig:LeftFoot": 0.32495, "mixamorig:LeftLeg": 0.65003}}, {"xyz": [0.49909, 0.37974, -0.42416], "index": 967, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.01888, "mixamorig:LeftFoot": 0.38657, "mixamorig:LeftLeg": 0.59455}}, {"xyz": [0.93808, 0.44425, -0.10558], "index": 968, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.05308, "mixamorig:LeftFoot": 0.19902, "mixamorig:LeftLeg": 0.74244, "mixamorig:LeftUpLeg": 0.00546}}, {"xyz": [0.76984, 0.51379, 0.23456], "index": 969, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.06854, "mixamorig:LeftFoot": 0.25448, "mixamorig:LeftLeg": 0.67395, "mixamorig:LeftUpLeg": 0.00303}}, {"xyz": [0.50288, 0.52006, 0.22998], "index": 970, "uvs": [0.10156, 0.42969], "totalWeight": 0.99999, "skin": {"mixamorig:LeftToeBase": 0.06134, "mixamorig:LeftFoot": 0.27844, "mixamorig:LeftLeg": 0.65134, "mixamorig:LeftUpLeg": 0.00887}}, {"xyz": [0.35709, 0.43799, -0.10612], "index": 971, "uvs": [0.10156, 0.42969], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.04213, "mixamorig:LeftFoot": 0.31068, "mixamorig:LeftLeg": 0.64269, "mixamorig:LeftUpLeg": 0.0045}}, {"xyz": [0.73398, 0.40292, -0.2883], "index": 972, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.03545, "mixamorig:LeftFoot": 0.48501, "mixamorig:LeftLeg": 0.4795, "mixamorig:LeftUpLeg": 4e-05}}, {"xyz": [0.54692, 0.40473, -0.29945], "index": 973, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.02464, "mixamorig:LeftFoot": 0.49683, "mixamorig:LeftLeg": 0.47853, "mixamorig:LeftUpLeg": 0.0}}, {"xyz": [0.81895, 0.4447, -0.10203], "index": 974, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.077, "mixamorig:LeftFoot": 0.34092, "mixamorig:LeftLeg": 0.57997, "mixamorig:LeftUpLeg": 0.00211}}, {"xyz": [0.7147, 0.48779, 0.10875], "index": 975, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.12992, "mixamorig:LeftFoot": 0.39348, "mixamorig:LeftLeg": 0.47574, "mixamorig:LeftUpLeg": 0.00086}}, {"xyz": [0.54927, 0.49168, 0.10591], "index": 976, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.1009, "mixamorig:LeftFoot": 0.39837, "mixamorig:LeftLeg": 0.49795, "mixamorig:LeftUpLeg": 0.00278}}, {"xyz": [0.45893, 0.44082, -0.10236], "index": 977, "uvs": [0.11719, 0.44531], "totalWeight": 1.0, "skin": {"mixamorig:LeftToeBase": 0.05513, "mixamorig:LeftFoot": 0.41714, "mixamorig:LeftLeg": 0.52575, "mixamorig:LeftUpLeg": 0.00198}}, {"xyz": [-0.19335, 4.94464, 0.84389], "index": 978, "uvs": [0.58594, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.06064, 4.75642, 0.79222], "index": 979, "uvs": [0.58594, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.19884, "mixamorig:Spine2": 0.80116}}, {"xyz": [0.06064, 4.75642, 0.79222], "index": 980, "uvs": [0.58594, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine1": 0.21123, "mixamorig:Spine2": 0.78877}}, {"xyz": [0.19335, 4.94464, 0.84389], "index": 981, "uvs": [0.58594, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.25347, 3.20097, 1.00995], "index": 982, "uvs": [0.54738, 0.6437], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.22362, 3.08667, 0.96152], "index": 983, "uvs": [0.54829, 0.64344], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.22361, 3.08667, 0.96152], "index": 984, "uvs": [0.5504, 0.6458], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [0.25347, 3.20097, 1.00995], "index": 985, "uvs": [0.54977, 0.64638], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.0, 2.36021, -0.66227], "index": 986, "uvs": [0.125, 0.61719], "totalWeight": 0.99999, "skin": {"mixamorig:Hips": 0.14729, "mixamorig:RightUpLeg": 0.39681, "mixamorig:LeftUpLeg": 0.45589}}, {"xyz": [-0.0, 2.70599, 1.15044], "index": 987, "uvs": [0.61719, 0.14062], "totalWeight": 1.0, "skin": {"mixamorig:Hips": 1.0}}, {"xyz": [-0.0, 5.08022, 0.80591], "index": 988, "uvs": [0.58594, 0.1875], "totalWeight": 1.0, "skin": {"mixamorig:Spine2": 1.0}}, {"xyz": [-0.49199, 6.934, 1.52902], "index": 989, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.20007, 6.78498, 1.82946], "index": 990, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.03096, 6.7481, 1.92444], "index": 991, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.26047, 6.77469, 1.93668], "index": 992, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.68518, 6.96281, 1.06568], "index": 993, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.271, 6.9146, 1.89121], "index": 994, "uvs": [0.85938, 0.85156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.26047, 6.77469, 1.93668], "index": 995, "uvs": [0.85938, 0.85156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.53392, 6.88202, 1.62988], "index": 996, "uvs": [0.85938, 0.85156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.57867, 6.98685, 1.59879], "index": 997, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.81678, 7.13671, 0.87326], "index": 998, "uvs": [0.85938, 0.85156], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.81678, 7.13671, 0.87326], "index": 999, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.81678, 7.13671, 0.87326], "index": 1000, "uvs": [0.82031, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.63042, 7.02348, 0.8216], "index": 1001, "uvs": [0.82031, 0.625], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.54546, 7.13981, 0.29111], "index": 1002, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.63042, 7.02348, 0.8216], "index": 1003, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.89123, 6.52025, 0.32462], "index": 1004, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.82046, 6.60694, 0.72338], "index": 1005, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.88207, 6.8119, 0.8347], "index": 1006, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.79092, 6.12756, -0.11339], "index": 1007, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.93659, "mixamorig:Neck": 0.06341}}, {"xyz": [0.79737, 6.06123, -0.34985], "index": 1008, "uvs": [0.82031, 0.83594], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.91877, "mixamorig:Neck": 0.08123}}, {"xyz": [-0.70911, 6.59166, 0.70011], "index": 1009, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.92852, 6.3777, 0.37052], "index": 1010, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.7516, 6.04591, -0.02252], "index": 1011, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.90935, "mixamorig:Neck": 0.09065}}, {"xyz": [-0.80086, 5.91215, -0.23275], "index": 1012, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.63833, "mixamorig:Neck": 0.33048, "mixamorig:Spine2": 0.03119}}, {"xyz": [0.71864, 6.22635, 0.30423], "index": 1013, "uvs": [0.5565, 0.71401], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.97995, "mixamorig:Neck": 0.02005}}, {"xyz": [0.65782, 6.44695, 0.81364], "index": 1014, "uvs": [0.55817, 0.70729], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [-0.09174, 6.50802, 0.52552], "index": 1015, "uvs": [0.58648, 0.70376], "totalWeight": 1.0, "skin": {"mixamorig:Head": 1.0}}, {"xyz": [0.20394, 6.19475, 0.05351], "index": 1016, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.60174, "mixamorig:Neck": 0.39395, "mixamorig:Spine2": 0.00431}}, {"xyz": [-0.5759, 6.29641, 0.45302], "index": 1017, "uvs": [0.6055, 0.70896], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.99882, "mixamorig:Neck": 0.00118}}, {"xyz": [-0.58296, 5.96462, 0.05999], "index": 1018, "uvs": [0.60673, 0.71884], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.72209, "mixamorig:Neck": 0.20726, "mixamorig:Spine2": 0.07065, "mixamorig:RightShoulder": 0.0}}, {"xyz": [-0.1248, 6.17387, -0.00326], "index": 1019, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.95808, "mixamorig:Neck": 0.04192}}, {"xyz": [-0.25654, 5.96113, -0.38214], "index": 1020, "uvs": [0.59434, 0.71969], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.28724, "mixamorig:Neck": 0.24124, "mixamorig:Spine2": 0.47152}}, {"xyz": [0.30422, 5.98755, -0.37612], "index": 1021, "uvs": [0.57295, 0.72018], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.78993, "mixamorig:Neck": 0.21007}}, {"xyz": [0.1287, 6.12975, -0.74627], "index": 1022, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.91327, "mixamorig:Neck": 0.08673}}, {"xyz": [0.52915, 6.19393, -0.58471], "index": 1023, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.94467, "mixamorig:Neck": 0.05533}}, {"xyz": [-0.54585, 6.04999, -0.60436], "index": 1024, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.80333, "mixamorig:Neck": 0.19667}}, {"xyz": [-0.20179, 6.11097, -0.71243], "index": 1025, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.87052, "mixamorig:Neck": 0.12948}}, {"xyz": [-0.20179, 6.11097, -0.71243], "index": 1026, "uvs": [0.8125, 0.66406], "totalWeight": 1.0, "skin": {"mixamorig:Head": 0.87052, "mixamorig:Neck": 0.12948}}]}}`
-	ArmatureData      = `{"Armature": {"bones": {"mixamorig:Hips": {"matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, -0.0, -1.0, 2.99156, 0.0, 1.0, -0.0, 0.03882, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Hips", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.03882, 0.0, -1.0, -0.0, 2.99156, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandThumb4": {"parentName": "mixamorig:LeftHandThumb3", "matrix_local": [0.68058, 0.07885, -0.72841, 4.09133, 0.31957, -0.92658, 0.19829, 5.26683, -0.6593, -0.36773, -0.65582, 0.36469, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandThumb4", "matrix_local_inverted": [0.68058, 0.31957, -0.6593, -4.22716, 0.07885, -0.92658, -0.36773, 4.69168, -0.72841, 0.19829, -0.65582, 2.17501, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:Spine1": {"parentName": "mixamorig:Spine", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, 0.06699, -0.99775, 4.11312, 0.0, 0.99775, 0.06699, -0.03648, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Spine1", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, 0.06699, 0.99775, -0.23912, 0.0, -0.99775, 0.06699, 4.10633, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandIndex1": {"parentName": "mixamorig:RightHand", "matrix_local": [0.11493, -0.14464, 0.98279, -3.95475, -0.26222, -0.95867, -0.11043, 5.34987, 0.95814, -0.24501, -0.14811, 0.04457, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandIndex1", "matrix_local_inverted": [0.11493, -0.26222, 0.95814, 1.81465, -0.14464, -0.95867, -0.24501, 4.56766, 0.98279, -0.11043, -0.14811, 4.48405, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandThumb4": {"parentName": "mixamorig:RightHandThumb3", "matrix_local": [0.64704, 0.01904, 0.76222, -4.08411, -0.30289, -0.911, 0.27987, 5.24274, 0.69971, -0.41196, -0.58368, 0.36377, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandThumb4", "matrix_local_inverted": [0.64704, -0.30289, 0.69971, 3.97603, 0.01904, -0.911, -0.41196, 5.00376, 0.76222, 0.27987, -0.58368, 1.85802, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandIndex1": {"parentName": "mixamorig:LeftHand", "matrix_local": [0.14403, 0.13791, -0.97992, 3.95414, 0.26139, -0.96037, -0.09674, 5.35808, -0.95443, -0.2422, -0.17437, 0.04764, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandIndex1", "matrix_local_inverted": [0.14403, 0.26139, -0.95443, -1.92459, 0.13791, -0.96037, -0.2422, 4.61198, -0.97992, -0.09674, -0.17437, 4.40138, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightLeg": {"parentName": "mixamorig:RightUpLeg", "matrix_local": [-0.99996, 0.0016, -0.01369, -0.65177, -0.01378, -0.09379, 0.9955, 1.43809, 0.00026, 0.99561, 0.09381, 0.035, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightLeg", "matrix_local_inverted": [-0.99985, -0.01378, 0.00031, -0.63187, 0.00155, -0.09379, 0.99557, 0.10104, -0.01369, 0.9955, 0.0938, -1.44382, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftToeBase": {"parentName": "mixamorig:LeftFoot", "matrix_local": [-0.97258, 0.16111, -0.16777, 0.67251, 0.16218, 0.98673, 0.00739, 0.01485, 0.16675, -0.02003, -0.9858, 0.48222, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftToeBase", "matrix_local_inverted": [-0.97256, 0.16218, 0.16674, 0.57125, 0.16111, 0.98673, -0.02003, -0.11335, -0.16778, 0.00739, -0.98579, 0.58809, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:HeadTop_End": {"parentName": "mixamorig:Head", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, -0.0, -1.0, 7.42432, 0.0, 1.0, -0.0, 1.08469, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:HeadTop_End", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, -0.0, 1.0, -1.08469, 0.0, -1.0, -0.0, 7.42432, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftShoulder": {"parentName": "mixamorig:Spine2", "matrix_local": [-0.0142, -0.20215, -0.97925, 0.45626, -0.06714, -0.97695, 0.20265, 5.48354, -0.99764, 0.06862, 0.0003, -0.13509, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftShoulder", "matrix_local_inverted": [-0.0142, -0.06714, -0.99764, 0.23986, -0.20215, -0.97695, 0.06862, 5.45863, -0.97925, 0.20265, 0.0003, -0.66442, 0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightForeArm": {"parentName": "mixamorig:RightArm", "matrix_local": [0.10512, 0.00695, 0.99444, -2.41745, 0.06812, -0.99768, -0.00023, 5.30926, 0.99212, 0.06776, -0.10535, -0.13492, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightForeArm", "matrix_local_inverted": [0.10512, 0.06812, 0.99212, 0.02631, 0.00695, -0.99768, 0.06776, 5.32287, 0.99444, -0.00023, -0.10535, 2.39099, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandThumb2": {"parentName": "mixamorig:LeftHandThumb1", "matrix_local": [0.68059, 0.03037, -0.73204, 3.94168, 0.31957, -0.9114, 0.2593, 5.32911, -0.6593, -0.41041, -0.62999, 0.1964, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandThumb2", "matrix_local_inverted": [0.68059, 0.31957, -0.6593, -4.25618, 0.03037, -0.9114, -0.41041, 4.81783, -0.73204, 0.2593, -0.62999, 1.62738, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftLeg": {"parentName": "mixamorig:LeftUpLeg", "matrix_local": [-0.99991, -0.00193, 0.01368, 0.65177, 0.0138, -0.10147, 0.99474, 1.43809, -0.00052, 0.99484, 0.10149, 0.03499, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftLeg", "matrix_local_inverted": [-0.9999, 0.0138, -0.00053, 0.63187, -0.00192, -0.10147, 0.99483, 0.11237, 0.01368, 0.99474, 0.10149, -1.443, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:RightArm": {"parentName": "mixamorig:RightShoulder", "matrix_local": [0.00067, -0.01506, 0.99989, -1.37436, 0.07008, -0.99743, -0.01507, 5.29354, 0.99754, 0.07008, 0.00038, -0.13452, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightArm", "matrix_local_inverted": [0.00067, 0.07008, 0.99754, -0.23585, -0.01506, -0.99743, 0.07008, 5.26865, 0.99989, -0.01507, 0.00038, 1.45403, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandThumb3": {"parentName": "mixamorig:RightHandThumb2", "matrix_local": [0.64704, 0.01904, 0.76222, -4.00006, -0.30289, -0.911, 0.27987, 5.25837, 0.69971, -0.41196, -0.58368, 0.33308, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandThumb3", "matrix_local_inverted": [0.64704, -0.30289, 0.69971, 3.94785, 0.01904, -0.911, -0.41196, 5.00376, 0.76222, 0.27987, -0.58368, 1.77167, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightUpLeg": {"parentName": "mixamorig:Hips", "matrix_local": [-0.99928, -4e-05, 0.03931, -0.60193, 0.0393, 0.02253, 0.99897, 2.70444, -0.00099, 0.99976, -0.02251, 0.00647, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightUpLeg", "matrix_local_inverted": [-0.99918, 0.0393, -0.00093, -0.70771, -0.0001, 0.02253, 0.99973, -0.06746, 0.03931, 0.99897, -0.02251, -2.67786, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:LeftUpLeg": {"parentName": "mixamorig:Hips", "matrix_local": [-0.99923, -5e-05, -0.03929, 0.60193, -0.03927, 0.03795, 0.99851, 2.70444, 0.00145, 0.99929, -0.03792, -0.0131, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftUpLeg", "matrix_local_inverted": [-0.99922, -0.03927, 0.00144, 0.70768, -4e-05, 0.03795, 0.99927, -0.08952, -0.03929, 0.99851, -0.03793, -2.67725, 0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandIndex3": {"parentName": "mixamorig:LeftHandIndex2", "matrix_local": [0.14403, -0.37818, -0.91446, 4.31786, 0.26139, -0.87673, 0.40375, 5.28808, -0.95443, -0.29718, -0.02743, 0.07129, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandIndex3", "matrix_local_inverted": [0.14403, 0.26139, -0.95443, -1.93611, -0.37818, -0.87673, -0.29718, 6.29036, -0.91446, 0.40375, -0.02743, 1.8154, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftForeArm": {"parentName": "mixamorig:LeftArm", "matrix_local": [0.10762, -0.00716, -0.99417, 2.41745, -0.06843, -0.99766, -0.00022, 5.30926, -0.99183, 0.06805, -0.10785, -0.13469, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftForeArm", "matrix_local_inverted": [0.10762, -0.06843, -0.99183, -0.03044, -0.00716, -0.99766, 0.06805, 5.32329, -0.99417, -0.00022, -0.10785, 2.39001, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftToe_End": {"parentName": "mixamorig:LeftToeBase", "matrix_local": [-0.97258, 0.16111, -0.16777, 0.71671, 0.16218, 0.98673, 0.00739, 0.01291, 0.16675, -0.02003, -0.9858, 0.74192, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftToe_End", "matrix_local_inverted": [-0.97256, 0.16218, 0.16674, 0.57125, 0.16111, 0.98673, -0.02003, -0.11335, -0.16778, 0.00739, -0.98579, 0.85154, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:RightFoot": {"parentName": "mixamorig:RightLeg", "matrix_local": [-0.99765, -0.04361, 0.05373, -0.63714, -0.00723, 0.83794, 0.54571, 0.3741, -0.06888, 0.54402, -0.83626, -0.06526, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightFoot", "matrix_local_inverted": [-0.99755, -0.00723, -0.06882, -0.63736, -0.04363, 0.83794, 0.544, -0.30578, 0.05378, 0.54571, -0.83624, -0.22446, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandThumb2": {"parentName": "mixamorig:RightHandThumb1", "matrix_local": [0.64704, 0.00616, 0.76243, -3.92003, -0.30289, -0.9156, 0.26445, 5.29857, 0.69971, -0.40205, -0.59056, 0.24275, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandThumb2", "matrix_local_inverted": [0.64704, -0.30289, 0.69971, 3.97145, 0.00616, -0.9156, -0.40205, 4.97313, 0.76243, 0.26445, -0.59056, 1.73091, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandThumb1": {"parentName": "mixamorig:RightHand", "matrix_local": [0.64703, -0.18849, 0.73879, -3.80684, -0.30289, -0.95277, 0.02219, 5.30345, 0.69972, -0.23813, -0.67357, 0.13365, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandThumb1", "matrix_local_inverted": [0.64703, -0.30289, 0.69972, 3.97602, -0.18849, -0.95277, -0.23813, 4.36721, 0.73879, 0.02219, -0.67357, 2.78481, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:Neck": {"parentName": "mixamorig:Spine2", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, -0.0, -1.0, 5.57977, 0.0, 1.0, -0.0, -0.13495, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Neck", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, -0.0, 1.0, 0.13495, 0.0, -1.0, -0.0, 5.57977, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandThumb3": {"parentName": "mixamorig:LeftHandThumb2", "matrix_local": [0.68059, 0.07885, -0.72841, 3.98274, 0.31957, -0.92658, 0.19829, 5.27908, -0.6593, -0.36773, -0.65582, 0.31053, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandThumb3", "matrix_local_inverted": [0.68059, 0.31957, -0.6593, -4.19287, 0.07885, -0.92658, -0.36773, 4.69169, -0.72841, 0.19829, -0.65582, 2.05796, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightToe_End": {"parentName": "mixamorig:RightToeBase", "matrix_local": [-0.97219, -0.16166, 0.16975, -0.71671, -0.16276, 0.98664, 0.00746, 0.01292, -0.16873, -0.02039, -0.98547, 0.74186, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightToe_End", "matrix_local_inverted": [-0.97208, -0.16276, -0.16867, -0.56947, -0.16164, 0.98664, -0.02038, -0.11348, 0.16979, 0.00746, -0.98544, 0.85265, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandIndex2": {"parentName": "mixamorig:LeftHandIndex1", "matrix_local": [0.14403, -0.4522, -0.88021, 4.15049, 0.26139, -0.84051, 0.47458, 5.38567, -0.95443, -0.29843, -0.00286, 0.05004, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandIndex2", "matrix_local_inverted": [0.14403, 0.26139, -0.95443, -1.95779, -0.4522, -0.84051, -0.29843, 6.41849, -0.88021, 0.47458, -0.00286, 1.09754, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:Head": {"parentName": "mixamorig:Neck", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, -0.0, -1.0, 5.73901, 0.0, 1.0, -0.0, -0.02966, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Head", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, -0.0, 1.0, 0.02966, 0.0, -1.0, -0.0, 5.73901, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightToeBase": {"parentName": "mixamorig:RightFoot", "matrix_local": [-0.97219, -0.16167, 0.16975, -0.67251, -0.16277, 0.98664, 0.00746, 0.01486, -0.16873, -0.02039, -0.98547, 0.48526, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightToeBase", "matrix_local_inverted": [-0.97208, -0.16277, -0.16867, -0.56947, -0.16165, 0.98664, -0.02038, -0.11348, 0.16979, 0.00746, -0.98544, 0.59227, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightShoulder": {"parentName": "mixamorig:Spine2", "matrix_local": [-0.01358, 0.2022, 0.97925, -0.45626, 0.06713, -0.97695, 0.20265, 5.48354, 0.99765, 0.06849, -0.0003, -0.1348, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightShoulder", "matrix_local_inverted": [-0.01358, 0.06713, 0.99765, -0.23985, 0.2022, -0.97695, 0.06849, 5.45861, 0.97925, 0.20265, -0.0003, -0.6645, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:RightHand": {"parentName": "mixamorig:RightForeArm", "matrix_local": [0.11978, -0.18849, 0.97474, -3.66536, -0.26297, -0.95277, -0.15193, 5.30954, 0.95734, -0.23813, -0.16369, -0.00272, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHand", "matrix_local_inverted": [0.11978, -0.26297, 0.95734, 1.83793, -0.18849, -0.95277, -0.23813, 4.36721, 0.97474, -0.15193, -0.16369, 4.37899, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftFoot": {"parentName": "mixamorig:LeftLeg", "matrix_local": [-0.99768, 0.0425, -0.05338, 0.63714, 0.00679, 0.8403, 0.54209, 0.37411, 0.0679, 0.54046, -0.83863, -0.07356, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftFoot", "matrix_local_inverted": [-0.99766, 0.00679, 0.06789, 0.6381, 0.0425, 0.84029, 0.54046, -0.30168, -0.05339, 0.54209, -0.83862, -0.23047, -0.0, -0.0, -0.0, 1.0]}, "mixamorig:LeftHandThumb1": {"parentName": "mixamorig:LeftHand", "matrix_local": [0.68058, 0.22667, -0.69672, 3.81887, 0.31957, -0.94756, 0.00389, 5.32041, -0.6593, -0.2253, -0.71733, 0.10941, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandThumb1", "matrix_local_inverted": [0.68058, 0.31957, -0.6593, -4.22716, 0.22667, -0.94756, -0.2253, 4.2004, -0.69672, 0.00389, -0.71733, 2.71851, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandIndex3": {"parentName": "mixamorig:RightHandIndex2", "matrix_local": [0.11493, 0.59085, 0.79855, -4.31667, -0.26222, -0.75732, 0.59808, 5.28133, 0.95814, -0.27813, 0.06789, 0.05901, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandIndex3", "matrix_local_inverted": [0.11493, -0.26222, 0.95814, 1.82443, 0.59085, -0.75732, -0.27813, 6.56659, 0.79855, 0.59808, 0.06789, 0.28442, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:Spine": {"parentName": "mixamorig:Hips", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, 0.06699, -0.99775, 3.5092, 0.0, 0.99775, 0.06699, 0.00406, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Spine", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, 0.06699, 0.99775, -0.23912, 0.0, -0.99775, 0.06699, 3.50105, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandIndex4": {"parentName": "mixamorig:RightHandIndex3", "matrix_local": [0.11493, 0.59085, 0.79855, -4.43094, -0.26222, -0.75732, 0.59808, 5.19233, 0.95814, -0.27813, 0.06789, 0.05858, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandIndex4", "matrix_local_inverted": [0.11493, -0.26222, 0.95814, 1.81464, 0.59085, -0.75732, -0.27813, 6.56659, 0.79855, 0.59808, 0.06789, 0.42893, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:Spine2": {"parentName": "mixamorig:Spine1", "matrix_local": [1.0, 0.0, 0.0, 0.0, 0.0, 0.06699, -0.99775, 4.80331, 0.0, 0.99775, 0.06699, -0.08282, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:Spine2", "matrix_local_inverted": [1.0, -0.0, 0.0, -0.0, -0.0, 0.06699, 0.99775, -0.23912, 0.0, -0.99775, 0.06699, 4.79807, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:RightHandIndex2": {"parentName": "mixamorig:RightHandIndex1", "matrix_local": [0.11493, 0.53675, 0.83587, -4.17209, -0.26222, -0.79521, 0.5467, 5.37913, 0.95814, -0.28201, 0.04935, 0.05841, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:RightHandIndex2", "matrix_local_inverted": [0.11493, -0.26222, 0.95814, 1.83403, 0.53676, -0.79521, -0.28201, 6.53341, 0.83587, 0.5467, 0.04935, 0.54369, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHand": {"parentName": "mixamorig:LeftForeArm", "matrix_local": [0.11319, 0.22667, -0.96737, 3.66536, 0.25538, -0.94756, -0.19214, 5.30954, -0.96019, -0.2253, -0.16514, 0.00069, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHand", "matrix_local_inverted": [0.11319, 0.25538, -0.96019, -1.77017, 0.22667, -0.94756, -0.2253, 4.20042, -0.96737, -0.19214, -0.16514, 4.56608, -0.0, 0.0, -0.0, 1.0]}, "mixamorig:LeftHandIndex4": {"parentName": "mixamorig:LeftHandIndex3", "matrix_local": [0.14403, -0.37818, -0.91446, 4.4547, 0.26139, -0.87673, 0.40375, 5.22392, -0.95443, -0.29718, -0.02743, 0.08643, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftHandIndex4", "matrix_local_inverted": [0.14403, 0.26139, -0.95443, -1.92459, -0.37818, -0.87673, -0.29718, 6.29036, -0.91446, 0.40375, -0.02743, 1.96686, -0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftArm": {"parentName": "mixamorig:LeftShoulder", "matrix_local": [0.00171, 0.01499, -0.99989, 1.37436, -0.07042, -0.9974, -0.01507, 5.29354, -0.99752, 0.07044, -0.00065, -0.13538, 0.0, 0.0, 0.0, 1.0], "name": "mixamorig:LeftArm", "matrix_local_inverted": [0.00171, -0.07042, -0.99752, 0.23538, 0.01499, -0.9974, 0.07044, 5.26873, -0.99989, -0.01507, -0.00065, 1.45389, -0.0, 0.0, -0.0, 1.0]}}, "matrix_world": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "name": "Armature"}}`
-	AnimationMatrices = `{"Cube": {"ArmatureAction": {"mixamorig:Hips": {"1": [0.96462, 0.17658, -0.19577, 0.04297, -0.13144, 0.9658, 0.22351, -0.02403, 0.22855, -0.18987, 0.95484, 0.15616, 0.0, 0.0, 0.0, 1.0], "2": [0.97615, 0.13499, -0.17001, 0.06282, -0.09905, 0.97384, 0.20452, -0.02706, 0.19317, -0.1828, 0.96399, 0.13466, 0.0, 0.0, 0.0, 1.0], "3": [0.98511, 0.07957, -0.1524, 0.09023, -0.05027, 0.98102, 0.18727, -0.02027, 0.1644, -0.17682, 0.97042, 0.12876, 0.0, 0.0, 0.0, 1.0], "4": [0.98927, 0.01459, -0.14537, 0.1236, 0.01096, 0.9848, 0.17337, -0.00611, 0.14569, -0.1731, 0.97407, 0.13844, 0.0, 0.0, 0.0, 1.0], "5": [0.98835, -0.05042, -0.14363, 0.15943, 0.0741, 0.98357, 0.1646, 0.00725, 0.13297, -0.17333, 0.97585, 0.16711, 0.0, 0.0, 0.0, 1.0], "6": [0.9852, -0.10128, -0.13828, 0.19512, 0.12359, 0.97875, 0.16366, 0.01222, 0.11876, -0.17832, 0.97678, 0.20779, 0.0, 0.0, 0.0, 1.0], "7": [0.98431, -0.13, -0.11929, 0.22902, 0.14949, 0.97361, 0.17243, 0.00661, 0.09373, -0.18756, 0.97777, 0.25324, 0.0, 0.0, 0.0, 1.0], "8": [0.9867, -0.14059, -0.08163, 0.25938, 0.15381, 0.9699, 0.18878, -0.00301, 0.05264, -0.19882, 0.97862, 0.30009, 0.0, 0.0, 0.0, 1.0], "9": [0.98934, -0.14277, -0.02884, 0.28583, 0.14564, 0.96758, 0.20634, -0.00682, -0.00156, -0.20834, 0.97806, 0.34613, 0.0, 0.0, 0.0, 1.0], "10": [0.98939, -0.14253, 0.02823, 0.31136, 0.13298, 0.96653, 0.21941, -0.00118, -0.05856, -0.21333, 0.97522, 0.38552, 0.0, 0.0, 0.0, 1.0], "11": [0.98697, -0.14021, 0.07889, 0.33834, 0.11917, 0.96656, 0.22705, 0.00025, -0.10809, -0.2147, 0.97068, 0.40939, 0.0, 0.0, 0.0, 1.0], "12": [0.98431, -0.13501, 0.11365, 0.36671, 0.10609, 0.96732, 0.23029, -0.00293, -0.14102, -0.21462, 0.96646, 0.40766, 0.0, 0.0, 0.0, 1.0], "13": [0.9834, -0.12904, 0.12753, 0.39465, 0.09747, 0.96866, 0.22848, -0.00828, -0.15302, -0.21226, 0.96516, 0.38095, 0.0, 0.0, 0.0, 1.0], "14": [0.98492, -0.12369, 0.12096, 0.41678, 0.09489, 0.97084, 0.22014, -0.01387, -0.14466, -0.20535, 0.96794, 0.33757, 0.0, 0.0, 0.0, 1.0], "15": [0.98829, -0.11596, 0.09914, 0.42784, 0.09358, 0.97399, 0.20638, -0.02297, -0.1205, -0.19469, 0.97344, 0.28833, 0.0, 0.0, 0.0, 1.0], "16": [0.99266, -0.09998, 0.06803, 0.42861, 0.08539, 0.97784, 0.19114, -0.03292, -0.08563, -0.18393, 0.9792, 0.23753, 0.0, 0.0, 0.0, 1.0], "17": [0.99679, -0.07324, 0.03229, 0.42308, 0.06637, 0.98179, 0.178, -0.03821, -0.04474, -0.17529, 0.9835, 0.18752, 0.0, 0.0, 0.0, 1.0], "18": [0.99921, -0.0395, -0.00363, 0.41302, 0.03955, 0.98501, 0.16789, -0.03525, -0.00306, -0.1679, 0.9858, 0.14424, 0.0, 0.0, 0.0, 1.0], "19": [0.99936, -0.00429, -0.0355, 0.39805, 0.00993, 0.98706, 0.16006, -0.02313, 0.03436, -0.16031, 0.98647, 0.11501, 0.0, 0.0, 0.0, 1.0], "20": [0.99762, 0.03146, -0.06132, 0.3785, -0.02162, 0.98768, 0.155, -0.00449, 0.06544, -0.15331, 0.98601, 0.10586, 0.0, 0.0, 0.0, 1.0], "21": [0.99422, 0.07004, -0.08142, 0.35561, -0.05666, 0.98608, 0.15634, 0.01428, 0.09124, -0.15083, 0.98434, 0.11631, 0.0, 0.0, 0.0, 1.0], "22": [0.98908, 0.11061, -0.09737, 0.32967, -0.09308, 0.98119, 0.16912, 0.02652, 0.11424, -0.15822, 0.98077, 0.14382, 0.0, 0.0, 0.0, 1.0], "23": [0.98274, 0.14904, -0.10958, 0.29868, -0.1259, 0.97287, 0.19411, 0.03219, 0.13554, -0.17696, 0.97484, 0.18964, 0.0, 0.0, 0.0, 1.0], "24": [0.97545, 0.18162, -0.12454, 0.26175, -0.15053, 0.96269, 0.22489, 0.03124, 0.16074, -0.20063, 0.96639, 0.24727, 0.0, 0.0, 0.0, 1.0], "25": [0.96638, 0.2083, -0.15076, 0.22036, -0.16612, 0.95329, 0.25228, 0.03108, 0.19626, -0.21875, 0.95584, 0.30985, 0.0, 0.0, 0.0, 1.0], "26": [0.95438, 0.22949, -0.19104, 0.1792, -0.17302, 0.94645, 0.27259, 0.0363, 0.24337, -0.2271, 0.94297, 0.3679, 0.0, 0.0, 0.0, 1.0], "27": [0.93966, 0.2437, -0.2401, 0.14009, -0.17007, 0.94172, 0.29025, 0.0488, 0.29684, -0.2319, 0.92634, 0.41125, 0.0, 0.0, 0.0, 1.0], "28": [0.92421, 0.25182, -0.28709, 0.10386, -0.1589, 0.93719, 0.31052, 0.06001, 0.34725, -0.24136, 0.90618, 0.43452, 0.0, 0.0, 0.0, 1.0], "29": [0.91304, 0.25831, -0.31566, 0.0698, -0.14951, 0.93199, 0.33021, 0.06772, 0.37949, -0.2543, 0.88956, 0.44035, 0.0, 0.0, 0.0, 1.0], "30": [0.90927, 0.26455, -0.32132, 0.0403, -0.14953, 0.9281, 0.34098, 0.07035, 0.38842, -0.262, 0.88345, 0.4281, 0.0, 0.0, 0.0, 1.0], "31": [0.91351, 0.26727, -0.30671, 0.01844, -0.15883, 0.92839, 0.33594, 0.06612, 0.37453, -0.25817, 0.89055, 0.39421, 0.0, 0.0, 0.0, 1.0], "32": [0.92346, 0.26172, -0.28059, 0.00735, -0.16858, 0.93365, 0.31605, 0.04973, 0.34469, -0.24456, 0.9063, 0.33892, 0.0, 0.0, 0.0, 1.0], "33": [0.9363, 0.24462, -0.25201, 0.00937, -0.16875, 0.94264, 0.28802, 0.02262, 0.30801, -0.22715, 0.92387, 0.2744, 0.0, 0.0, 0.0, 1.0], "34": [0.95044, 0.21562, -0.22401, 0.02155, -0.15584, 0.9538, 0.25684, -0.0037, 0.26904, -0.2092, 0.94013, 0.20981, 0.0, 0.0, 0.0, 1.0], "35": [0.96462, 0.17658, -0.19577, 0.04297, -0.13144, 0.9658, 0.22351, -0.02403, 0.22855, -0.18987, 0.95484, 0.15616, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftToeBase": {"1": [0.9994, 0.02818, -0.01999, -0.0, -0.03023, 0.99334, -0.11118, -0.0, 0.01672, 0.11171, 0.9936, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99995, -0.00729, -0.00671, -0.0, 0.007, 0.99912, -0.04144, -0.0, 0.00701, 0.04139, 0.99912, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99995, -0.00753, -0.00692, -0.0, 0.00722, 0.99906, -0.04278, -0.0, 0.00724, 0.04273, 0.99906, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99994, -0.00826, -0.00757, -0.0, 0.00789, 0.99887, -0.04685, -0.0, 0.00794, 0.04679, 0.99887, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99993, -0.00844, -0.00772, -0.0, 0.00806, 0.99882, -0.04785, -0.0, 0.00812, 0.04778, 0.99883, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99993, -0.00897, -0.00819, -0.0, 0.00855, 0.99867, -0.05081, -0.0, 0.00863, 0.05074, 0.99867, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99989, -0.01103, -0.00996, -0.0, 0.01039, 0.99801, -0.06216, -0.0, 0.01062, 0.06205, 0.99802, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99979, -0.01526, -0.01345, -0.0, 0.01406, 0.99628, -0.08507, -0.0, 0.0147, 0.08486, 0.99628, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99963, -0.02069, -0.01772, -0.0, 0.01854, 0.99332, -0.11388, -0.0, 0.01995, 0.11351, 0.99334, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99954, -0.02325, -0.01964, -0.0, 0.02057, 0.99166, -0.12726, -0.0, 0.02244, 0.12679, 0.99168, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99957, -0.02231, -0.01894, -0.0, 0.01983, 0.99229, -0.12235, -0.0, 0.02152, 0.12192, 0.99231, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.9997, -0.01855, -0.01607, -0.0, 0.01681, 0.99458, -0.10263, -0.0, 0.01788, 0.10233, 0.99459, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99978, -0.01589, -0.01396, -0.0, 0.0146, 0.99597, -0.08848, -0.0, 0.01531, 0.08825, 0.99598, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99981, -0.01446, -0.0128, -0.0, 0.01338, 0.99664, -0.08078, -0.0, 0.01393, 0.08059, 0.99665, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99981, -0.01452, -0.01285, -0.0, 0.01343, 0.99662, -0.08109, -0.0, 0.01398, 0.0809, 0.99662, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99981, -0.01479, -0.01307, -0.0, 0.01366, 0.99649, -0.08256, -0.0, 0.01425, 0.08236, 0.9965, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.9998, -0.01509, -0.01332, -0.0, 0.01392, 0.99635, -0.08417, -0.0, 0.01454, 0.08396, 0.99636, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.9998, -0.0149, -0.01317, -0.0, 0.01376, 0.99644, -0.08318, -0.0, 0.01436, 0.08298, 0.99645, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99979, -0.01545, -0.01361, -0.0, 0.01422, 0.99619, -0.0861, -0.0, 0.01488, 0.08589, 0.99619, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99975, -0.01696, -0.01482, -0.0, 0.0155, 0.99543, -0.09421, -0.0, 0.01635, 0.09396, 0.99544, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99967, -0.01932, -0.01667, -0.0, 0.01743, 0.99414, -0.10669, -0.0, 0.01863, 0.10637, 0.99415, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99958, -0.02202, -0.01872, -0.0, 0.0196, 0.99248, -0.12083, -0.0, 0.02124, 0.12041, 0.9925, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99946, -0.02529, -0.02114, -0.0, 0.02214, 0.99022, -0.13776, -0.0, 0.02441, 0.13722, 0.99024, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99913, -0.03251, -0.02617, -0.0, 0.02746, 0.98432, -0.17426, -0.0, 0.03142, 0.17339, 0.98435, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99796, -0.0515, -0.03765, -0.0, 0.0397, 0.96333, -0.26537, -0.0, 0.04993, 0.26333, 0.96341, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99529, -0.08211, -0.05154, -0.0, 0.05483, 0.9152, -0.39926, -0.0, 0.07996, 0.39456, 0.91539, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99149, -0.1175, -0.056, -0.0, 0.07919, 0.886, -0.45688, -0.0, 0.1033, 0.44856, 0.88776, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.93776, -0.34721, -0.00658, -0.0, 0.2911, 0.79625, -0.53033, -0.0, 0.18938, 0.49541, 0.84777, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.84041, -0.52719, 0.12562, -0.0, 0.52388, 0.73092, -0.43739, -0.0, 0.13877, 0.4334, 0.89046, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.96018, -0.27862, 0.02076, -0.0, 0.27658, 0.93739, -0.21166, -0.0, 0.03952, 0.20897, 0.97712, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.98633, -0.16463, -0.0071, -0.0, 0.16184, 0.97593, -0.14615, -0.0, 0.03098, 0.143, 0.98924, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.98849, -0.15041, -0.01632, -0.0, 0.14517, 0.97332, -0.17771, -0.0, 0.04261, 0.17329, 0.98395, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99398, -0.10601, -0.02786, -0.0, 0.09499, 0.95994, -0.26361, -0.0, 0.05469, 0.25938, 0.96423, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99776, -0.05916, -0.03126, -0.0, 0.05026, 0.97105, -0.23355, -0.0, 0.04417, 0.23145, 0.97184, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.9994, 0.02818, -0.01999, -0.0, -0.03024, 0.99334, -0.11118, -0.0, 0.01672, 0.11172, 0.9936, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:Spine1": {"1": [0.9991, 0.00366, 0.04237, 0.0, 0.00782, 0.96348, -0.26766, 0.0, -0.0418, 0.26775, 0.96258, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99945, 0.0073, 0.0324, 0.0, 0.00156, 0.96417, -0.26529, 0.0, -0.03318, 0.26519, 0.96362, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99969, 0.01182, 0.02192, 0.0, -0.00561, 0.96446, -0.26416, 0.0, -0.02426, 0.26396, 0.96423, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99981, 0.01632, 0.01096, 0.0, -0.01284, 0.96419, -0.26489, 0.0, -0.0149, 0.2647, 0.96422, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99981, 0.01966, 8e-05, 0.0, -0.01893, 0.9635, -0.26703, 0.0, -0.00533, 0.26697, 0.96369, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99973, 0.02115, -0.00955, 0.0, -0.02294, 0.96282, -0.26916, 0.0, 0.0035, 0.26931, 0.96305, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99964, 0.02083, -0.01661, 0.0, -0.02455, 0.96249, -0.27022, 0.0, 0.01036, 0.27053, 0.96265, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99961, 0.01912, -0.02054, 0.0, -0.02396, 0.96252, -0.27016, 0.0, 0.0146, 0.27054, 0.9626, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99962, 0.01655, -0.02188, 0.0, -0.02183, 0.96288, -0.26905, 0.0, 0.01661, 0.26942, 0.96288, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99967, 0.01378, -0.02188, 0.0, -0.01911, 0.96364, -0.2665, 0.0, 0.01741, 0.26683, 0.96359, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.9997, 0.01148, -0.02158, 0.0, -0.01675, 0.96475, -0.26262, 0.0, 0.0178, 0.2629, 0.96466, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99972, 0.01009, -0.02127, 0.0, -0.01526, 0.96578, -0.2589, 0.0, 0.01793, 0.25916, 0.96567, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99975, 0.00954, -0.02034, 0.0, -0.01446, 0.96623, -0.25729, 0.0, 0.0172, 0.25751, 0.96612, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.9998, 0.00934, -0.01756, 0.0, -0.01356, 0.96596, -0.25834, 0.0, 0.01455, 0.25853, 0.96589, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99989, 0.00875, -0.01194, 0.0, -0.01157, 0.96529, -0.26092, 0.0, 0.00925, 0.26103, 0.96529, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99997, 0.00735, -0.00353, 0.0, -0.00802, 0.96462, -0.26351, 0.0, 0.00147, 0.26353, 0.96465, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99996, 0.00536, 0.00656, 0.0, -0.00343, 0.96411, -0.26548, 0.0, -0.00774, 0.26545, 0.96409, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99985, 0.00352, 0.01701, 0.0, 0.00116, 0.96366, -0.26713, 0.0, -0.01733, 0.26711, 0.96351, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99963, 0.00241, 0.0272, 0.0, 0.00499, 0.96318, -0.26883, 0.0, -0.02685, 0.26886, 0.9628, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99931, 0.00207, 0.0372, 0.0, 0.00809, 0.96261, -0.27079, 0.0, -0.03637, 0.2709, 0.96192, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.9989, 0.00195, 0.0469, 0.0, 0.01096, 0.96185, -0.27335, 0.0, -0.04565, 0.27357, 0.96077, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99846, 0.00116, 0.05543, 0.0, 0.01425, 0.96083, -0.27678, 0.0, -0.05358, 0.27715, 0.95933, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99811, -0.00083, 0.06151, 0.0, 0.01809, 0.95966, -0.2806, 0.0, -0.05879, 0.28118, 0.95785, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.9979, -0.00352, 0.06469, 0.0, 0.02176, 0.95875, -0.28343, 0.0, -0.06103, 0.28424, 0.95681, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99781, -0.0057, 0.06596, 0.0, 0.02424, 0.95859, -0.28376, 0.0, -0.06161, 0.28474, 0.95662, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99773, -0.00675, 0.06695, 0.0, 0.02537, 0.95929, -0.28129, 0.0, -0.06233, 0.28235, 0.95728, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99762, -0.00723, 0.06853, 0.0, 0.02603, 0.96033, -0.27765, 0.0, -0.0638, 0.27877, 0.95824, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.9975, -0.00811, 0.07015, 0.0, 0.02719, 0.96094, -0.27543, 0.0, -0.06518, 0.27665, 0.95876, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99745, -0.00963, 0.07074, 0.0, 0.02886, 0.96066, -0.27621, 0.0, -0.0653, 0.27755, 0.95849, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99751, -0.01079, 0.06973, 0.0, 0.02991, 0.95974, -0.27931, 0.0, -0.06391, 0.2807, 0.95767, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99768, -0.0103, 0.06726, 0.0, 0.02892, 0.95892, -0.2822, 0.0, -0.06159, 0.28349, 0.957, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99795, -0.00773, 0.06347, 0.0, 0.02539, 0.95897, -0.28237, 0.0, -0.05869, 0.28341, 0.9572, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.9983, -0.00387, 0.05813, 0.0, 0.01998, 0.96004, -0.27914, 0.0, -0.05473, 0.27982, 0.95849, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.9987, 0.00015, 0.05106, 0.0, 0.01385, 0.96172, -0.27368, 0.0, -0.04914, 0.27403, 0.96047, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.9991, 0.00366, 0.04237, 0.0, 0.00782, 0.96348, -0.26766, 0.0, -0.0418, 0.26775, 0.96258, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandIndex1": {"1": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.96035, -0.05299, -0.27371, 0.0, 0.07586, 0.99439, 0.07366, -0.0, 0.26827, -0.0915, 0.95899, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandThumb4": {"1": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, -0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandIndex1": {"1": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.94668, 0.05975, 0.31659, 0.0, -0.08973, 0.99267, 0.08097, 0.0, -0.30943, -0.10506, 0.9451, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightLeg": {"1": [0.98124, 0.18719, 0.04603, 0.0, -0.19169, 0.97274, 0.13049, 0.0, -0.02035, -0.13687, 0.99038, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98935, 0.13477, 0.05501, 0.0, -0.13794, 0.98871, 0.0585, 0.0, -0.0465, -0.06547, 0.99677, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99676, 0.06668, 0.04509, 0.0, -0.06787, 0.99737, 0.02526, 0.0, -0.04328, -0.02824, 0.99866, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99971, -0.01438, 0.01942, 0.0, 0.01301, 0.99756, 0.06862, 0.0, -0.02036, -0.06835, 0.99745, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99567, -0.0927, -0.00614, 0.0, 0.09252, 0.98333, 0.15654, 0.0, -0.00847, -0.15643, 0.98765, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98886, -0.14604, -0.02864, 0.0, 0.14868, 0.96131, 0.23191, 0.0, -0.00633, -0.23358, 0.97232, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98508, -0.1645, -0.0505, 0.0, 0.17205, 0.93719, 0.30344, 0.0, -0.00259, -0.3076, 0.95151, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.98444, -0.1591, -0.07458, 0.0, 0.17571, 0.89608, 0.40763, 0.0, 0.00198, -0.41439, 0.9101, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.98502, -0.14058, -0.0999, 0.0, 0.17245, 0.81007, 0.5604, 0.0, 0.00214, -0.56923, 0.82217, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.98702, -0.10727, -0.11952, 0.0, 0.16031, 0.61373, 0.77307, 0.0, -0.00958, -0.7822, 0.62296, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.9906, -0.0505, -0.12711, 0.0, 0.13626, 0.44481, 0.8852, 0.0, 0.01184, -0.8942, 0.44751, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99231, -0.03255, -0.11945, 0.0, 0.12303, 0.36671, 0.92216, 0.0, 0.01379, -0.92977, 0.36789, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99445, 0.02399, -0.10248, 0.0, 0.09494, 0.2158, 0.97181, 0.0, 0.04543, -0.97614, 0.21233, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99549, 0.05147, -0.07965, 0.0, 0.07365, 0.10938, 0.99127, 0.0, 0.05973, -0.99267, 0.1051, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99574, 0.07125, -0.05845, 0.0, 0.05154, 0.0952, 0.99412, 0.0, 0.0764, -0.9929, 0.09113, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99346, 0.10705, -0.03982, 0.0, 0.02006, 0.17966, 0.98352, 0.0, 0.11244, -0.97789, 0.17634, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98866, 0.14871, -0.02107, 0.0, -0.03006, 0.33337, 0.94232, 0.0, 0.14716, -0.93099, 0.33405, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98438, 0.17606, -0.00097, 0.0, -0.09267, 0.5228, 0.8474, 0.0, 0.1497, -0.83407, 0.53095, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.98291, 0.1831, 0.01901, 0.0, -0.1462, 0.71374, 0.68498, 0.0, 0.11185, -0.67606, 0.72831, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.98353, 0.17677, 0.03758, 0.0, -0.17332, 0.86368, 0.47331, 0.0, 0.05121, -0.47203, 0.88009, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98531, 0.16163, 0.05511, 0.0, -0.17039, 0.95189, 0.2547, 0.0, -0.0113, -0.26035, 0.96545, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98715, 0.14177, 0.07378, 0.0, -0.14591, 0.98781, 0.05422, 0.0, -0.0652, -0.06428, 0.9958, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98861, 0.12787, 0.07939, 0.0, -0.12663, 0.99174, -0.0205, 0.0, -0.08135, 0.01021, 0.99663, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98855, 0.13036, 0.07598, 0.0, -0.12992, 0.99147, -0.01066, 0.0, -0.07672, 0.00066, 0.99705, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98731, 0.14194, 0.07122, 0.0, -0.14785, 0.98526, 0.08607, 0.0, -0.05796, -0.09551, 0.99374, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98489, 0.15504, 0.0772, 0.0, -0.16336, 0.97965, 0.11659, 0.0, -0.05755, -0.12744, 0.99017, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.97842, 0.17404, 0.11138, 0.0, -0.17696, 0.98407, 0.01679, 0.0, -0.10668, -0.03614, 0.99364, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.97874, 0.17559, 0.10602, 0.0, -0.19622, 0.95207, 0.23464, 0.0, -0.05974, -0.25046, 0.96628, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.9735, 0.1795, 0.14172, 0.0, -0.22069, 0.89986, 0.37623, 0.0, -0.05999, -0.39753, 0.91562, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.97132, 0.18292, 0.15189, 0.0, -0.23258, 0.86363, 0.44727, 0.0, -0.04936, -0.46977, 0.88141, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.97133, 0.187, 0.14676, 0.0, -0.235, 0.84844, 0.47426, 0.0, -0.03583, -0.49515, 0.86807, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.97287, 0.19173, 0.12946, 0.0, -0.22986, 0.8645, 0.44699, 0.0, -0.02622, -0.46462, 0.88512, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.97547, 0.19344, 0.10502, 0.0, -0.21902, 0.90059, 0.37546, 0.0, -0.02195, -0.38926, 0.92087, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.97864, 0.19203, 0.07336, 0.0, -0.20475, 0.94237, 0.26461, 0.0, -0.01832, -0.27398, 0.96156, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98124, 0.18719, 0.04603, 0.0, -0.19169, 0.97274, 0.13049, 0.0, -0.02035, -0.13687, 0.99038, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightUpLeg": {"1": [0.96659, -0.03238, 0.25426, -0.0, -0.00746, 0.98801, 0.15419, -0.0, -0.2562, -0.15094, 0.95477, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.97779, -0.03188, 0.20714, -0.0, -0.01905, 0.97075, 0.23935, -0.0, -0.20871, -0.23798, 0.94858, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98598, -0.0229, 0.16527, -0.0, -0.02918, 0.9516, 0.30595, -0.0, -0.16427, -0.30648, 0.93759, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99159, -0.00546, 0.12931, -0.0, -0.03891, 0.9403, 0.33813, -0.0, -0.12344, -0.34032, 0.93217, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99598, 0.01221, 0.08872, -0.0, -0.04291, 0.93462, 0.35304, -0.0, -0.07861, -0.35543, 0.93139, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99879, 0.02792, 0.04056, -0.0, -0.04118, 0.92526, 0.3771, -0.0, -0.027, -0.37831, 0.92528, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99859, 0.05023, -0.01689, -0.0, -0.03893, 0.91161, 0.40922, -0.0, 0.03595, -0.40798, 0.91228, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99278, 0.08348, -0.08618, -0.0, -0.03824, 0.90094, 0.43225, -0.0, 0.11373, -0.42583, 0.89763, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.97866, 0.11862, -0.16778, -0.0, -0.03581, 0.90252, 0.42916, -0.0, 0.20233, -0.414, 0.88751, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.95786, 0.13019, -0.25602, -0.0, -0.03068, 0.93265, 0.35948, -0.0, 0.28558, -0.33647, 0.89735, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.93809, 0.12723, -0.32219, -0.0, -0.03234, 0.95821, 0.28424, -0.0, 0.34489, -0.25623, 0.90299, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.93077, 0.11861, -0.34582, -0.0, -0.04738, 0.97707, 0.20758, -0.0, 0.36252, -0.17682, 0.91505, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.93794, 0.0759, -0.33838, -0.0, -0.05089, 0.99531, 0.08221, -0.0, 0.34303, -0.05989, 0.93741, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.96036, 0.02912, -0.27724, -0.0, -0.0512, 0.99603, -0.07276, -0.0, 0.27402, 0.08407, 0.95804, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98347, 0.0016, -0.18104, -0.0, -0.04256, 0.97398, -0.22259, -0.0, 0.17597, 0.22661, 0.95796, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99656, -0.00169, -0.08287, -0.0, -0.02667, 0.94007, -0.33994, -0.0, 0.07848, 0.34098, 0.93679, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99995, 0.0071, -0.00663, -0.0, -0.00922, 0.9085, -0.41777, -0.0, 0.00306, 0.41782, 0.90853, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99907, 0.01501, 0.04049, -0.0, 0.00548, 0.88594, -0.46377, -0.0, -0.04283, 0.46356, 0.88503, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99805, 0.0209, 0.05889, -0.0, 0.01033, 0.87428, -0.48531, -0.0, -0.06163, 0.48497, 0.87235, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99795, 0.03373, 0.05431, -0.0, -0.00269, 0.87088, -0.49149, -0.0, -0.06388, 0.49034, 0.86919, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99785, 0.05454, 0.03624, -0.0, -0.0303, 0.87516, -0.48288, -0.0, -0.05805, 0.48074, 0.87494, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99719, 0.0736, 0.01383, -0.0, -0.05968, 0.89263, -0.44682, -0.0, -0.04523, 0.44474, 0.89452, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99625, 0.08648, -0.0015, -0.0, -0.07934, 0.90679, -0.41404, -0.0, -0.03445, 0.41261, 0.91026, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99611, 0.08809, 0.00182, -0.0, -0.08103, 0.92397, -0.37378, -0.0, -0.03461, 0.37218, 0.92752, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99613, 0.08344, 0.02767, -0.0, -0.06798, 0.93071, -0.35939, -0.0, -0.05573, 0.35611, 0.93278, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99435, 0.07337, 0.07669, -0.0, -0.04465, 0.9447, -0.32487, -0.0, -0.09628, 0.31961, 0.94264, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98954, 0.04285, 0.13776, -0.0, -0.0093, 0.97183, -0.23549, -0.0, -0.14397, 0.23174, 0.96206, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.97419, 0.03764, 0.22256, -0.0, 0.02539, 0.96147, -0.27374, -0.0, -0.22429, 0.27233, 0.9357, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.95996, 0.02267, 0.27922, -0.0, 0.05464, 0.96243, -0.26599, -0.0, -0.27476, 0.2706, 0.92265, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.94712, 0.00635, 0.32081, -0.0, 0.07024, 0.97146, -0.22657, -0.0, -0.3131, 0.23712, 0.91964, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.94082, -0.00661, 0.33883, -0.0, 0.07038, 0.98182, -0.17626, -0.0, -0.33151, 0.18968, 0.92419, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.94187, -0.01949, 0.33542, -0.0, 0.06023, 0.99194, -0.11149, -0.0, -0.33054, 0.12521, 0.93545, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.94773, -0.02893, 0.31775, -0.0, 0.04203, 0.99852, -0.03443, -0.0, -0.31629, 0.04598, 0.94755, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.95585, -0.03379, 0.2919, -0.0, 0.0179, 0.99822, 0.05694, -0.0, -0.2933, -0.04921, 0.95475, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.96659, -0.03238, 0.25426, -0.0, -0.00746, 0.98801, 0.15419, -0.0, -0.2562, -0.15094, 0.95477, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandIndex2": {"1": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99944, 0.0062, 0.0328, -0.0, -0.01152, 0.98628, 0.1647, 0.0, -0.03133, -0.16498, 0.9858, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftShoulder": {"1": [0.99131, 0.10485, 0.07939, -0.0, -0.08731, 0.9761, -0.19903, -0.0, -0.09836, 0.19037, 0.97677, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.9918, 0.10259, 0.07624, -0.0, -0.08713, 0.97906, -0.18398, -0.0, -0.09352, 0.17583, 0.97997, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99284, 0.10058, 0.0645, -0.0, -0.08823, 0.98115, -0.17192, -0.0, -0.08058, 0.165, 0.983, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.9942, 0.09892, 0.04213, -0.0, -0.09058, 0.9817, -0.16752, -0.0, -0.05793, 0.16274, 0.98497, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99519, 0.09702, 0.01369, -0.0, -0.0931, 0.97988, -0.17654, -0.0, -0.03054, 0.17442, 0.9842, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99552, 0.09386, -0.01115, -0.0, -0.09418, 0.97498, -0.20134, -0.0, -0.00802, 0.20149, 0.97946, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99568, 0.08936, -0.0252, -0.0, -0.09279, 0.96716, -0.23664, -0.0, 0.00322, 0.23796, 0.97127, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99599, 0.0851, -0.02752, -0.0, -0.08937, 0.95894, -0.26914, -0.0, 0.00349, 0.27052, 0.96271, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99636, 0.08291, -0.01962, -0.0, -0.08505, 0.95418, -0.28689, -0.0, -0.00506, 0.28752, 0.95776, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99653, 0.08319, -0.00142, -0.0, -0.07998, 0.95311, -0.29185, -0.0, -0.02293, 0.29095, 0.95646, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99602, 0.08526, 0.02615, -0.0, -0.07351, 0.95096, -0.30044, -0.0, -0.05048, 0.29732, 0.95344, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99438, 0.08888, 0.05743, -0.0, -0.06549, 0.94318, -0.32576, -0.0, -0.08312, 0.32017, 0.94371, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99197, 0.0942, 0.08441, -0.0, -0.05798, 0.93175, -0.35844, -0.0, -0.11241, 0.35066, 0.92973, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98977, 0.10016, 0.10159, -0.0, -0.05541, 0.9261, -0.3732, -0.0, -0.13146, 0.36375, 0.92217, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98859, 0.10458, 0.10837, -0.0, -0.05969, 0.93272, -0.35562, -0.0, -0.13827, 0.3451, 0.92833, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98846, 0.10624, 0.10795, -0.0, -0.06741, 0.94685, -0.31455, -0.0, -0.13563, 0.30364, 0.94308, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98889, 0.10592, 0.10433, -0.0, -0.07449, 0.9603, -0.26884, -0.0, -0.12867, 0.25808, 0.95752, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98936, 0.10546, 0.10026, -0.0, -0.08011, 0.96995, -0.22975, -0.0, -0.12147, 0.21927, 0.96807, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.98961, 0.10621, 0.09692, -0.0, -0.08526, 0.97623, -0.19925, -0.0, -0.11577, 0.18892, 0.97514, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.98964, 0.10834, 0.0942, -0.0, -0.09029, 0.97981, -0.17836, -0.0, -0.11162, 0.16801, 0.97945, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98954, 0.11125, 0.09182, -0.0, -0.09449, 0.98089, -0.1701, -0.0, -0.10899, 0.15964, 0.98114, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98927, 0.11432, 0.09102, -0.0, -0.09718, 0.97986, -0.17444, -0.0, -0.10913, 0.16372, 0.98045, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98862, 0.11738, 0.09411, -0.0, -0.09853, 0.97787, -0.18457, -0.0, -0.1137, 0.1732, 0.9783, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98758, 0.12026, 0.10111, -0.0, -0.09927, 0.9764, -0.19178, -0.0, -0.12179, 0.17936, 0.97622, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.9867, 0.12211, 0.10729, -0.0, -0.09967, 0.97592, -0.19402, -0.0, -0.12839, 0.18075, 0.97511, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98695, 0.12143, 0.10575, -0.0, -0.09887, 0.97536, -0.19722, -0.0, -0.12709, 0.1842, 0.97464, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98866, 0.11754, 0.09349, -0.0, -0.09608, 0.97345, -0.20778, -0.0, -0.11543, 0.19644, 0.9737, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99087, 0.11191, 0.07513, -0.0, -0.09248, 0.96994, -0.22509, -0.0, -0.09806, 0.21609, 0.97144, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99239, 0.10776, 0.0596, -0.0, -0.09049, 0.96641, -0.24053, -0.0, -0.08352, 0.23331, 0.96881, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99278, 0.10753, 0.05306, -0.0, -0.09143, 0.96515, -0.24522, -0.0, -0.07758, 0.2386, 0.96801, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99228, 0.11063, 0.05605, -0.0, -0.09418, 0.96622, -0.2399, -0.0, -0.0807, 0.23277, 0.96918, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99135, 0.1139, 0.06515, -0.0, -0.09597, 0.96799, -0.23191, -0.0, -0.08948, 0.22365, 0.97055, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99061, 0.11427, 0.07507, -0.0, -0.09492, 0.96995, -0.22401, -0.0, -0.09841, 0.21478, 0.97169, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99054, 0.11093, 0.08081, -0.0, -0.09149, 0.97261, -0.21367, -0.0, -0.1023, 0.20426, 0.97356, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99131, 0.10485, 0.07939, -0.0, -0.08731, 0.9761, -0.19903, -0.0, -0.09836, 0.19037, 0.97677, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightForeArm": {"1": [0.98697, -0.00167, -0.16087, 0.0, 0.00225, 0.99999, 0.00337, 0.0, 0.16086, -0.00368, 0.98697, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98519, -0.0018, -0.17144, 0.0, 0.00228, 0.99999, 0.0026, 0.0, 0.17143, -0.00295, 0.98519, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98269, -0.00197, -0.18525, 0.0, 0.00231, 1.0, 0.0016, 0.0, 0.18525, -0.002, 0.98269, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.97714, -0.00235, -0.21256, 0.0, 0.00232, 1.0, -0.00039, 0.0, 0.21256, -0.00011, 0.97715, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.9658, -0.00314, -0.25928, 0.0, 0.00222, 0.99999, -0.00382, 0.0, 0.25929, 0.00312, 0.96579, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.94936, -0.00427, -0.31415, 0.0, 0.00189, 0.99997, -0.0079, 0.0, 0.31417, 0.00691, 0.94934, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.93303, -0.0054, -0.35976, 0.0, 0.00142, 0.99993, -0.01133, 0.0, 0.3598, 0.01006, 0.93298, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.91925, -0.00635, -0.39363, 0.0, 0.00096, 0.9999, -0.0139, 0.0, 0.39368, 0.0124, 0.91916, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.90527, -0.00732, -0.42477, 0.0, 0.00044, 0.99987, -0.01629, 0.0, 0.42484, 0.01455, 0.90515, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.89111, -0.0083, -0.45372, 0.0, -0.00012, 0.99983, -0.01852, 0.0, 0.45379, 0.01655, 0.89095, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.88548, -0.00869, -0.4646, 0.0, -0.00035, 0.99981, -0.01936, 0.0, 0.46468, 0.01731, 0.88531, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.89771, -0.00784, -0.44051, 0.0, 0.00015, 0.99985, -0.0175, 0.0, 0.44058, 0.01564, 0.89758, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.92303, -0.00609, -0.38468, 0.0, 0.00109, 0.99991, -0.01322, 0.0, 0.38473, 0.01178, 0.92295, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.94758, -0.0044, -0.31949, 0.0, 0.00184, 0.99996, -0.0083, 0.0, 0.31952, 0.00728, 0.94755, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.96501, -0.00319, -0.26219, 0.0, 0.00221, 0.99999, -0.00404, 0.0, 0.2622, 0.00332, 0.96501, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.97659, -0.00239, -0.2151, 0.0, 0.00232, 1.0, -0.00058, 0.0, 0.2151, 6e-05, 0.97659, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98412, -0.00187, -0.17752, 0.0, 0.00229, 1.0, 0.00216, 0.0, 0.17752, -0.00253, 0.98411, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98838, -0.00158, -0.15197, 0.0, 0.00221, 0.99999, 0.00401, 0.0, 0.15196, -0.0043, 0.98838, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99012, -0.00146, -0.14025, 0.0, 0.00216, 0.99999, 0.00485, 0.0, 0.14024, -0.00511, 0.9901, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99014, -0.00146, -0.14004, 0.0, 0.00216, 0.99999, 0.00487, 0.0, 0.14003, -0.00512, 0.99013, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98923, -0.00152, -0.14639, 0.0, 0.00219, 0.99999, 0.00441, 0.0, 0.14638, -0.00468, 0.98922, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98791, -0.00161, -0.15503, 0.0, 0.00222, 0.99999, 0.00379, 0.0, 0.15502, -0.00409, 0.9879, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98646, -0.00171, -0.16398, 0.0, 0.00226, 0.99999, 0.00314, 0.0, 0.16398, -0.00347, 0.98646, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98507, -0.00181, -0.17216, 0.0, 0.00228, 0.99999, 0.00255, 0.0, 0.17215, -0.0029, 0.98507, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98425, -0.00186, -0.17677, 0.0, 0.00229, 0.99999, 0.00222, 0.0, 0.17676, -0.00259, 0.98425, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98493, -0.00182, -0.17294, 0.0, 0.00228, 0.99999, 0.00249, 0.0, 0.17294, -0.00285, 0.98493, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98755, -0.00164, -0.15732, 0.0, 0.00223, 0.99999, 0.00362, 0.0, 0.15731, -0.00393, 0.98754, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99109, -0.00139, -0.13318, 0.0, 0.00212, 0.99998, 0.00536, 0.0, 0.13317, -0.0056, 0.99108, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99379, -0.0012, -0.11124, 0.0, 0.00199, 0.99997, 0.00694, 0.0, 0.11123, -0.00711, 0.99377, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99469, -0.00114, -0.10287, 0.0, 0.00193, 0.99997, 0.00753, 0.0, 0.10286, -0.00769, 0.99467, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99384, -0.0012, -0.11083, 0.0, 0.00198, 0.99997, 0.00696, 0.0, 0.11082, -0.00714, 0.99381, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99184, -0.00134, -0.12748, 0.0, 0.00209, 0.99998, 0.00577, 0.0, 0.12747, -0.00599, 0.99182, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.98975, -0.00148, -0.14277, 0.0, 0.00217, 0.99999, 0.00467, 0.0, 0.14276, -0.00493, 0.98975, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98823, -0.00159, -0.15298, 0.0, 0.00222, 0.99999, 0.00394, 0.0, 0.15297, -0.00423, 0.98822, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98697, -0.00167, -0.16087, 0.0, 0.00225, 0.99999, 0.00337, 0.0, 0.16086, -0.00368, 0.98697, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandThumb2": {"1": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.29969, -0.16487, -0.93968, -0.0, 0.26429, 0.96075, -0.08428, 0.0, 0.9167, -0.22309, 0.3315, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftLeg": {"1": [0.99208, -0.08563, 0.09188, 0.0, -0.06631, 0.2642, 0.96219, 0.0, -0.10667, -0.96066, 0.25643, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99075, -0.09503, 0.09685, 0.0, -0.04215, 0.46289, 0.88542, 0.0, -0.12897, -0.88131, 0.4546, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99004, -0.09439, 0.10449, 0.0, -0.01971, 0.64191, 0.76653, 0.0, -0.13942, -0.76095, 0.63365, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.98954, -0.08809, 0.11427, 0.0, -0.00646, 0.76414, 0.64501, 0.0, -0.14414, -0.639, 0.75558, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.98871, -0.08686, 0.12211, 0.0, 0.00113, 0.81918, 0.57353, 0.0, -0.14985, -0.56692, 0.81003, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98824, -0.09473, 0.12002, 0.0, 0.01271, 0.83315, 0.5529, 0.0, -0.15237, -0.54487, 0.82456, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98911, -0.10587, 0.10225, 0.0, 0.03274, 0.83556, 0.54843, 0.0, -0.1435, -0.53911, 0.82992, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99113, -0.11404, 0.06828, 0.0, 0.05893, 0.83745, 0.54333, 0.0, -0.11914, -0.53449, 0.83674, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99225, -0.12184, 0.02452, 0.0, 0.08923, 0.83569, 0.5419, 0.0, -0.08652, -0.53551, 0.84009, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99062, -0.13539, -0.01824, 0.0, 0.12288, 0.8247, 0.55206, 0.0, -0.0597, -0.54912, 0.83361, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.98677, -0.15412, -0.05034, 0.0, 0.15483, 0.80355, 0.57474, 0.0, -0.04813, -0.57493, 0.81678, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.98311, -0.16986, -0.06813, 0.0, 0.17593, 0.7745, 0.60762, 0.0, -0.05044, -0.60934, 0.7913, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.98182, -0.17484, -0.07386, 0.0, 0.18188, 0.75539, 0.62952, 0.0, -0.05427, -0.63151, 0.77346, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98383, -0.16356, -0.07295, 0.0, 0.17243, 0.7551, 0.63253, 0.0, -0.04837, -0.63488, 0.77109, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98859, -0.13409, -0.06863, 0.0, 0.14734, 0.76598, 0.62576, 0.0, -0.03134, -0.62873, 0.77699, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99407, -0.09108, -0.05949, 0.0, 0.10842, 0.78469, 0.61033, 0.0, -0.00891, -0.61316, 0.78991, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99837, -0.03762, -0.04288, 0.0, 0.05562, 0.80865, 0.58566, 0.0, 0.01264, -0.58709, 0.80942, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99952, 0.02442, -0.01929, 0.0, -0.0097, 0.83355, 0.55236, 0.0, 0.02957, -0.55191, 0.83338, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99605, 0.08856, 0.00692, 0.0, -0.07931, 0.85161, 0.51815, 0.0, 0.03999, -0.51665, 0.85526, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.9898, 0.13939, 0.02948, 0.0, -0.13559, 0.85807, 0.49531, 0.0, 0.04374, -0.49425, 0.86822, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98597, 0.1609, 0.04437, 0.0, -0.16202, 0.85885, 0.48593, 0.0, 0.04008, -0.4863, 0.87287, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98527, 0.16143, 0.05638, 0.0, -0.16831, 0.8575, 0.48617, 0.0, 0.03014, -0.4885, 0.87205, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98393, 0.16009, 0.07908, 0.0, -0.17813, 0.84947, 0.49666, 0.0, 0.01233, -0.50276, 0.86434, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98073, 0.15425, 0.11989, 0.0, -0.19442, 0.83071, 0.52165, 0.0, -0.01913, -0.53491, 0.84469, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.97618, 0.14014, 0.16565, 0.0, -0.21116, 0.7891, 0.57683, 0.0, -0.04988, -0.59807, 0.79989, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.97455, 0.11706, 0.19117, 0.0, -0.21749, 0.70035, 0.67986, 0.0, -0.0543, -0.70414, 0.70799, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98049, 0.06598, 0.18518, 0.0, -0.19166, 0.53042, 0.82579, 0.0, -0.04373, -0.84517, 0.53271, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.98567, -0.006, 0.16856, 0.0, -0.15533, 0.35715, 0.92104, 0.0, -0.06573, -0.93403, 0.3511, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.98853, -0.02834, 0.14835, 0.0, -0.13953, 0.20453, 0.96886, 0.0, -0.0578, -0.97845, 0.19823, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.98888, -0.05701, 0.13736, 0.0, -0.13669, 0.01561, 0.99049, 0.0, -0.05861, -0.99825, 0.00764, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99054, -0.04236, 0.13055, 0.0, -0.13462, -0.11425, 0.98429, 0.0, -0.02678, -0.99255, -0.11887, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99245, -0.01507, 0.12173, 0.0, -0.12262, -0.14681, 0.98153, 0.0, 0.00308, -0.98905, -0.14755, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99396, -0.00718, 0.10946, 0.0, -0.10968, -0.0825, 0.99054, 0.0, 0.00192, -0.99657, -0.08279, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99473, -0.03029, 0.09796, 0.0, -0.09596, 0.06178, 0.99347, 0.0, -0.03615, -0.99763, 0.05854, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99208, -0.08563, 0.09188, 0.0, -0.06631, 0.2642, 0.96218, 0.0, -0.10667, -0.96066, 0.25643, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightArm": {"1": [0.8323, 0.02812, -0.55361, 0.0, -0.38624, 0.74578, -0.5428, 0.0, 0.3976, 0.6656, 0.63157, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.81104, 0.0165, -0.58476, 0.0, -0.40293, 0.74045, -0.53795, 0.0, 0.42411, 0.67191, 0.60718, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.78833, -0.00504, -0.61523, 0.0, -0.4139, 0.73551, -0.53638, 0.0, 0.45521, 0.67749, 0.57774, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.76446, -0.03575, -0.64368, 0.0, -0.41537, 0.73626, -0.53421, 0.0, 0.49301, 0.67575, 0.548, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.74075, -0.06991, -0.66813, 0.0, -0.40478, 0.7473, -0.52697, 0.0, 0.53613, 0.6608, 0.52526, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.71916, -0.09819, -0.68787, 0.0, -0.38607, 0.76663, -0.51306, 0.0, 0.57772, 0.63454, 0.51342, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.70249, -0.11421, -0.70247, 0.0, -0.36806, 0.78649, -0.49594, 0.0, 0.60913, 0.60695, 0.51047, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.69435, -0.11856, -0.7098, 0.0, -0.35702, 0.79964, -0.48281, 0.0, 0.62483, 0.58866, 0.5129, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.69793, -0.11503, -0.70687, 0.0, -0.35235, 0.80414, -0.47875, 0.0, 0.6235, 0.5832, 0.5207, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.71358, -0.1043, -0.69277, 0.0, -0.35026, 0.80328, -0.48172, 0.0, 0.60673, 0.5864, 0.53667, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.73609, -0.08392, -0.67166, 0.0, -0.35006, 0.80208, -0.48386, 0.0, 0.57933, 0.59129, 0.56103, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.75631, -0.05408, -0.65197, 0.0, -0.35509, 0.80307, -0.47853, 0.0, 0.54945, 0.59343, 0.58817, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.76772, -0.02086, -0.64044, 0.0, -0.36829, 0.80353, -0.46765, 0.0, 0.52437, 0.5949, 0.60921, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.77068, 0.00676, -0.63719, 0.0, -0.38838, 0.79773, -0.46128, 0.0, 0.50519, 0.60297, 0.61742, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.77079, 0.02263, -0.63669, 0.0, -0.40964, 0.783, -0.46809, 0.0, 0.48794, 0.62161, 0.6128, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.77438, 0.0276, -0.63212, 0.0, -0.42559, 0.76199, -0.48811, 0.0, 0.4682, 0.647, 0.60182, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.78438, 0.02774, -0.61967, 0.0, -0.43358, 0.73893, -0.51575, 0.0, 0.44358, 0.67322, 0.59162, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.8002, 0.02761, -0.5991, 0.0, -0.43332, 0.71724, -0.54571, 0.0, 0.41463, 0.69628, 0.58589, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.82037, 0.02633, -0.57122, 0.0, -0.42223, 0.70155, -0.57406, 0.0, 0.38562, 0.71213, 0.58665, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.84319, 0.01965, -0.53726, 0.0, -0.3964, 0.6978, -0.59661, 0.0, 0.36318, 0.71602, 0.59617, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.86595, 0.00448, -0.50011, 0.0, -0.35543, 0.70901, -0.60908, 0.0, 0.35186, 0.70519, 0.61556, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.88593, -0.01709, -0.46351, 0.0, -0.30517, 0.73107, -0.61025, 0.0, 0.34928, 0.68209, 0.64246, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.90265, -0.03647, -0.42883, 0.0, -0.25722, 0.75315, -0.60547, 0.0, 0.34506, 0.65683, 0.67045, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.91835, -0.04164, -0.39357, 0.0, -0.22496, 0.76326, -0.60567, 0.0, 0.32561, 0.64475, 0.69157, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.93475, -0.0241, -0.35448, 0.0, -0.21536, 0.75508, -0.61926, 0.0, 0.28258, 0.65519, 0.70062, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.94915, 0.01252, -0.31457, 0.0, -0.2228, 0.73265, -0.6431, 0.0, 0.22242, 0.68049, 0.69819, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.95706, 0.04748, -0.28596, 0.0, -0.23345, 0.71105, -0.66326, 0.0, 0.17184, 0.70154, 0.6916, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.95781, 0.05333, -0.28242, 0.0, -0.23595, 0.70701, -0.66669, 0.0, 0.16411, 0.70519, 0.68976, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.95086, 0.02107, -0.30892, 0.0, -0.2279, 0.72301, -0.65217, 0.0, 0.20961, 0.69052, 0.69228, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.93357, -0.0278, -0.35732, 0.0, -0.21871, 0.74564, -0.62944, 0.0, 0.28393, 0.66577, 0.69002, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.90977, -0.05965, -0.4108, 0.0, -0.22435, 0.76198, -0.6075, 0.0, 0.34926, 0.64485, 0.67984, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.88809, -0.05714, -0.4561, 0.0, -0.2525, 0.76849, -0.58793, 0.0, 0.38411, 0.6373, 0.66806, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.87044, -0.02853, -0.49145, 0.0, -0.29668, 0.76624, -0.56996, 0.0, 0.39283, 0.64192, 0.6585, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.85276, 0.00536, -0.52228, 0.0, -0.34439, 0.75757, -0.55452, 0.0, 0.39269, 0.65274, 0.64786, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.8323, 0.02812, -0.55361, 0.0, -0.38624, 0.74578, -0.5428, 0.0, 0.3976, 0.6656, 0.63157, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandThumb3": {"1": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99574, 0.03019, -0.08712, -0.0, -0.01309, 0.98159, 0.19055, 0.0, 0.09127, -0.18859, 0.9778, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftUpLeg": {"1": [0.99427, 0.07831, 0.07274, -0.0, -0.03159, 0.86549, -0.49993, -0.0, -0.10211, 0.49477, 0.86301, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99696, 0.06637, 0.04072, -0.0, -0.03323, 0.83557, -0.54837, -0.0, -0.07042, 0.54535, 0.83524, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99758, 0.05813, 0.03816, -0.0, -0.02539, 0.81536, -0.57839, -0.0, -0.06474, 0.57602, 0.81487, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99668, 0.05281, 0.06198, -0.0, -0.00451, 0.79582, -0.60552, -0.0, -0.0813, 0.60323, 0.79342, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99377, 0.05043, 0.09942, -0.0, 0.02461, 0.77059, -0.63686, -0.0, -0.10872, 0.63534, 0.76454, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98997, 0.04696, 0.13322, -0.0, 0.0525, 0.75325, -0.65563, -0.0, -0.13113, 0.65605, 0.74323, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98893, 0.03289, 0.1447, -0.0, 0.0688, 0.76236, -0.64349, -0.0, -0.13148, 0.64632, 0.75166, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99222, 0.00401, 0.12443, -0.0, 0.07268, 0.79279, -0.60514, -0.0, -0.10107, 0.60948, 0.78633, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99646, -0.03223, 0.07767, -0.0, 0.0705, 0.82358, -0.5628, -0.0, -0.04583, 0.56628, 0.82294, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99784, -0.06322, 0.01791, -0.0, 0.06309, 0.84559, -0.53009, -0.0, 0.01837, 0.53007, 0.84775, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99591, -0.08038, -0.04128, -0.0, 0.04888, 0.86349, -0.50199, -0.0, 0.076, 0.49792, 0.86389, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99173, -0.08658, -0.09471, -0.0, 0.03237, 0.88303, -0.46821, -0.0, 0.12417, 0.46127, 0.87853, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.98729, -0.08811, -0.1323, -0.0, 0.02485, 0.90761, -0.41907, -0.0, 0.157, 0.41046, 0.89826, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98523, -0.09215, -0.1443, -0.0, 0.03473, 0.93284, -0.35861, -0.0, 0.16765, 0.3483, 0.92227, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98619, -0.10232, -0.13021, -0.0, 0.05977, 0.95321, -0.29635, -0.0, 0.15444, 0.28447, 0.94616, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98839, -0.11503, -0.09927, -0.0, 0.08972, 0.96912, -0.22968, -0.0, 0.12262, 0.21811, 0.96819, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99001, -0.12762, -0.06001, -0.0, 0.117, 0.98085, -0.15571, -0.0, 0.07874, 0.14713, 0.98598, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98955, -0.14314, -0.01728, -0.0, 0.14149, 0.98714, -0.07435, -0.0, 0.0277, 0.07113, 0.99708, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.9858, -0.16583, 0.02659, -0.0, 0.16567, 0.98615, 0.0082, -0.0, -0.02759, -0.00368, 0.99961, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.97835, -0.19417, 0.07156, -0.0, 0.18784, 0.97838, 0.08654, -0.0, -0.08682, -0.07122, 0.99367, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.96734, -0.22288, 0.12074, -0.0, 0.2024, 0.96591, 0.1614, -0.0, -0.1526, -0.13169, 0.97948, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.95226, -0.24971, 0.17562, -0.0, 0.2053, 0.94957, 0.23699, -0.0, -0.22594, -0.18962, 0.95551, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.933, -0.2755, 0.23156, -0.0, 0.19653, 0.92904, 0.31346, -0.0, -0.30149, -0.24695, 0.92093, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.91093, -0.29613, 0.28726, -0.0, 0.17511, 0.90796, 0.38071, -0.0, -0.37356, -0.2965, 0.87894, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.88698, -0.30171, 0.34963, -0.0, 0.1369, 0.89483, 0.42489, -0.0, -0.44105, -0.329, 0.835, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.85884, -0.28233, 0.42743, -0.0, 0.07863, 0.89718, 0.43462, -0.0, -0.50618, -0.33966, 0.79272, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.82908, -0.22424, 0.51219, -0.0, 0.0089, 0.92123, 0.38892, -0.0, -0.55906, -0.31789, 0.76577, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.8133, -0.14612, 0.5632, -0.0, -0.04927, 0.94718, 0.31689, -0.0, -0.57976, -0.28547, 0.76314, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.82286, -0.06084, 0.56498, -0.0, -0.09156, 0.96707, 0.23749, -0.0, -0.56083, -0.24715, 0.79019, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.85649, 0.03024, 0.51527, -0.0, -0.1168, 0.98374, 0.13641, -0.0, -0.50277, -0.17702, 0.8461, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.90593, 0.09943, 0.41159, -0.0, -0.10995, 0.99394, 0.0019, -0.0, -0.4089, -0.04698, 0.91137, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.94959, 0.12767, 0.28631, -0.0, -0.08452, 0.98376, -0.15834, -0.0, -0.30188, 0.12615, 0.94496, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.97622, 0.11928, 0.18104, -0.0, -0.05829, 0.94869, -0.31078, -0.0, -0.20882, 0.29284, 0.93308, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98883, 0.09693, 0.1132, -0.0, -0.03992, 0.90409, -0.42546, -0.0, -0.14358, 0.41619, 0.89787, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99427, 0.07831, 0.07274, -0.0, -0.03159, 0.86549, -0.49993, -0.0, -0.10211, 0.49477, 0.86301, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandThumb3": {"1": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.88166, -0.09774, 0.46164, -0.0, 0.02557, 0.98677, 0.1601, -0.0, -0.47119, -0.12935, 0.8725, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftForeArm": {"1": [0.98053, 0.00214, 0.19636, 0.0, -0.00232, 1.0, 0.00072, 0.0, -0.19636, -0.00116, 0.98053, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98245, 0.002, 0.18651, 0.0, -0.00231, 1.0, 0.00144, 0.0, -0.1865, -0.00185, 0.98245, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98445, 0.00187, 0.17568, 0.0, -0.00229, 0.99999, 0.00223, 0.0, -0.17567, -0.0026, 0.98445, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.9863, 0.00174, 0.16493, 0.0, -0.00226, 0.99999, 0.00301, 0.0, -0.16492, -0.00334, 0.9863, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.98781, 0.00163, 0.15567, 0.0, -0.00223, 0.99999, 0.00368, 0.0, -0.15567, -0.00399, 0.9878, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98897, 0.00155, 0.14814, 0.0, -0.0022, 0.99999, 0.00423, 0.0, -0.14813, -0.00451, 0.98896, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99014, 0.00147, 0.14008, 0.0, -0.00217, 0.99999, 0.00481, 0.0, -0.14007, -0.00507, 0.99013, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.9918, 0.00136, 0.12778, 0.0, -0.0021, 0.99998, 0.0057, 0.0, -0.12777, -0.00592, 0.99179, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99409, 0.0012, 0.10854, 0.0, -0.00198, 0.99997, 0.00709, 0.0, -0.10853, -0.00726, 0.99407, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99657, 0.00102, 0.08278, 0.0, -0.00177, 0.99996, 0.00893, 0.0, -0.08277, -0.00905, 0.99653, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.9985, 0.00089, 0.0548, 0.0, -0.00149, 0.99994, 0.01092, 0.0, -0.05479, -0.01099, 0.99844, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.9995, 0.00082, 0.03163, 0.0, -0.00122, 0.99992, 0.01257, 0.0, -0.03162, -0.0126, 0.99942, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99981, 0.0008, 0.01969, 0.0, -0.00106, 0.99991, 0.01341, 0.0, -0.01967, -0.01343, 0.99972, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99976, 0.0008, 0.02193, 0.0, -0.00109, 0.99991, 0.01325, 0.0, -0.02192, -0.01327, 0.99967, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99926, 0.00084, 0.03842, 0.0, -0.0013, 0.99993, 0.01209, 0.0, -0.03841, -0.01213, 0.99919, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99766, 0.00095, 0.06837, 0.0, -0.00163, 0.99995, 0.00996, 0.0, -0.06836, -0.01005, 0.99761, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99383, 0.00121, 0.11095, 0.0, -0.00199, 0.99997, 0.00691, 0.0, -0.11094, -0.00709, 0.9938, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98617, 0.00175, 0.16573, 0.0, -0.00227, 0.99999, 0.00295, 0.0, -0.16573, -0.00329, 0.98617, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.97261, 0.00269, 0.23244, 0.0, -0.0023, 1.0, -0.00193, 0.0, -0.23244, 0.00134, 0.97261, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.95147, 0.00416, 0.30772, 0.0, -0.00193, 0.99997, -0.00753, 0.0, -0.30774, 0.00657, 0.95145, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.92349, 0.0061, 0.38357, 0.0, -0.00109, 0.99991, -0.01327, 0.0, -0.38361, 0.01184, 0.92342, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.89209, 0.00828, 0.45178, 0.0, 0.00011, 0.99983, -0.01853, 0.0, -0.45186, 0.01658, 0.89194, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.86049, 0.01047, 0.50935, 0.0, 0.00147, 0.99973, -0.02304, 0.0, -0.50946, 0.02057, 0.86025, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.82959, 0.01262, 0.55823, 0.0, 0.00292, 0.99963, -0.02693, 0.0, -0.55837, 0.02397, 0.82925, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.7995, 0.01471, 0.60049, 0.0, 0.00441, 0.99953, -0.03034, 0.0, -0.60065, 0.02691, 0.79906, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.77323, 0.01653, 0.63391, 0.0, 0.00576, 0.99944, -0.03308, 0.0, -0.6341, 0.02923, 0.7727, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.75846, 0.01755, 0.65148, 0.0, 0.00653, 0.99938, -0.03453, 0.0, -0.65168, 0.03045, 0.75788, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.7644, 0.01714, 0.64452, 0.0, 0.00622, 0.9994, -0.03396, 0.0, -0.64471, 0.02996, 0.76384, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.79488, 0.01503, 0.60659, 0.0, 0.00464, 0.99951, -0.03084, 0.0, -0.60675, 0.02733, 0.79442, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.84368, 0.01164, 0.53672, 0.0, 0.00225, 0.99968, -0.02521, 0.0, -0.53684, 0.02248, 0.84339, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.89667, 0.00796, 0.44262, 0.0, -8e-05, 0.99984, -0.01782, 0.0, -0.44269, 0.01594, 0.89653, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.93938, 0.00499, 0.34283, 0.0, -0.0016, 0.99995, -0.01018, 0.0, -0.34286, 0.00901, 0.93934, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.96524, 0.0032, 0.26135, 0.0, -0.00221, 0.99999, -0.00407, 0.0, -0.26136, 0.00335, 0.96524, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.97712, 0.00237, 0.21269, 0.0, -0.00233, 1.0, -0.00048, 0.0, -0.21269, -3e-05, 0.97712, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98053, 0.00214, 0.19636, 0.0, -0.00232, 1.0, 0.00072, 0.0, -0.19636, -0.00116, 0.98053, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftToe_End": {"1": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightFoot": {"1": [0.99961, -0.00707, -0.02692, 0.0, 0.00407, 0.99394, -0.10985, 0.0, 0.02754, 0.1097, 0.99358, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99944, -0.02041, -0.02649, 0.0, 0.01688, 0.99171, -0.12736, 0.0, 0.02887, 0.12684, 0.9915, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99973, -0.01226, -0.01981, 0.0, 0.00913, 0.98854, -0.15069, 0.0, 0.02143, 0.15047, 0.98838, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.9999, 0.01122, -0.00844, 0.0, -0.0127, 0.979, -0.20348, 0.0, 0.00598, 0.20356, 0.97904, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99936, 0.03574, 0.00236, 0.0, -0.03359, 0.95799, -0.28483, 0.0, -0.01244, 0.28457, 0.95857, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99819, 0.05773, 0.01683, 0.0, -0.04775, 0.93104, -0.36177, 0.0, -0.03655, 0.36031, 0.93212, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99615, 0.07836, 0.0392, 0.0, -0.05363, 0.89905, -0.43454, 0.0, -0.06929, 0.43077, 0.8998, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99356, 0.09562, 0.06082, 0.0, -0.05236, 0.86329, -0.50199, 0.0, -0.1005, 0.49557, 0.86273, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99134, 0.11405, 0.06508, 0.0, -0.06124, 0.83998, -0.53916, 0.0, -0.11616, 0.5305, 0.83969, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99103, 0.12544, 0.04605, 0.0, -0.09076, 0.88479, -0.45706, 0.0, -0.09808, 0.44879, 0.88824, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99671, 0.079, 0.01825, 0.0, -0.07286, 0.97143, -0.22589, 0.0, -0.03557, 0.22381, 0.97398, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99868, -0.02738, -0.04339, 0.0, 0.03024, 0.99732, 0.06663, 0.0, 0.04145, -0.06786, 0.99683, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99733, -0.04791, -0.0551, 0.0, 0.05333, 0.99341, 0.10148, 0.0, 0.04988, -0.10415, 0.99331, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99684, -0.0622, -0.04941, 0.0, 0.06554, 0.99545, 0.06916, 0.0, 0.04489, -0.07218, 0.99638, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99807, -0.05706, -0.02435, 0.0, 0.05763, 0.99806, 0.02365, 0.0, 0.02295, -0.025, 0.99942, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.9992, -0.03935, 0.0068, 0.0, 0.03931, 0.99921, 0.00577, 0.0, -0.00702, -0.0055, 0.99996, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99933, -0.02393, 0.02769, 0.0, 0.02387, 0.99971, 0.00265, 0.0, -0.02774, -0.00199, 0.99961, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99927, -0.02132, 0.03172, 0.0, 0.02112, 0.99975, 0.0067, 0.0, -0.03185, -0.00603, 0.99947, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99899, -0.03414, 0.02937, 0.0, 0.03362, 0.99927, 0.01804, 0.0, -0.02997, -0.01703, 0.99941, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99743, -0.06345, 0.03324, 0.0, 0.06227, 0.99743, 0.03553, 0.0, -0.03541, -0.03337, 0.99882, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99355, -0.10246, 0.04853, 0.0, 0.10046, 0.99405, 0.04199, 0.0, -0.05254, -0.03685, 0.99794, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98804, -0.1395, 0.0657, 0.0, 0.13682, 0.98963, 0.04372, 0.0, -0.07112, -0.03421, 0.99688, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98559, -0.15228, 0.07363, 0.0, 0.15113, 0.98829, 0.021, 0.0, -0.07597, -0.00957, 0.99706, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98943, -0.1352, 0.05234, 0.0, 0.13494, 0.99082, 0.00863, 0.0, -0.05302, -0.00147, 0.99859, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99672, -0.08088, 0.00115, 0.0, 0.08066, 0.99483, 0.06172, 0.0, -0.00614, -0.06142, 0.99809, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.9979, -0.00457, -0.06466, 0.0, 0.01808, 0.97752, 0.21008, 0.0, 0.06224, -0.2108, 0.97554, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98978, 0.04423, -0.13561, 0.0, 0.01085, 0.9246, 0.38078, 0.0, 0.14222, -0.37836, 0.91467, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.98623, 0.08961, -0.13897, 0.0, -0.04746, 0.95847, 0.28121, 0.0, 0.1584, -0.27075, 0.94953, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.9855, 0.0846, -0.1471, 0.0, -0.06546, 0.9893, 0.13042, 0.0, 0.15656, -0.11889, 0.98049, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.98596, 0.07835, -0.14746, 0.0, -0.07838, 0.99691, 0.00565, 0.0, 0.14744, 0.00598, 0.98905, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.98675, 0.06739, -0.1476, 0.0, -0.08052, 0.99314, -0.08485, 0.0, 0.14087, 0.09561, 0.9854, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.98858, 0.05835, -0.13892, 0.0, -0.07676, 0.98839, -0.13113, 0.0, 0.12965, 0.14029, 0.98158, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99196, 0.04111, -0.11972, 0.0, -0.05846, 0.98767, -0.14523, 0.0, 0.11227, 0.15106, 0.98213, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99638, 0.01877, -0.08287, 0.0, -0.02982, 0.99051, -0.13416, 0.0, 0.07957, 0.13615, 0.98749, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99961, -0.00707, -0.02692, 0.0, 0.00407, 0.99394, -0.10985, 0.0, 0.02754, 0.1097, 0.99358, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:HeadTop_End": {"1": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandThumb2": {"1": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.7251, 0.14598, 0.67299, -0.0, -0.21253, 0.97701, 0.01706, -0.0, -0.65503, -0.1554, 0.73945, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandThumb1": {"1": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98792, -0.14798, -0.04592, 0.0, 0.1287, 0.94876, -0.28862, 0.0, 0.08628, 0.27922, 0.95634, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:Neck": {"1": [0.99994, -0.00602, 0.00957, 0.0, 0.00276, 0.95084, 0.30967, 0.0, -0.01096, -0.30963, 0.95079, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99999, -0.00261, 0.00275, 0.0, 0.00166, 0.95292, 0.30322, 0.0, -0.00341, -0.30321, 0.95292, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99998, 0.00349, -0.00529, 0.0, -0.00176, 0.95474, 0.29744, 0.0, 0.00608, -0.29742, 0.95473, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99982, 0.01228, -0.0144, 0.0, -0.0075, 0.9557, 0.29424, 0.0, 0.01737, -0.29407, 0.95562, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99945, 0.02301, -0.02387, 0.0, -0.01495, 0.95537, 0.29502, 0.0, 0.02959, -0.2945, 0.95519, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.9989, 0.03412, -0.03214, 0.0, -0.02294, 0.95385, 0.29942, 0.0, 0.04087, -0.29835, 0.95358, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99834, 0.04383, -0.03732, 0.0, -0.03035, 0.95164, 0.3057, 0.0, 0.04891, -0.30406, 0.9514, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99798, 0.05073, -0.03834, 0.0, -0.03625, 0.94927, 0.31236, 0.0, 0.05224, -0.31034, 0.94919, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99787, 0.05426, -0.03612, 0.0, -0.03995, 0.94692, 0.31899, 0.0, 0.05152, -0.31687, 0.94707, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99794, 0.05487, -0.03326, 0.0, -0.04108, 0.94459, 0.32566, 0.0, 0.04928, -0.32363, 0.9449, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99806, 0.05355, -0.0319, 0.0, -0.03996, 0.94241, 0.33206, 0.0, 0.04784, -0.33014, 0.94272, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99817, 0.05131, -0.0319, 0.0, -0.03756, 0.94062, 0.33739, 0.0, 0.04732, -0.33557, 0.94083, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99832, 0.04876, -0.03115, 0.0, -0.03525, 0.93943, 0.34092, 0.0, 0.04589, -0.33925, 0.93958, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99854, 0.04632, -0.02794, 0.0, -0.03399, 0.93903, 0.34215, 0.0, 0.04208, -0.3407, 0.93923, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99876, 0.0442, -0.02277, 0.0, -0.0338, 0.93952, 0.34081, 0.0, 0.03646, -0.33962, 0.93986, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99895, 0.04222, -0.01775, 0.0, -0.03377, 0.94077, 0.33735, 0.0, 0.03094, -0.3364, 0.94121, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99911, 0.03976, -0.01418, 0.0, -0.03277, 0.94232, 0.3331, 0.0, 0.0266, -0.33234, 0.94278, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99928, 0.03607, -0.01133, 0.0, -0.03034, 0.94376, 0.32924, 0.0, 0.02257, -0.32866, 0.94418, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.9995, 0.03083, -0.00744, 0.0, -0.02672, 0.94498, 0.32603, 0.0, 0.01708, -0.32566, 0.94533, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.9997, 0.02424, -0.00154, 0.0, -0.02244, 0.94606, 0.3232, 0.0, 0.00929, -0.32307, 0.94633, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99984, 0.0168, 0.00585, 0.0, -0.01779, 0.94697, 0.32083, 0.0, -0.00015, -0.32089, 0.94712, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99987, 0.00899, 0.01345, 0.0, -0.01282, 0.94757, 0.3193, 0.0, -0.00988, -0.31943, 0.94756, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.9998, 0.00121, 0.0199, 0.0, -0.00748, 0.94787, 0.31858, 0.0, -0.01847, -0.31866, 0.94769, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99969, -0.006, 0.02418, 0.0, -0.002, 0.94809, 0.31799, 0.0, -0.02483, -0.31794, 0.94779, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99959, -0.01161, 0.02602, 0.0, 0.00277, 0.94847, 0.31685, 0.0, -0.02836, -0.31665, 0.94812, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99956, -0.0143, 0.02615, 0.0, 0.00533, 0.94902, 0.31518, 0.0, -0.02932, -0.3149, 0.94867, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99957, -0.01338, 0.02591, 0.0, 0.00458, 0.94953, 0.31365, 0.0, -0.0288, -0.3134, 0.94918, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99961, -0.00973, 0.02618, 0.0, 0.00104, 0.94977, 0.31296, 0.0, -0.02791, -0.31281, 0.9494, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99963, -0.00554, 0.0268, 0.0, -0.00315, 0.94959, 0.31348, 0.0, -0.02719, -0.31345, 0.94921, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99963, -0.00284, 0.02694, 0.0, -0.0058, 0.94901, 0.31518, 0.0, -0.02646, -0.31522, 0.94865, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99966, -0.00228, 0.02591, 0.0, -0.00606, 0.94831, 0.31728, 0.0, -0.0253, -0.31733, 0.94798, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99972, -0.00318, 0.02354, 0.0, -0.00448, 0.94797, 0.31832, 0.0, -0.02333, -0.31834, 0.94769, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99979, -0.00446, 0.01988, 0.0, -0.00208, 0.94839, 0.31709, 0.0, -0.02027, -0.31707, 0.94819, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99987, -0.00546, 0.01513, 0.0, 0.00044, 0.9495, 0.31378, 0.0, -0.01608, -0.31373, 0.94938, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99994, -0.00602, 0.00957, 0.0, 0.00276, 0.95084, 0.30967, 0.0, -0.01096, -0.30963, 0.95079, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandIndex3": {"1": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99477, 0.05234, 0.0877, -0.0, -0.01366, 0.91917, -0.39362, -0.0, -0.10121, 0.39037, 0.91508, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightToe_End": {"1": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, 0.0, 0.0, -0.0, -0.0, 1.0, 0.0, -0.0, -0.0, -0.0, 1.0, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandIndex2": {"1": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98473, -0.04585, -0.16793, -0.0, 0.05565, 0.99699, 0.05407, 0.0, 0.16494, -0.06259, 0.98432, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:Head": {"1": [0.99695, -0.07615, -0.01722, 0.0, 0.0767, 0.9144, 0.39749, -0.0, -0.01453, -0.39759, 0.91745, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99599, -0.0891, -0.0079, 0.0, 0.08474, 0.91161, 0.40222, -0.0, -0.02864, -0.40128, 0.91551, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99548, -0.09493, 0.00077, 0.0, 0.08648, 0.91021, 0.40501, -0.0, -0.03915, -0.40311, 0.91431, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99562, -0.093, 0.0094, 0.0, 0.0813, 0.91114, 0.404, -0.0, -0.04614, -0.40147, 0.91471, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99634, -0.08357, 0.01814, 0.0, 0.06938, 0.91402, 0.3997, -0.0, -0.04998, -0.39698, 0.91647, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99737, -0.06754, 0.02636, 0.0, 0.05168, 0.91726, 0.39492, -0.0, -0.05085, -0.39252, 0.91834, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99836, -0.04676, 0.03305, 0.0, 0.03014, 0.91991, 0.39098, -0.0, -0.04869, -0.38934, 0.91981, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99897, -0.02535, 0.03756, 0.0, 0.00892, 0.92264, 0.38557, -0.0, -0.04443, -0.38484, 0.92191, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99918, -0.00939, 0.03946, 0.0, -0.00616, 0.92646, 0.37634, -0.0, -0.04009, -0.37627, 0.92564, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99928, -0.00368, 0.0378, 0.0, -0.01041, 0.93067, 0.36571, -0.0, -0.03652, -0.36584, 0.92996, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99948, -0.00797, 0.03137, 0.0, -0.00386, 0.93292, 0.36007, -0.0, -0.03213, -0.36001, 0.9324, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99966, -0.01599, 0.02077, 0.0, 0.00733, 0.93125, 0.36431, -0.0, -0.02517, -0.36403, 0.93105, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99976, -0.01952, 0.00975, 0.0, 0.01438, 0.92561, 0.37822, -0.0, -0.01641, -0.37799, 0.92567, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99989, -0.01448, 0.00276, 0.0, 0.01219, 0.91748, 0.39759, -0.0, -0.00829, -0.39751, 0.91756, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99999, -0.0033, 0.0006, 0.0, 0.00275, 0.90881, 0.41719, -0.0, -0.00192, -0.41719, 0.90882, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99997, 0.00826, -0.00056, 0.0, -0.0072, 0.90139, 0.43295, -0.0, 0.00408, -0.43293, 0.90142, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99986, 0.01583, -0.00554, 0.0, -0.01174, 0.89645, 0.443, -0.0, 0.01198, -0.44287, 0.89651, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99969, 0.01919, -0.016, 0.0, -0.00999, 0.89407, 0.44782, -0.0, 0.02289, -0.44752, 0.89398, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99931, 0.0216, -0.03012, 0.0, -0.00577, 0.89338, 0.44927, -0.0, 0.03661, -0.44879, 0.89289, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99864, 0.02629, -0.04504, 0.0, -0.00328, 0.89357, 0.44892, -0.0, 0.05205, -0.44816, 0.89244, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99777, 0.03249, -0.05829, 0.0, -0.00302, 0.89454, 0.44697, -0.0, 0.06667, -0.4458, 0.89265, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99709, 0.03496, -0.06775, 0.0, -0.00137, 0.89674, 0.44256, -0.0, 0.07623, -0.44118, 0.89417, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99698, 0.02825, -0.07237, 0.0, 0.00602, 0.90063, 0.43454, -0.0, 0.07745, -0.43366, 0.89774, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99721, 0.01257, -0.07365, 0.0, 0.01972, 0.90646, 0.42184, -0.0, 0.07206, -0.42211, 0.90367, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.9972, -0.00415, -0.07465, 0.0, 0.03405, 0.91409, 0.40409, -0.0, 0.06656, -0.4055, 0.91167, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99704, -0.01127, -0.07609, 0.0, 0.03967, 0.92285, 0.38311, -0.0, 0.0659, -0.385, 0.92056, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99721, -0.00442, -0.07457, 0.0, 0.03132, 0.93101, 0.36366, -0.0, 0.06782, -0.36497, 0.92854, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99774, 0.01043, -0.06634, 0.0, 0.01357, 0.93618, 0.35125, -0.0, 0.06577, -0.35136, 0.93393, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99837, 0.02219, -0.05251, 0.0, -0.00249, 0.93721, 0.34876, -0.0, 0.05695, -0.34806, 0.93574, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99898, 0.02347, -0.03869, 0.0, -0.00822, 0.93487, 0.35489, -0.0, 0.0445, -0.35421, 0.93411, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99948, 0.01359, -0.0294, 0.0, -0.00191, 0.93081, 0.36549, -0.0, 0.03234, -0.36525, 0.93035, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99969, -0.00473, -0.02447, 0.0, 0.01359, 0.92646, 0.37614, -0.0, 0.02089, -0.37636, 0.92624, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99937, -0.02821, -0.02131, 0.0, 0.03424, 0.92248, 0.38453, -0.0, 0.00881, -0.38502, 0.92287, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99841, -0.05319, -0.01869, 0.0, 0.05626, 0.91863, 0.39109, -0.0, -0.00363, -0.39152, 0.92016, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99695, -0.07615, -0.01722, 0.0, 0.0767, 0.9144, 0.39749, -0.0, -0.01453, -0.39759, 0.91745, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightToeBase": {"1": [0.99973, 0.01752, 0.01515, 0.0, -0.01599, 0.99524, -0.0961, -0.0, -0.01676, 0.09583, 0.99526, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99972, 0.0178, 0.01537, 0.0, -0.01622, 0.9951, -0.09753, -0.0, -0.01703, 0.09726, 0.99511, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99966, 0.01977, 0.01689, 0.0, -0.01784, 0.994, -0.10787, -0.0, -0.01893, 0.10753, 0.99402, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.9995, 0.0244, 0.02035, 0.0, -0.02151, 0.99105, -0.13171, -0.0, -0.02338, 0.13121, 0.99108, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99925, 0.03005, 0.02434, 0.0, -0.02577, 0.98674, -0.16022, -0.0, -0.02883, 0.15948, 0.98678, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99895, 0.03596, 0.02826, 0.0, -0.02998, 0.98145, -0.18934, -0.0, -0.03454, 0.1883, 0.9815, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99847, 0.04416, 0.03329, 0.0, -0.0354, 0.97288, -0.22859, -0.0, -0.04248, 0.22706, 0.97295, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99715, 0.06208, 0.04281, 0.0, -0.04579, 0.94954, -0.3103, -0.0, -0.05991, 0.30745, 0.94968, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99299, 0.10301, 0.05796, 0.0, -0.06291, 0.87577, -0.47861, -0.0, -0.10006, 0.47161, 0.87611, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99003, 0.12603, 0.06292, 0.0, -0.06899, 0.82328, -0.56343, -0.0, -0.12281, 0.55347, 0.82377, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.93806, 0.34528, 0.02862, 0.0, -0.25487, 0.74368, -0.61805, -0.0, -0.23468, 0.57248, 0.78562, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.85302, 0.49689, -0.15957, 0.0, -0.43876, 0.51725, -0.7348, -0.0, -0.28258, 0.69681, 0.65924, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.86878, 0.48087, -0.1183, 0.0, -0.48089, 0.7622, -0.43336, -0.0, -0.11822, 0.43338, 0.89342, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.91689, 0.39118, -0.07934, 0.0, -0.39266, 0.84831, -0.35524, -0.0, -0.07166, 0.35687, 0.9314, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.93803, 0.33949, -0.06962, 0.0, -0.32493, 0.7917, -0.51734, -0.0, -0.12051, 0.5079, 0.85295, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.96972, 0.2441, -0.00776, 0.0, -0.20548, 0.79829, -0.56614, -0.0, -0.132, 0.55059, 0.82427, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98629, 0.16279, 0.02716, 0.0, -0.13236, 0.87849, -0.45907, -0.0, -0.09859, 0.44918, 0.88798, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99822, 0.05735, 0.01663, 0.0, -0.0547, 0.98991, -0.13073, -0.0, -0.02396, 0.12959, 0.99128, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99998, 0.00494, 0.00458, 0.0, -0.00481, 0.9996, -0.02795, -0.0, -0.00471, 0.02793, 0.9996, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99979, 0.01539, 0.01346, 0.0, -0.0142, 0.99629, -0.08483, -0.0, -0.01472, 0.08462, 0.9963, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99937, 0.02748, 0.02255, 0.0, -0.02387, 0.9888, -0.14735, -0.0, -0.02635, 0.14672, 0.98883, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99906, 0.03398, 0.02697, 0.0, -0.02859, 0.98331, -0.17965, -0.0, -0.03262, 0.17871, 0.98336, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99892, 0.03663, 0.02868, 0.0, -0.03043, 0.98081, -0.19257, -0.0, -0.03518, 0.19149, 0.98086, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99891, 0.03681, 0.0288, 0.0, -0.03056, 0.98063, -0.19348, -0.0, -0.03537, 0.19238, 0.98068, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99867, 0.04086, 0.03132, 0.0, -0.03327, 0.9765, -0.21292, -0.0, -0.03928, 0.2116, 0.97657, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.9988, 0.0388, 0.03005, 0.0, -0.03191, 0.97865, -0.20306, -0.0, -0.03729, 0.20185, 0.97871, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99961, 0.02142, 0.01814, 0.0, -0.01917, 0.99302, -0.11641, -0.0, -0.02051, 0.11602, 0.99304, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.9997, 0.01849, 0.01591, 0.0, -0.01679, 0.99473, -0.10119, -0.0, -0.0177, 0.10089, 0.99474, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99969, 0.01892, 0.01624, 0.0, -0.01714, 0.99449, -0.10343, -0.0, -0.01811, 0.10312, 0.9945, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99974, 0.01726, 0.01495, 0.0, -0.01577, 0.99538, -0.09472, -0.0, -0.01651, 0.09445, 0.99539, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99975, 0.01692, 0.01468, 0.0, -0.01548, 0.99555, -0.0929, -0.0, -0.01618, 0.09265, 0.99557, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99974, 0.01733, 0.015, 0.0, -0.01582, 0.99535, -0.09506, -0.0, -0.01658, 0.0948, 0.99536, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99973, 0.01752, 0.01515, 0.0, -0.01599, 0.99525, -0.09608, -0.0, -0.01676, 0.09581, 0.99526, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99973, 0.01758, 0.0152, 0.0, -0.01604, 0.99521, -0.09642, -0.0, -0.01682, 0.09615, 0.99522, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99973, 0.01752, 0.01515, 0.0, -0.01599, 0.99524, -0.0961, -0.0, -0.01676, 0.09583, 0.99526, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightShoulder": {"1": [0.98975, -0.11585, -0.08352, 0.0, 0.08551, 0.94908, -0.30322, -0.0, 0.1144, 0.29297, 0.94925, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.98716, -0.11917, -0.1064, 0.0, 0.0872, 0.95997, -0.2662, -0.0, 0.13387, 0.2535, 0.95803, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.98419, -0.12149, -0.12885, 0.0, 0.09042, 0.97034, -0.22421, -0.0, 0.15227, 0.20902, 0.96598, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.9813, -0.123, -0.14807, 0.0, 0.09486, 0.97833, -0.18405, -0.0, 0.1675, 0.16656, 0.9717, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.9788, -0.12459, -0.16257, 0.0, 0.09925, 0.98281, -0.15565, -0.0, 0.17917, 0.13621, 0.97434, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.97673, -0.12719, -0.17268, 0.0, 0.10229, 0.98396, -0.14616, -0.0, 0.1885, 0.12509, 0.97407, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.97535, -0.13057, -0.17789, 0.0, 0.10313, 0.98241, -0.15566, -0.0, 0.19508, 0.13348, 0.97166, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.97551, -0.1331, -0.17514, 0.0, 0.10159, 0.97877, -0.17801, -0.0, 0.19511, 0.15586, 0.96832, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.97763, -0.13298, -0.16293, 0.0, 0.09799, 0.97351, -0.20659, -0.0, 0.18609, 0.186, 0.96477, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.98073, -0.1301, -0.14573, 0.0, 0.09267, 0.96652, -0.23927, -0.0, 0.17198, 0.22115, 0.95996, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.98323, -0.12646, -0.1314, 0.0, 0.08594, 0.95678, -0.27781, -0.0, 0.16085, 0.26186, 0.95161, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.98433, -0.12489, -0.12449, 0.0, 0.07894, 0.94337, -0.32222, -0.0, 0.15768, 0.30734, 0.93844, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.98413, -0.12699, -0.12395, 0.0, 0.07379, 0.92809, -0.36498, -0.0, 0.16139, 0.35004, 0.92273, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.98311, -0.13214, -0.12663, 0.0, 0.07243, 0.91631, -0.39387, -0.0, 0.16808, 0.37804, 0.9104, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98183, -0.1379, -0.13038, 0.0, 0.07471, 0.91239, -0.40244, -0.0, 0.17446, 0.38538, 0.90611, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98095, -0.14131, -0.13327, 0.0, 0.07835, 0.91567, -0.39422, -0.0, 0.17774, 0.37627, 0.9093, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.98127, -0.14022, -0.13206, 0.0, 0.08125, 0.92294, -0.37626, -0.0, 0.17465, 0.35849, 0.91705, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.98315, -0.1348, -0.12346, 0.0, 0.08318, 0.93135, -0.3545, -0.0, 0.16277, 0.33826, 0.92687, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.98609, -0.12757, -0.10652, 0.0, 0.08507, 0.93806, -0.33586, -0.0, 0.14276, 0.32212, 0.93587, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.98919, -0.12109, -0.08273, 0.0, 0.08763, 0.94038, -0.32864, -0.0, 0.11759, 0.31784, 0.94082, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99182, -0.11556, -0.05413, 0.0, 0.09066, 0.93664, -0.33836, -0.0, 0.0898, 0.33068, 0.93946, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.9938, -0.10888, -0.0223, 0.0, 0.09341, 0.92699, -0.36326, -0.0, 0.06022, 0.35893, 0.93142, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99497, -0.09956, 0.01085, 0.0, 0.09577, 0.91425, -0.39367, -0.0, 0.02928, 0.39273, 0.91919, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99516, -0.08944, 0.04078, 0.0, 0.0983, 0.90411, -0.41584, -0.0, 0.00033, 0.41784, 0.90852, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99479, -0.08314, 0.05895, 0.0, 0.10026, 0.90216, -0.41959, -0.0, -0.01829, 0.42332, 0.9058, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99485, -0.08413, 0.05651, 0.0, 0.09987, 0.90852, -0.40572, -0.0, -0.01721, 0.40927, 0.91225, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99526, -0.09142, 0.03316, 0.0, 0.09712, 0.91678, -0.3874, -0.0, 0.00502, 0.38879, 0.92131, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99493, -0.10055, 0.00196, 0.0, 0.09373, 0.92002, -0.38051, -0.0, 0.03645, 0.37876, 0.92478, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99407, -0.10715, -0.01834, 0.0, 0.09173, 0.91731, -0.38747, -0.0, 0.05834, 0.38349, 0.9217, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99381, -0.10952, -0.01884, 0.0, 0.09321, 0.91381, -0.3953, -0.0, 0.06051, 0.3911, 0.91836, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99402, -0.10884, -0.0085, 0.0, 0.0968, 0.91466, -0.39246, -0.0, 0.05049, 0.38929, 0.91973, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99415, -0.10785, -0.00539, 0.0, 0.09777, 0.9202, -0.37904, -0.0, 0.04583, 0.37629, 0.92537, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99387, -0.10869, -0.02005, 0.0, 0.0943, 0.92851, -0.35914, -0.0, 0.05765, 0.35505, 0.93307, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99252, -0.11169, -0.04923, 0.0, 0.08903, 0.93838, -0.33393, -0.0, 0.0835, 0.32705, 0.94131, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.98975, -0.11585, -0.08352, 0.0, 0.08551, 0.94908, -0.30322, -0.0, 0.1144, 0.29297, 0.94925, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHand": {"1": [0.97109, 0.18718, -0.14813, -0.0, -0.20127, 0.97571, -0.08652, -0.0, 0.12833, 0.11383, 0.98518, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.96731, 0.19638, -0.16045, -0.0, -0.21081, 0.97438, -0.07836, -0.0, 0.14095, 0.10963, 0.98393, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.96178, 0.21139, -0.17404, -0.0, -0.22544, 0.97207, -0.06519, -0.0, 0.1554, 0.10193, 0.98258, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.95484, 0.23615, -0.1803, -0.0, -0.24874, 0.96726, -0.0504, -0.0, 0.1625, 0.09297, 0.98232, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.94706, 0.26974, -0.17412, -0.0, -0.28, 0.95929, -0.03684, -0.0, 0.1571, 0.08364, 0.98403, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.93849, 0.30333, -0.16501, -0.0, -0.31136, 0.94997, -0.02461, -0.0, 0.14929, 0.07447, 0.98599, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.92988, 0.32704, -0.16844, -0.0, -0.33395, 0.94249, -0.01364, -0.0, 0.15429, 0.06894, 0.98562, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.92225, 0.33941, -0.18509, -0.0, -0.34643, 0.93806, -0.00596, -0.0, 0.1716, 0.06961, 0.9827, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.91666, 0.34502, -0.20171, -0.0, -0.35256, 0.93579, -0.00157, -0.0, 0.18821, 0.07255, 0.97944, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.91447, 0.34431, -0.21258, -0.0, -0.35137, 0.93622, 0.00482, -0.0, 0.20068, 0.07028, 0.97713, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.91458, 0.33329, -0.22903, -0.0, -0.33829, 0.94087, 0.01829, -0.0, 0.22159, 0.06076, 0.97325, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.91286, 0.31435, -0.26053, -0.0, -0.31668, 0.94792, 0.03415, -0.0, 0.2577, 0.05133, 0.96486, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.90861, 0.29819, -0.29243, -0.0, -0.30059, 0.953, 0.03781, -0.0, 0.28996, 0.05355, 0.95554, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.90942, 0.28966, -0.29842, -0.0, -0.29839, 0.95429, 0.01696, -0.0, 0.28969, 0.07363, 0.95428, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.92068, 0.27909, -0.27288, -0.0, -0.29739, 0.95437, -0.02727, -0.0, 0.25282, 0.10626, 0.96166, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.93838, 0.25359, -0.2348, -0.0, -0.2789, 0.95688, -0.08118, -0.0, 0.20409, 0.14166, 0.96865, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.95516, 0.21438, -0.20423, -0.0, -0.24317, 0.9615, -0.12801, -0.0, 0.16893, 0.17194, 0.97052, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.96628, 0.1773, -0.18671, -0.0, -0.20747, 0.96559, -0.15682, -0.0, 0.15248, 0.19027, 0.96982, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.97169, 0.15554, -0.17781, -0.0, -0.18562, 0.96826, -0.16738, -0.0, 0.14613, 0.19565, 0.96973, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.97378, 0.14759, -0.1731, -0.0, -0.17717, 0.96935, -0.17018, -0.0, 0.14268, 0.19639, 0.97009, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.97483, 0.14308, -0.171, -0.0, -0.17339, 0.96865, -0.17792, -0.0, 0.14018, 0.20309, 0.96907, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.97624, 0.13428, -0.17008, -0.0, -0.16679, 0.96669, -0.19414, -0.0, 0.13834, 0.2179, 0.96612, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.9786, 0.11913, -0.1678, -0.0, -0.1537, 0.96532, -0.21105, -0.0, 0.13684, 0.23232, 0.96297, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.98169, 0.09764, -0.16353, -0.0, -0.13256, 0.96679, -0.21851, -0.0, 0.13677, 0.23619, 0.96203, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98452, 0.07063, -0.16044, -0.0, -0.10476, 0.97089, -0.21542, -0.0, 0.14055, 0.22889, 0.96325, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98602, 0.04243, -0.16113, -0.0, -0.07677, 0.97396, -0.21334, -0.0, 0.14788, 0.22273, 0.9636, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.98657, 0.02031, -0.16207, -0.0, -0.05742, 0.97203, -0.22771, -0.0, 0.15292, 0.23396, 0.96015, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.98773, 0.0088, -0.15591, -0.0, -0.04965, 0.9643, -0.26011, -0.0, 0.14806, 0.26466, 0.95291, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99008, 0.00684, -0.14031, -0.0, -0.0476, 0.95606, -0.2893, -0.0, 0.13217, 0.29311, 0.9469, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99245, 0.01179, -0.12209, -0.0, -0.04655, 0.9571, -0.28601, -0.0, 0.11348, 0.28953, 0.95042, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99346, 0.02461, -0.11153, -0.0, -0.051, 0.96933, -0.24042, -0.0, 0.10219, 0.24453, 0.96424, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99226, 0.05038, -0.11348, -0.0, -0.06972, 0.98237, -0.17347, -0.0, 0.10274, 0.18004, 0.97828, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.98796, 0.09192, -0.12445, -0.0, -0.10677, 0.9872, -0.11846, -0.0, 0.11196, 0.13032, 0.98513, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98025, 0.14215, -0.13748, -0.0, -0.15545, 0.98361, -0.09139, -0.0, 0.12223, 0.11096, 0.98628, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.97109, 0.18718, -0.14813, -0.0, -0.20127, 0.97571, -0.08652, -0.0, 0.12833, 0.11383, 0.98518, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftFoot": {"1": [0.99685, -0.07134, -0.03471, 0.0, 0.06614, 0.9889, -0.13303, 0.0, 0.04382, 0.13031, 0.9905, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99692, -0.07346, -0.0275, 0.0, 0.06989, 0.99106, -0.11366, 0.0, 0.0356, 0.11139, 0.99314, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99639, -0.08231, -0.0209, 0.0, 0.07932, 0.98995, -0.11707, 0.0, 0.03033, 0.11499, 0.9929, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99413, -0.10674, -0.0175, 0.0, 0.10268, 0.98216, -0.15754, 0.0, 0.03401, 0.15482, 0.98736, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.98888, -0.14768, -0.01733, 0.0, 0.13961, 0.96228, -0.23352, 0.0, 0.05116, 0.22851, 0.9722, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98204, -0.18796, -0.01648, 0.0, 0.1741, 0.93636, -0.30484, 0.0, 0.07273, 0.29649, 0.95226, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.97924, -0.20248, -0.00911, 0.0, 0.18854, 0.92649, -0.32568, 0.0, 0.07439, 0.3172, 0.94544, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.9848, -0.17327, 0.01169, 0.0, 0.1701, 0.94881, -0.26614, 0.0, 0.03502, 0.26409, 0.96386, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99287, -0.10996, 0.04601, 0.0, 0.11509, 0.98483, -0.12986, 0.0, -0.03104, 0.13423, 0.99046, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99534, -0.046, 0.08476, 0.0, 0.04531, 0.99892, 0.00995, 0.0, -0.08512, -0.00606, 0.99635, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99469, -0.02515, 0.09978, 0.0, 0.01765, 0.997, 0.07541, 0.0, -0.10138, -0.07325, 0.99215, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99464, -0.02055, 0.10133, 0.0, 0.01643, 0.99901, 0.0413, 0.0, -0.10208, -0.03942, 0.994, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99499, -0.01738, 0.09842, 0.0, 0.02217, 0.99861, -0.04773, 0.0, -0.09746, 0.04967, 0.994, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99526, -0.0115, 0.0966, 0.0, 0.02439, 0.99077, -0.13335, 0.0, -0.09418, 0.13507, 0.98635, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99516, -0.00253, 0.09821, 0.0, 0.02222, 0.97956, -0.19991, 0.0, -0.0957, 0.20113, 0.97488, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99508, 0.00449, 0.09897, 0.0, 0.02089, 0.96701, -0.2539, 0.0, -0.09685, 0.25472, 0.96215, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99517, 0.0055, 0.098, 0.0, 0.02401, 0.95446, -0.29738, 0.0, -0.09517, 0.29829, 0.94972, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99517, 0.00069, 0.09819, 0.0, 0.03264, 0.9408, -0.33738, 0.0, -0.09261, 0.33895, 0.93623, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99505, -0.00422, 0.09925, 0.0, 0.04131, 0.92617, -0.37483, 0.0, -0.09034, 0.37708, 0.92177, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99593, -0.00059, 0.09007, 0.0, 0.0388, 0.90531, -0.42298, 0.0, -0.08129, 0.42476, 0.90165, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99858, 0.00966, 0.05236, 0.0, 0.01667, 0.87732, -0.47962, 0.0, -0.05057, 0.47981, 0.87591, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99991, 0.01008, -0.00834, 0.0, -0.01297, 0.84439, -0.53557, 0.0, 0.00164, 0.53563, 0.84445, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99769, -0.01363, -0.06649, 0.0, -0.0283, 0.80681, -0.59013, 0.0, 0.06169, 0.59065, 0.80457, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99281, -0.05855, -0.10445, 0.0, -0.02219, 0.76725, -0.64097, 0.0, 0.11767, 0.63868, 0.76043, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98965, -0.10335, -0.0996, 0.0, 0.00731, 0.72931, -0.68414, 0.0, 0.14334, 0.67633, 0.72252, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99188, -0.12153, -0.03751, 0.0, 0.06137, 0.71559, -0.69582, 0.0, 0.1114, 0.68786, 0.71724, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99262, -0.11371, 0.04223, 0.0, 0.1153, 0.77632, -0.61971, 0.0, 0.03769, 0.62, 0.7837, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99355, -0.06944, 0.0896, 0.0, 0.1011, 0.90026, -0.42346, 0.0, -0.05126, 0.42979, 0.90147, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99286, 0.00078, 0.11929, 0.0, 0.02751, 0.97152, -0.23537, 0.0, -0.11608, 0.23698, 0.96456, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99435, 0.00585, 0.10597, 0.0, 0.01876, 0.97308, -0.2297, 0.0, -0.10446, 0.23039, 0.96748, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99488, 0.03653, 0.09427, 0.0, -0.01103, 0.96609, -0.25795, 0.0, -0.1005, 0.25559, 0.96155, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99366, 0.0723, 0.08615, 0.0, -0.04749, 0.96407, -0.26137, 0.0, -0.10195, 0.25562, 0.96139, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99478, 0.07493, 0.06923, 0.0, -0.05708, 0.97128, -0.231, 0.0, -0.08455, 0.22585, 0.97049, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99925, 0.02482, 0.02986, 0.0, -0.01882, 0.98225, -0.18664, 0.0, -0.03396, 0.18594, 0.98197, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99685, -0.07134, -0.03471, 0.0, 0.06614, 0.9889, -0.13303, 0.0, 0.04382, 0.13031, 0.9905, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandThumb1": {"1": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.97925, 0.10579, 0.17284, 0.0, -0.04419, 0.94387, -0.32734, -0.0, -0.19777, 0.31291, 0.92897, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandIndex3": {"1": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99859, -0.02362, -0.04748, 0.0, 0.01082, 0.96725, -0.25358, -0.0, 0.05192, 0.25271, 0.96615, -0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:Spine": {"1": [0.9911, -0.00374, 0.13306, 0.0, 0.04459, 0.95118, -0.30539, 0.0, -0.12542, 0.3086, 0.94288, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99514, 0.01724, 0.09696, 0.0, 0.01303, 0.95288, -0.30308, 0.0, -0.09761, 0.30287, 0.94802, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99688, 0.04332, 0.06602, 0.0, -0.0218, 0.95458, -0.29716, 0.0, -0.07589, 0.29479, 0.95254, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99605, 0.07721, 0.04385, 0.0, -0.06142, 0.95578, -0.28761, 0.0, -0.06412, 0.28378, 0.95674, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99298, 0.11508, 0.02741, 0.0, -0.10302, 0.95512, -0.27774, 0.0, -0.05815, 0.27296, 0.96027, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.98985, 0.14167, 0.01115, 0.0, -0.13332, 0.95289, -0.27244, 0.0, -0.04923, 0.26819, 0.96211, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.98963, 0.14309, -0.01235, 0.0, -0.14099, 0.95147, -0.27355, 0.0, -0.02739, 0.27245, 0.96178, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.9914, 0.12177, -0.04796, 0.0, -0.13034, 0.95164, -0.27818, 0.0, 0.01176, 0.28204, 0.95933, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.9913, 0.09197, -0.0942, 0.0, -0.11525, 0.95211, -0.2832, 0.0, 0.06364, 0.29159, 0.95442, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.9877, 0.06512, -0.14213, 0.0, -0.10446, 0.95128, -0.29009, 0.0, 0.11632, 0.30137, 0.94639, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.98242, 0.04377, -0.18147, 0.0, -0.09813, 0.94807, -0.30255, 0.0, 0.1588, 0.31504, 0.9357, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.97863, 0.0276, -0.20377, 0.0, -0.09321, 0.94283, -0.31998, 0.0, 0.18329, 0.33213, 0.92525, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.97787, 0.01766, -0.20846, 0.0, -0.08854, 0.93773, -0.33589, 0.0, 0.18955, 0.34691, 0.91854, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.9795, 0.01254, -0.20104, 0.0, -0.08258, 0.93533, -0.344, 0.0, 0.18372, 0.35355, 0.9172, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.98241, 0.00679, -0.18659, 0.0, -0.07181, 0.93621, -0.34401, 0.0, 0.17235, 0.35136, 0.92024, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.98639, -0.00391, -0.1644, 0.0, -0.05305, 0.9387, -0.34064, 0.0, 0.15565, 0.34472, 0.92571, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99115, -0.01749, -0.13161, 0.0, -0.02823, 0.94086, -0.33763, 0.0, 0.12974, 0.33835, 0.93203, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.9955, -0.02802, -0.09057, 0.0, -0.00387, 0.9425, -0.33418, 0.0, 0.09473, 0.33302, 0.93815, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99822, -0.03392, -0.04904, 0.0, 0.01603, 0.94483, -0.32718, 0.0, 0.05744, 0.32581, 0.94369, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99902, -0.04218, -0.01355, 0.0, 0.03574, 0.94811, -0.31591, 0.0, 0.02617, 0.31512, 0.94869, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.99807, -0.06032, 0.01462, 0.0, 0.06191, 0.95057, -0.30427, 0.0, 0.00446, 0.30459, 0.95247, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99571, -0.08424, 0.03828, 0.0, 0.09185, 0.95007, -0.29821, 0.0, -0.01124, 0.30044, 0.95373, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99305, -0.10159, 0.05937, 0.0, 0.11479, 0.94729, -0.29911, 0.0, -0.02586, 0.30384, 0.95237, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99106, -0.10447, 0.08303, 0.0, 0.12484, 0.9456, -0.30043, 0.0, -0.04713, 0.30811, 0.95018, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.98865, -0.0973, 0.11448, 0.0, 0.12729, 0.94723, -0.29418, 0.0, -0.07982, 0.30542, 0.94887, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.98403, -0.08883, 0.15424, 0.0, 0.12992, 0.95079, -0.28127, 0.0, -0.12167, 0.29682, 0.94715, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.97683, -0.08208, 0.19766, 0.0, 0.13514, 0.9527, -0.27223, 0.0, -0.16596, 0.29263, 0.94171, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.96831, -0.0762, 0.23786, 0.0, 0.14243, 0.95077, -0.27524, 0.0, -0.20517, 0.30039, 0.93149, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.96207, -0.07239, 0.26302, 0.0, 0.14942, 0.94649, -0.28605, 0.0, -0.22824, 0.31451, 0.92141, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.96028, -0.06914, 0.27034, 0.0, 0.15071, 0.94386, -0.29397, 0.0, -0.23484, 0.32304, 0.91678, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.96345, -0.06387, 0.26017, 0.0, 0.14219, 0.94499, -0.29457, 0.0, -0.22704, 0.3208, 0.91953, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.97001, -0.05414, 0.23694, 0.0, 0.12449, 0.94796, -0.29305, 0.0, -0.20875, 0.31376, 0.92627, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.97764, -0.03947, 0.20653, 0.0, 0.10079, 0.95, -0.29552, 0.0, -0.18454, 0.30973, 0.93274, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.98492, -0.02189, 0.17164, 0.0, 0.07364, 0.95067, -0.30135, 0.0, -0.15658, 0.30944, 0.93794, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.9911, -0.00374, 0.13306, 0.0, 0.04459, 0.95118, -0.30539, 0.0, -0.12543, 0.3086, 0.94288, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:RightHandIndex4": {"1": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, 0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:Spine2": {"1": [0.99909, 0.0119, 0.04091, 0.0, 0.00652, 0.90627, -0.42266, 0.0, -0.0421, 0.42254, 0.90537, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.99945, 0.01353, 0.03037, 0.0, 0.00049, 0.90734, -0.4204, 0.0, -0.03324, 0.42018, 0.90683, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.99969, 0.0159, 0.01916, 0.0, -0.0064, 0.90782, -0.4193, 0.0, -0.02405, 0.41905, 0.90764, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.99981, 0.01816, 0.00751, 0.0, -0.01332, 0.90744, -0.41997, 0.0, -0.01444, 0.41979, 0.90751, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.99981, 0.01929, -0.0038, 0.0, -0.01909, 0.90639, -0.42201, 0.0, -0.0047, 0.422, 0.90658, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.99973, 0.01885, -0.01352, 0.0, -0.02281, 0.90534, -0.42408, 0.0, 0.00424, 0.42427, 0.90552, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.99965, 0.01715, -0.02038, 0.0, -0.02419, 0.90482, -0.42511, 0.0, 0.01115, 0.42545, 0.90491, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.99961, 0.01472, -0.02393, 0.0, -0.0235, 0.90488, -0.42501, 0.0, 0.01539, 0.4254, 0.90487, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.99962, 0.01197, -0.0248, 0.0, -0.02136, 0.90547, -0.42387, 0.0, 0.01739, 0.42424, 0.90538, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.99966, 0.00926, -0.02433, 0.0, -0.01865, 0.90669, -0.42138, 0.0, 0.01815, 0.42169, 0.90656, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.9997, 0.007, -0.02363, 0.0, -0.01623, 0.90846, -0.41766, 0.0, 0.01854, 0.41792, 0.9083, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.99972, 0.00557, -0.02306, 0.0, -0.01462, 0.9101, -0.41413, 0.0, 0.01868, 0.41435, 0.90993, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.99974, 0.00512, -0.02204, 0.0, -0.01376, 0.91081, -0.4126, 0.0, 0.01796, 0.4128, 0.91064, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.9998, 0.00549, -0.01927, 0.0, -0.01297, 0.91036, -0.4136, 0.0, 0.01527, 0.41377, 0.91025, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.99989, 0.00615, -0.01364, 0.0, -0.01127, 0.90926, -0.41607, 0.0, 0.00985, 0.41618, 0.90923, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.99997, 0.00658, -0.0051, 0.0, -0.00811, 0.90815, -0.41857, 0.0, 0.00188, 0.41859, 0.90817, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.99996, 0.00672, 0.00521, 0.0, -0.00391, 0.90727, -0.42053, 0.0, -0.00756, 0.42049, 0.90726, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.99985, 0.00703, 0.01586, 0.0, 0.00033, 0.90651, -0.42218, 0.0, -0.01734, 0.42213, 0.90637, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.99963, 0.00795, 0.0261, 0.0, 0.00386, 0.90572, -0.42385, 0.0, -0.02701, 0.42379, 0.90536, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.99931, 0.00955, 0.03598, 0.0, 0.00669, 0.90482, -0.42575, 0.0, -0.03662, 0.4257, 0.90412, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.9989, 0.01128, 0.04552, 0.0, 0.00931, 0.90362, -0.42824, 0.0, -0.04597, 0.4282, 0.90252, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.99847, 0.01214, 0.05402, 0.0, 0.01238, 0.90199, -0.43157, 0.0, -0.05397, 0.43158, 0.90046, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.99811, 0.01137, 0.06036, 0.0, 0.01607, 0.90016, -0.43526, 0.0, -0.05928, 0.43541, 0.89828, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.99791, 0.00932, 0.064, 0.0, 0.0197, 0.89878, -0.43796, 0.0, -0.0616, 0.43831, 0.89671, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.99781, 0.00732, 0.06569, 0.0, 0.02226, 0.89858, -0.43824, 0.0, -0.06224, 0.43874, 0.89646, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.99774, 0.00635, 0.06695, 0.0, 0.02352, 0.89973, -0.4358, 0.0, -0.063, 0.43639, 0.89755, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.99762, 0.0061, 0.06869, 0.0, 0.02425, 0.90144, -0.43223, 0.0, -0.06455, 0.43287, 0.89914, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.99749, 0.00554, 0.07055, 0.0, 0.02539, 0.90247, -0.43, 0.0, -0.06605, 0.43072, 0.90007, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.99743, 0.00419, 0.07147, 0.0, 0.02706, 0.90212, -0.43063, 0.0, -0.06628, 0.43146, 0.8997, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.99749, 0.00281, 0.07071, 0.0, 0.0282, 0.90073, -0.43347, 0.0, -0.06491, 0.43438, 0.89839, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.99767, 0.00272, 0.06818, 0.0, 0.02736, 0.89945, -0.43617, 0.0, -0.06251, 0.43702, 0.89728, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.99794, 0.00448, 0.06394, 0.0, 0.02392, 0.89945, -0.43637, 0.0, -0.05947, 0.437, 0.89749, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.99829, 0.00733, 0.05792, 0.0, 0.01853, 0.90102, -0.43338, 0.0, -0.05536, 0.43372, 0.89935, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.99869, 0.01005, 0.05016, 0.0, 0.01242, 0.90355, -0.4283, 0.0, -0.04962, 0.42836, 0.90224, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.99909, 0.0119, 0.04091, 0.0, 0.00652, 0.90627, -0.42266, 0.0, -0.0421, 0.42254, 0.90537, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHand": {"1": [0.83212, -0.55256, 0.04759, -0.0, 0.5505, 0.83333, 0.05012, -0.0, -0.06735, -0.01551, 0.99761, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.83782, -0.54318, 0.05493, -0.0, 0.54318, 0.83946, 0.0163, -0.0, -0.05497, 0.01618, 0.99836, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.84342, -0.53407, 0.05847, -0.0, 0.53597, 0.84393, -0.02271, -0.0, -0.03722, 0.0505, 0.99803, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.84674, -0.52889, 0.05754, -0.0, 0.53176, 0.84471, -0.06086, -0.0, -0.01642, 0.08213, 0.99649, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.84617, -0.53029, 0.05284, -0.0, 0.53291, 0.84151, -0.08869, -0.0, 0.00256, 0.1032, 0.99466, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.84229, -0.53705, 0.04611, -0.0, 0.53884, 0.83665, -0.0983, -0.0, 0.01421, 0.10765, 0.99409, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.83776, -0.54471, 0.03817, -0.0, 0.54579, 0.83317, -0.08916, -0.0, 0.01677, 0.09553, 0.99529, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.83462, -0.55007, 0.0287, -0.0, 0.55064, 0.83187, -0.06925, -0.0, 0.01422, 0.07361, 0.99719, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.83204, -0.55437, 0.01966, -0.0, 0.55459, 0.83058, -0.05061, -0.0, 0.01173, 0.05302, 0.99852, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.82801, -0.56042, 0.01815, -0.0, 0.56065, 0.82698, -0.04214, -0.0, 0.00861, 0.04507, 0.99895, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.82301, -0.56715, 0.03148, -0.0, 0.56802, 0.82185, -0.04376, -0.0, -0.00105, 0.0539, 0.99855, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.81988, -0.56975, 0.05644, -0.0, 0.57216, 0.81893, -0.04462, -0.0, -0.0208, 0.06887, 0.99741, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.81982, -0.56732, 0.07773, -0.0, 0.57061, 0.82075, -0.02787, -0.0, -0.04798, 0.0672, 0.99658, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.81919, -0.56755, 0.08256, -0.0, 0.56822, 0.82269, 0.01751, -0.0, -0.07786, 0.03257, 0.99643, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.81079, -0.58059, 0.07438, -0.0, 0.57493, 0.81377, 0.085, -0.0, -0.10988, -0.02616, 0.9936, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.79041, -0.60898, 0.06628, -0.0, 0.59545, 0.78921, 0.1503, -0.0, -0.14384, -0.07933, 0.98642, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.76301, -0.64329, 0.06321, -0.0, 0.62377, 0.75843, 0.18896, -0.0, -0.16949, -0.10475, 0.97995, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.74122, -0.66878, 0.05766, -0.0, 0.64813, 0.73539, 0.19781, -0.0, -0.17469, -0.10925, 0.97854, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.73386, -0.67788, 0.04393, -0.0, 0.65879, 0.72599, 0.19733, -0.0, -0.16566, -0.11587, 0.97935, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.73742, -0.67475, 0.03032, -0.0, 0.65501, 0.72535, 0.21173, -0.0, -0.16485, -0.13628, 0.97686, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.74326, -0.66825, 0.03175, -0.0, 0.64225, 0.72602, 0.24579, -0.0, -0.1873, -0.16229, 0.9688, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.74806, -0.66166, 0.05118, -0.0, 0.62451, 0.72795, 0.28297, -0.0, -0.22449, -0.17971, 0.95776, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.75463, -0.65205, 0.07324, -0.0, 0.60558, 0.73509, 0.30482, -0.0, -0.2526, -0.18567, 0.94959, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.76567, -0.63851, 0.07783, -0.0, 0.59079, 0.74593, 0.3075, -0.0, -0.2544, -0.18946, 0.94836, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.77847, -0.62504, 0.05749, -0.0, 0.58376, 0.75461, 0.29964, -0.0, -0.23067, -0.1997, 0.95232, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.7876, -0.61582, 0.02138, -0.0, 0.58453, 0.75766, 0.29029, -0.0, -0.19497, -0.21614, 0.9567, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.79081, -0.61189, -0.01448, -0.0, 0.59046, 0.75646, 0.28129, -0.0, -0.16116, -0.231, 0.95951, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.7894, -0.61264, -0.03888, -0.0, 0.59878, 0.75449, 0.2687, -0.0, -0.13528, -0.23539, 0.96244, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.78497, -0.61759, -0.04903, -0.0, 0.60842, 0.75355, 0.24898, -0.0, -0.11683, -0.22527, 0.96727, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.77889, -0.62544, -0.04648, -0.0, 0.61839, 0.75353, 0.22312, -0.0, -0.10452, -0.20253, 0.97368, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.7745, -0.63174, -0.03252, -0.0, 0.6249, 0.75611, 0.19441, -0.0, -0.09823, -0.17089, 0.98038, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.77734, -0.62901, -0.0092, -0.0, 0.62176, 0.76598, 0.16339, -0.0, -0.09572, -0.13273, 0.98652, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.7905, -0.61223, 0.01692, -0.0, 0.60556, 0.78542, 0.12812, -0.0, -0.09173, -0.09104, 0.99161, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.81078, -0.58415, 0.0374, -0.0, 0.57953, 0.81007, 0.08912, -0.0, -0.08235, -0.05059, 0.99532, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.83212, -0.55256, 0.04759, -0.0, 0.5505, 0.83333, 0.05012, -0.0, -0.06735, -0.01551, 0.99761, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandIndex4": {"1": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, 0.0, 0.0, 0.0, -0.0, 1.0, -0.0, 0.0, -0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftArm": {"1": [0.85043, 0.22779, 0.47422, 0.0, 0.21641, 0.67013, -0.71, 0.0, -0.47952, 0.70643, 0.5206, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [0.87554, 0.22802, 0.42596, 0.0, 0.19155, 0.64556, -0.73929, 0.0, -0.44356, 0.72887, 0.52154, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [0.89804, 0.21871, 0.38168, 0.0, 0.1705, 0.62678, -0.76032, 0.0, -0.40552, 0.74787, 0.52558, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [0.91662, 0.204, 0.34379, 0.0, 0.15157, 0.61844, -0.77108, 0.0, -0.36991, 0.75889, 0.53596, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [0.93116, 0.18877, 0.31195, 0.0, 0.13149, 0.62413, -0.77017, 0.0, -0.34008, 0.75817, 0.55634, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [0.94284, 0.1753, 0.28343, 0.0, 0.10839, 0.64295, -0.7582, 0.0, -0.31514, 0.74558, 0.5872, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [0.95279, 0.16157, 0.25706, 0.0, 0.08601, 0.66835, -0.73885, 0.0, -0.29119, 0.72608, 0.62291, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [0.96089, 0.14297, 0.23717, 0.0, 0.07485, 0.69047, -0.71948, 0.0, -0.26662, 0.70909, 0.65276, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [0.96572, 0.11714, 0.23165, 0.0, 0.08415, 0.70292, -0.70627, 0.0, -0.24557, 0.70155, 0.66897, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [0.96509, 0.09168, 0.24536, 0.0, 0.10982, 0.70881, -0.6968, 0.0, -0.23779, 0.69942, 0.67399, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [0.95696, 0.08382, 0.27786, 0.0, 0.13448, 0.72034, -0.68046, 0.0, -0.25719, 0.68854, 0.67806, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [0.93943, 0.10461, 0.32638, 0.0, 0.14228, 0.7473, -0.64907, 0.0, -0.3118, 0.6562, 0.68716, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [0.9106, 0.14315, 0.38771, 0.0, 0.13534, 0.78308, -0.60701, 0.0, -0.39051, 0.60522, 0.6937, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [0.87209, 0.17368, 0.45749, 0.0, 0.13676, 0.81115, -0.56863, 0.0, -0.46985, 0.55846, 0.68364, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [0.82984, 0.17982, 0.52823, 0.0, 0.16777, 0.82245, -0.54354, 0.0, -0.53218, 0.53967, 0.65233, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [0.78907, 0.16545, 0.59161, 0.0, 0.22475, 0.81852, -0.52868, 0.0, -0.57171, 0.55013, 0.60868, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [0.75128, 0.14424, 0.64402, 0.0, 0.2879, 0.80646, -0.51646, 0.0, -0.59387, 0.57342, 0.56436, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [0.71574, 0.12858, 0.68642, 0.0, 0.34079, 0.79362, -0.50401, 0.0, -0.60957, 0.59467, 0.52421, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [0.68279, 0.1254, 0.71978, 0.0, 0.37532, 0.78504, -0.49279, 0.0, -0.62685, 0.60662, 0.48895, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [0.65418, 0.13504, 0.74419, 0.0, 0.38826, 0.78444, -0.48364, 0.0, -0.64908, 0.60533, 0.46073, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [0.63081, 0.15278, 0.76075, 0.0, 0.38023, 0.79378, -0.4747, 0.0, -0.67639, 0.58871, 0.44263, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [0.61262, 0.1724, 0.77135, 0.0, 0.35641, 0.81081, -0.46428, 0.0, -0.70546, 0.55934, 0.43528, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [0.60108, 0.19053, 0.77614, 0.0, 0.32464, 0.82922, -0.45498, 0.0, -0.73028, 0.52545, 0.43658, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [0.59999, 0.20945, 0.77211, 0.0, 0.28995, 0.84257, -0.45388, 0.0, -0.74562, 0.4962, 0.4448, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [0.61285, 0.23379, 0.75483, 0.0, 0.25193, 0.84757, -0.46706, 0.0, -0.74897, 0.4764, 0.46053, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [0.63908, 0.26298, 0.72278, 0.0, 0.20997, 0.84438, -0.49288, 0.0, -0.73992, 0.46675, 0.48441, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [0.67299, 0.28867, 0.681, 0.0, 0.16953, 0.83599, -0.5219, 0.0, -0.71996, 0.46669, 0.51367, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [0.70693, 0.30134, 0.63988, 0.0, 0.14096, 0.82652, -0.54497, 0.0, -0.6931, 0.47545, 0.54181, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [0.73438, 0.29897, 0.60934, 0.0, 0.13316, 0.81685, -0.56127, 0.0, -0.66554, 0.49332, 0.56007, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [0.75191, 0.28843, 0.59282, 0.0, 0.14699, 0.80324, -0.57724, 0.0, -0.64267, 0.52117, 0.56157, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [0.76213, 0.27832, 0.58455, 0.0, 0.17268, 0.78279, -0.59784, 0.0, -0.62397, 0.55658, 0.54853, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [0.77324, 0.26998, 0.57376, 0.0, 0.19752, 0.75726, -0.62253, 0.0, -0.60256, 0.5947, 0.53222, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [0.79231, 0.25901, 0.5524, 0.0, 0.21441, 0.72946, -0.64956, 0.0, -0.5712, 0.63309, 0.52242, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [0.81954, 0.24399, 0.51849, 0.0, 0.22091, 0.70035, -0.67875, 0.0, -0.52873, 0.6708, 0.52007, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [0.85043, 0.22779, 0.47422, 0.0, 0.21641, 0.67013, -0.71, 0.0, -0.47952, 0.70643, 0.5206, 0.0, 0.0, 0.0, 0.0, 1.0]}, "mixamorig:LeftHandThumb4": {"1": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "2": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "3": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "4": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "5": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "6": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "7": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "8": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "9": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "10": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "11": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "12": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "13": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "14": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "15": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "16": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "17": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "18": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "19": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "20": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "21": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "22": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "23": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "24": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "25": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "26": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "27": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "28": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "29": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "30": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "31": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "32": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "33": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "34": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0], "35": [1.0, -0.0, -0.0, 0.0, 0.0, 1.0, 0.0, -0.0, 0.0, -0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]}}}}`
+	Width     = 480
+	Height    = 480
+	Title     = "Skinned animation"
+	AssetFile = "trump.hmxa" // converted from the JSON export in ../testdata with the convert tool
 )
 
-func InitGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+func main() {
+	compressedFile := flag.String("compressed", "", "compressed clip from the compress tool, played instead of the asset's clip")
+	flag.Parse()
 
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
+	runtime.LockOSThread()
 
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title, ClearColor: &[4]float32{0.9921568627, 0.968627451, 0.8901960784, 1}})
 
 	if err != nil {
-		panic(err)
+		log.Fatal(err.Error())
 	}
 
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
+	// read the model, its armature and its action from the binary asset file
+	assetFile, err := asset.Open(AssetFile)
 
-	return window
-}
-
-func InitOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
+	skeleton, err := assetFile.Skeleton("Armature")
 
-	gl.ClearColor(0.9921568627, 0.968627451, 0.8901960784, 1)
-
-	width, height := window.GetFramebufferSize()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
+	clip, err := assetFile.Clip("ArmatureAction")
 
-func GlStr(str string) *uint8 {
-	if !strings.HasSuffix(str, "\x00") {
-		str = str + "\x00"
+	if err != nil {
+		log.Fatal(err.Error())
 	}
-	return gl.Str(str)
-}
-
-func CheckError() {
 
-	err := gl.GetError()
+	cubeMesh, err := assetFile.Mesh("Cube")
 
-	if err != gl.NO_ERROR {
-		log.Print("CheckError")
-		log.Fatal(err)
+	if err != nil {
+		log.Fatal(err.Error())
 	}
-}
 
-func main() {
-	runtime.LockOSThread()
-
-	// initialise glfw and opengl
-	window := InitGLFW()
-	InitOpenGL(window)
+	cubeSkin, err := assetFile.Skin("Cube")
 
-	// unmarshal vertex data
-	var vertexData map[string]Mesh
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	vertexByteArray := []byte(VertexData)
-	err := json.Unmarshal(vertexByteArray, &vertexData)
+	cubeMorph, err := assetFile.Morph("Cube")
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 
-	// unmarshal animation data
-	var animationData map[string]map[string]map[string]*IntToMatrix4fMap
-
-	animationByteArray := []byte(AnimationMatrices)
-	err = json.Unmarshal(animationByteArray, &animationData)
+	// the inverted bone matrices are stored in skeleton order, ready for the texture buffer
+	invertedBoneMatrixBuffer, err := assetFile.ArmatureMatrices("Armature")
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 
-	// unmarshal armature data
-	var armatureData map[string]*Armature
+	armature := skeleton.Armature()
 
-	armatureByteArray := []byte(ArmatureData)
-	err = json.Unmarshal(armatureByteArray, &armatureData)
+	// refuse broken data up front rather than recursing forever or binding vertices to the wrong bones
+	errs := ValidateArmature(armature)
+	errs = append(errs, ValidateKeyframes(clip.Keyframes, armature, clip.StartFrame, clip.EndFrame)...)
 
-	if err != nil {
+	if err := errs.Err(); err != nil {
 		log.Fatal(err.Error())
 	}
 
-	// initialise what will eventually be texture buffers bound to our shader program
-	boneMatrixBuffer := []float32{}
-	invertedBoneMatrixBuffer := []float32{}
-	skinBuffer := []float32{}
-	offsetBuffer := []float32{}
-
-	armatureActionFrames := animationData["Cube"]["ArmatureAction"]
-	armature := armatureData["Armature"]
 	// create a new skinned animation instance using the armature data, and the animation data
-	skinnedAnimation := NewSkinnedAnimation(armature, armatureActionFrames, 35, 30)
+	skinnedAnimation := NewSkinnedAnimation(armature, clip.Keyframes, clip.EndFrame, 30)
 	// fetch the bind matrices that were generated in the skinned animation instance
 	bindMatrices := skinnedAnimation.BindMatrices
 
-	boneNameToIndex := NewStringToFloat32Map()
-	boneIndex := float32(0)
+	// initialise what will eventually be texture buffers bound to our shader program
+	boneMatrixBuffer := []float32{}
+	offsetBuffer := []float32{}
 
-	// loop over the bones of the armature that the mesh is parented to
-	for boneName := range armature.Bones {
+	// loop over the bones of the armature that the mesh is parented to, parents before children, so every bone
+	// gets the index the skin weights refer to
+	for _, boneName := range skeleton.Names {
 
 		// get the per-frame matrices of the current bone in the armature
 		frameMatrices := bindMatrices[boneName]
@@ -150,64 +108,55 @@ func main() {
 			frameMatrix := frameMatrices.Get(animationFrame)
 			boneMatrixBuffer = append(boneMatrixBuffer, frameMatrix.Get1D()...)
 		}
+	}
 
-		// keep track of the name of the bone whose matrices we just appended to a list, along with the index with which it was iterated on
-		boneNameToIndex.Set(boneName, boneIndex)
+	// a compressed clip is sampled straight into the buffer in the same layout, frames counted from its first
+	if *compressedFile != "" {
+		b, err := ioutil.ReadFile(*compressedFile)
 
-		// incremenet the bone index
-		boneIndex++
-	}
+		if err != nil {
+			log.Fatal(err.Error())
+		}
 
-	// collect the inverted bone matrices for the bones in the armature based on the order they were encountered when collecting their pose matrices
-	for _, boneName := range boneNameToIndex.Keys() {
-		matrixLocalInverted := armature.Bones[boneName].MatrixLocalInverted
-		invertedBoneMatrixBuffer = append(invertedBoneMatrixBuffer, matrixLocalInverted.Get1D()...)
-	}
+		compressed := &CompressedClip{}
 
-	cubeVertexData := vertexData["Cube"]
+		if err := json.Unmarshal(b, compressed); err != nil {
+			log.Fatalf("%s: %s", *compressedFile, err.Error())
+		}
 
-	for _, v := range cubeVertexData.Coordinates {
-		for sk, sv := range v.Skin {
-			skinBuffer = append(skinBuffer, boneNameToIndex.Get(sk))
-			skinBuffer = append(skinBuffer, sv/v.TotalWeight)
+		if boneMatrixBuffer, err = compressed.BoneMatrixBuffer(armature, skeleton.Names); err != nil {
+			log.Fatal(err.Error())
 		}
+
+		skinnedAnimation.EndFrame = int64(compressed.EndFrame - compressed.StartFrame + 1)
 	}
 
 	offsetBuffer = append(offsetBuffer, []float32{0.0, 0.0, 0.0, 0.0, 0.0, 0.0}...)
 
-	skinBufferID := ArrayToTexture(skinBuffer)
+	skinBufferID := ArrayToTexture(cubeSkin.Weights)
 	boneMatrixsBufferID := ArrayToTexture(boneMatrixBuffer)
 	invertedBoneMatrixBufferID := ArrayToTexture(invertedBoneMatrixBuffer)
 	offsetBufferID := ArrayToTexture(offsetBuffer)
 
-	// load all the vertex attributes for all vertices into a float array, which will later become a vertex buffer
-	points := make([]float32, len(cubeVertexData.Coordinates)*8) // 8 = 3 location coordinates + 2 texture coordinates + 1 mesh offset + 1 skin length + 1 skin offset
-
-	currentPointElement := 0
-
-	skinOffset := float32(0.0)
-
-	for _, coordinate := range cubeVertexData.Coordinates {
-		points[currentPointElement] = coordinate.Vertices[0]
-		currentPointElement++
-		points[currentPointElement] = coordinate.Vertices[1]
-		currentPointElement++
-		points[currentPointElement] = coordinate.Vertices[2]
-		currentPointElement++
-		points[currentPointElement] = coordinate.Textures[0]
-		currentPointElement++
-		points[currentPointElement] = 1 - coordinate.Textures[1]
-		currentPointElement++
-		points[currentPointElement] = 0 // hardcode the mesh offset to 0 since we only have one mesh to render
-		currentPointElement++
-		points[currentPointElement] = float32(len(coordinate.Skin))
-		currentPointElement++
-		points[currentPointElement] = skinOffset
-		currentPointElement++
-
-		skinOffset += float32(len(coordinate.Skin)) * 2
+	// a mesh without morph targets has no MRPH chunk
+	morphNames := []string{}
+	morphDeltas := []float32{}
+
+	if cubeMorph != nil {
+		morphNames = cubeMorph.Names
+		morphDeltas = cubeMorph.Deltas
 	}
 
+	// upload the morph target deltas once, the weights are re-uploaded every frame so they can be changed at runtime
+	morphWeights := NewNamedMorphWeights(morphNames)
+	morphDeltaBufferID := ArrayToTexture(morphDeltas)
+	morphWeightBufferID := ArrayToTexture(morphWeights.Values())
+
+	// the vertex buffer is stored ready to upload: 8 floats per vertex = 3 location coordinates + 2 texture coordinates
+	// + 1 mesh offset + 1 skin length + 1 skin offset
+	points := cubeMesh.Points
+	normals := VertexNormals(points, asset.VertexStride, cubeMesh.Indices)
+
 	var vaoId uint32
 	gl.GenVertexArrays(1, &vaoId)
 
@@ -217,6 +166,9 @@ func main() {
 	var vboiId uint32
 	gl.GenBuffers(1, &vboiId)
 
+	var normalVboId uint32
+	gl.GenBuffers(1, &normalVboId)
+
 	gl.BindVertexArray(vaoId)
 
 	// pass the vertex attribute float array to an array buffer
@@ -224,7 +176,7 @@ func main() {
 	gl.BufferData(gl.ARRAY_BUFFER, len(points)*4, gl.Ptr(points), gl.STATIC_DRAW)
 
 	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, vboiId)
-	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(cubeVertexData.Indices)*4, gl.Ptr(cubeVertexData.Indices), gl.STATIC_DRAW)
+	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(cubeMesh.Indices)*4, gl.Ptr(cubeMesh.Indices), gl.STATIC_DRAW)
 
 	// define the layout of a single vertex; define the first 3 floats as one attribute (xyz location)
 	gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 32, gl.PtrOffset(0))
@@ -246,47 +198,36 @@ func main() {
 	gl.VertexAttribPointer(4, 1, gl.FLOAT, false, 32, gl.PtrOffset(28))
 	gl.EnableVertexAttribArray(4)
 
+	// the normals live in their own buffer, the morph normal deltas are added to them in the shader
+	gl.BindBuffer(gl.ARRAY_BUFFER, normalVboId)
+	gl.BufferData(gl.ARRAY_BUFFER, len(normals)*4, gl.Ptr(normals), gl.STATIC_DRAW)
+	gl.VertexAttribPointer(5, 3, gl.FLOAT, false, 12, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(5)
+
 	gl.BindVertexArray(0)
 
 	// load in a texture to apply to the mesh
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../../trump.png")
+	diffuse, err := texture.LoadImage("../../../trump.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error opening image ../../../trump.png")
+		log.Fatal(err.Error())
 	}
 
-	im, err := png.Decode(ioreader)
-
-	if err != nil {
-		log.Fatal("Error decoding image ../../../trump.png")
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+	diffuseTexture, err := texture.Upload(diffuse)
+
+	if err != nil {
+		log.Fatal(err.Error())
 	}
 
-	CheckError()
+	texId := diffuseTexture.ID
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
 	gl.BindTexture(gl.TEXTURE_2D, texId)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
-
 	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
 	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
-
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-
 	gl.BindTexture(gl.TEXTURE_2D, 0)
 
 	// rotate the trump model -45 degrees on the Y axis
@@ -323,14 +264,20 @@ uniform samplerBuffer offsets; // curFrame|numFrames|meshSkinOffset|animationOff
 uniform samplerBuffer skin;   // vertex -> { boneIndex : boneInfluence } | (skinOffset + in_VertexIndex) -> for i in in_NumberOfBones -> influence (passed by engine)
 uniform samplerBuffer boneMatrices; // mesh -> animation -> frame -> { boneIndex : mat4 } | in_ModelOffset -> { animation : frame : boneIndex : mat4 }
 uniform samplerBuffer invertedMatrices;
+uniform samplerBuffer morphDeltas;  // target -> vertex -> { xyz position delta, xyz normal delta }
+uniform samplerBuffer morphWeights; // target -> weight
+uniform int morphTargetCount;
+uniform int morphVertexCount;
 
 layout (location = 0) in vec3 in_Position;
 layout (location = 1) in vec2 in_Texture;
 layout (location = 2) in float in_ModelOffset;
 layout (location = 3) in float in_NumberOfBones;
 layout (location = 4) in float in_SkinOffset;
+layout (location = 5) in vec3 in_Normal;
 
 out vec2 out_Texture;
+out vec3 out_Normal;
 
 mat4 getMatrix(int index, samplerBuffer fpgbuffer){
   float m00 = texelFetch(fpgbuffer, index + 0).r;
@@ -356,13 +303,31 @@ mat4 getMatrix(int index, samplerBuffer fpgbuffer){
   				m03, m13, m23, m33);
 }
 
+void applyMorphTargets(inout vec3 position, inout vec3 normal){
+  for(int t=0;t<morphTargetCount;++t) {
+  	float weight = texelFetch(morphWeights, t).r;
+  	int deltaOffset = (t * morphVertexCount + gl_VertexID) * 6;
+
+  	position += vec3(texelFetch(morphDeltas, deltaOffset).r,
+  					 texelFetch(morphDeltas, deltaOffset + 1).r,
+  					 texelFetch(morphDeltas, deltaOffset + 2).r) * weight;
+  	normal += vec3(texelFetch(morphDeltas, deltaOffset + 3).r,
+  				   texelFetch(morphDeltas, deltaOffset + 4).r,
+  				   texelFetch(morphDeltas, deltaOffset + 5).r) * weight;
+  }
+}
+
 void main() { 
   out_Texture = in_Texture;
   mat4 modelMatrix = getMatrix(int(in_ModelOffset*16), modelMatrices);
 
   int offset = int(in_ModelOffset * 6);
   float curFrame = texelFetch(offsets, offset).r;   				// current frame of animation currently playing
-  vec3 mod_position = in_Position;
+  vec3 morphed_position = in_Position;
+  vec3 morphed_normal = in_Normal;
+  applyMorphTargets(morphed_position, morphed_normal);	// blend shapes are applied before skinning
+  vec3 mod_position = morphed_position;
+  vec3 mod_normal = morphed_normal;
 
    if (curFrame != -1){
 	  float numFramesInAnimation = texelFetch(offsets, offset + 1).r;   	// offset for how many bones are in the current meshes armature SHOULD BE NUMBER OF FRAMES IN CURRENT ANIMATION
@@ -374,6 +339,7 @@ void main() {
 	  float vertexSkinOffset = skinOffset + in_SkinOffset;      			// get the starting point of the current vertices skin
 
 	  mod_position = vec3(0,0,0);
+	  mod_normal = vec3(0,0,0);
 
 	  for(float i=0;i<in_NumberOfBones;++i) {
 	  	int vOffset = int(vertexSkinOffset + i*2);        	// for each bone that affects this vertex..
@@ -386,12 +352,14 @@ void main() {
 	  	mat4 boneMat = getMatrix(int(matIndex), boneMatrices);
 	  	mat4 invertedMat = getMatrix(int(invertedMatrixOffset + (boneIndex * 16)), invertedMatrices);
 
-	    mod_position = mod_position + ((boneMat * (invertedMat * vec4(in_Position, 1.0))) *  boneInfluence ).xyz;
+	    mod_position = mod_position + ((boneMat * (invertedMat * vec4(morphed_position, 1.0))) *  boneInfluence ).xyz;
+	    mod_normal = mod_normal + mat3(boneMat * invertedMat) * morphed_normal * boneInfluence;	// bones do not scale, so no inverse transpose
 	  }
   }
 
 
   vec3 worldPos = (modelMatrix * vec4(mod_position, 1.0)).xyz;
+  out_Normal = mat3(modelMatrix) * mod_normal;
 
   gl_Position = projectionMatrix * viewMatrix * vec4(worldPos, 1.0);
 }
@@ -401,32 +369,23 @@ void main() {
 uniform sampler2D diffuse;
 
 in vec2 out_Texture;
+in vec3 out_Normal;
 
 out vec4 out_Colour;
 
+const vec3 lightDirection = vec3(0.4, 0.8, 0.45);	// towards the light, roughly normalised
+
 void main() {
-  out_Colour = vec4(texture(diffuse,out_Texture).rgb , 1.0);
+  float light = 0.35 + 0.65 * max(dot(normalize(out_Normal), lightDirection), 0.0);	// ambient + lambert
+  out_Colour = vec4(texture(diffuse,out_Texture).rgb * light, 1.0);
 }
 `
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
-
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
-
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
 	projectionMatrix := NewProjectionMatrix(Width, Height)
 
@@ -471,35 +430,27 @@ void main() {
 
 		complete := []float32{float32(curFrame), float32(skinnedAnimation.EndFrame), 0.0, 0.0, 0.0, 0.0}
 
+		// the clip's weight tracks drive the morph targets, a clip without them leaves the weights as they are
+		clip.MorphWeights["Cube"].Apply(float32(curFrame), morphWeights)
+
 		UpdateArrayToTexture(offsetBufferID.BufferID, complete)
+		UpdateArrayToTexture(morphWeightBufferID.BufferID, morphWeights.Values())
 
-		gl.UseProgram(shaderProgram)
-		var location int32 = -1
+		shaderProgram.Use()
 
 		// set uniform values in the shader program
-		location = gl.GetUniformLocation(shaderProgram, GlStr("projectionMatrix"))
-		gl.UniformMatrix4fv(location, 1, true, &projectionMatrix.Get1D()[0])
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("viewMatrix"))
-		gl.UniformMatrix4fv(location, 1, true, &viewMatrix.Get1D()[0])
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("modelMatrices"))
-		gl.Uniform1i(location, 0)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("offsets"))
-		gl.Uniform1i(location, 1)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("skin"))
-		gl.Uniform1i(location, 2)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("boneMatrices"))
-		gl.Uniform1i(location, 3)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("invertedMatrices"))
-		gl.Uniform1i(location, 4)
-
-		location = gl.GetUniformLocation(shaderProgram, GlStr("diffuse"))
-		gl.Uniform1i(location, 5)
+		shaderProgram.SetMat4("projectionMatrix", projectionMatrix.Get1D())
+		shaderProgram.SetMat4("viewMatrix", viewMatrix.Get1D())
+		shaderProgram.SetSampler("modelMatrices", 0)
+		shaderProgram.SetSampler("offsets", 1)
+		shaderProgram.SetSampler("skin", 2)
+		shaderProgram.SetSampler("boneMatrices", 3)
+		shaderProgram.SetSampler("invertedMatrices", 4)
+		shaderProgram.SetSampler("diffuse", 5)
+		shaderProgram.SetSampler("morphDeltas", 6)
+		shaderProgram.SetSampler("morphWeights", 7)
+		shaderProgram.SetInt("morphTargetCount", int32(len(morphNames)))
+		shaderProgram.SetInt("morphVertexCount", int32(cubeMesh.VertexCount()))
 
 		// bind the buffers at the appropriate texture slots
 		gl.ActiveTexture(gl.TEXTURE0)
@@ -520,8 +471,14 @@ void main() {
 		gl.ActiveTexture(gl.TEXTURE5)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
 
+		gl.ActiveTexture(gl.TEXTURE6)
+		gl.BindTexture(gl.TEXTURE_BUFFER, morphDeltaBufferID.TextureID)
+
+		gl.ActiveTexture(gl.TEXTURE7)
+		gl.BindTexture(gl.TEXTURE_BUFFER, morphWeightBufferID.TextureID)
+
 		gl.BindVertexArray(vaoId)
-		gl.DrawElements(gl.TRIANGLES, int32(len(cubeVertexData.Indices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
+		gl.DrawElements(gl.TRIANGLES, int32(len(cubeMesh.Indices)), gl.UNSIGNED_INT, gl.PtrOffset(0))
 		gl.BindVertexArray(0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
@@ -542,8 +499,16 @@ void main() {
 		gl.ActiveTexture(gl.TEXTURE5)
 		gl.BindTexture(gl.TEXTURE_2D, 0)
 
+		gl.ActiveTexture(gl.TEXTURE6)
+		gl.BindTexture(gl.TEXTURE_BUFFER, 0)
+
+		gl.ActiveTexture(gl.TEXTURE7)
+		gl.BindTexture(gl.TEXTURE_BUFFER, 0)
+
 		glfw.PollEvents()
 		window.SwapBuffers()
-		CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
 }
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	. "github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+// validate checks an exported vertex, armature, animation and morph weights file against the export schema and
+// for broken data, and lists every problem found. Files that break the schema are not checked any further.
+// -schema names the schema version the exporter wrote, which must be the one this validator knows.
+func main() {
+	vertexFile := flag.String("vertices", "", "exported vertex data JSON file")
+	armatureFile := flag.String("armature", "", "exported armature data JSON file")
+	animationFile := flag.String("animation", "", "exported animation matrices JSON file")
+	morphWeightsFile := flag.String("morphWeights", "", "exported morph weights JSON file")
+	mesh := flag.String("mesh", "Cube", "mesh name in the export")
+	armatureName := flag.String("armatureName", "Armature", "armature name in the export")
+	action := flag.String("action", "ArmatureAction", "action name in the export")
+	startFrame := flag.Int64("start", 1, "first frame of the animation")
+	endFrame := flag.Int64("end", -1, "last frame of the animation, defaults to the last keyed frame")
+	schema := flag.Int("schema", SchemaVersion, "export schema version the files were written against")
+	flag.Parse()
+
+	if *schema != SchemaVersion {
+		log.Fatalf("export schema version %d is not supported, this validator checks version %d", *schema, SchemaVersion)
+	}
+
+	if *vertexFile == "" && *armatureFile == "" && *animationFile == "" && *morphWeightsFile == "" {
+		log.Fatal("at least one of -vertices, -armature, -animation and -morphWeights is required")
+	}
+
+	failed := false
+
+	report := func(file string, errs ValidationErrors) bool {
+		for _, e := range errs {
+			fmt.Printf("%s: %s\n", file, e)
+		}
+
+		failed = failed || len(errs) > 0
+
+		return len(errs) == 0
+	}
+
+	vertexOK := *vertexFile != "" && report(*vertexFile, ValidateVertexDataSchema(readFile(*vertexFile)))
+	armatureOK := *armatureFile != "" && report(*armatureFile, ValidateArmatureDataSchema(readFile(*armatureFile)))
+	animationOK := *animationFile != "" && report(*animationFile, ValidateAnimationMatricesSchema(readFile(*animationFile)))
+
+	if *morphWeightsFile != "" {
+		report(*morphWeightsFile, ValidateMorphWeightsSchema(readFile(*morphWeightsFile)))
+	}
+
+	// the remaining checks need the armature
+	if armatureOK {
+		var armatureData map[string]*Armature
+		readJSON(*armatureFile, &armatureData)
+
+		armature, ok := armatureData[*armatureName]
+
+		if !ok || armature == nil {
+			log.Fatalf("no armature %q in %s", *armatureName, *armatureFile)
+		}
+
+		report(*armatureFile, ValidateArmature(armature))
+
+		if vertexOK {
+			var vertexData map[string]Mesh
+			readJSON(*vertexFile, &vertexData)
+
+			m, ok := vertexData[*mesh]
+
+			if !ok {
+				log.Fatalf("no mesh %q in %s", *mesh, *vertexFile)
+			}
+
+			report(*vertexFile, ValidateSkin(&m, armature))
+		}
+
+		if animationOK {
+			var animationData ExportedAnimations
+			readJSON(*animationFile, &animationData)
+
+			keyframes, ok := animationData[*mesh][*action]
+
+			if !ok {
+				log.Fatalf("no action %q for mesh %q in %s", *action, *mesh, *animationFile)
+			}
+
+			if *endFrame < 0 {
+				*endFrame = lastKeyedFrame(keyframes)
+			}
+
+			report(*animationFile, ValidateKeyframes(keyframes, armature, *startFrame, *endFrame))
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+
+	fmt.Printf("valid against export schema version %d\n", SchemaVersion)
+}
+
+func lastKeyedFrame(keyframes map[string]*IntToMatrix4fMap) int64 {
+	last := int64(0)
+
+	for _, frames := range keyframes {
+		if frames == nil {
+			continue
+		}
+
+		for _, frame := range frames.Keys() {
+			if int64(frame) > last {
+				last = int64(frame)
+			}
+		}
+	}
+
+	return last
+}
+
+func readFile(file string) []byte {
+	b, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	return b
+}
+
+func readJSON(file string, v interface{}) {
+	err := json.Unmarshal(readFile(file), v)
+
+	if err != nil {
+		log.Fatalf("%s: %s", file, err.Error())
+	}
+}
@@ -1,16 +1,12 @@
 package main
 
 import (
-	"github.com/go-gl/glfw/v3.2/glfw"
 	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
 	"log"
 	"runtime"
-	"fmt"
-	"image"
-	"image/png"
-	"image/draw"
-	"os"
-	"strings"
 )
 
 const (
@@ -19,63 +15,24 @@ const (
 	Title = "Rendering meshes with textures"
 )
 
-func initGLFW() *glfw.Window {
-	if err := glfw.Init(); err != nil {
-		panic(err)
-	}
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 3)
-	glfw.WindowHint(glfw.ContextVersionMinor, 3)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-
-	glfw.WindowHint(glfw.DepthBits, 24)
-	glfw.WindowHint(glfw.StencilBits, 8)
+func main() {
+	runtime.LockOSThread()
 
-	window, err := glfw.CreateWindow(Width, Height, Title, nil, nil)
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title})
 
 	if err != nil {
-		panic(err)
-	}
-
-	window.SetCursorPos(0, 0)
-	window.MakeContextCurrent()
-
-	return window
-}
-
-func initOpenGL(window *glfw.Window) {
-	if err := gl.Init(); err != nil {
-		panic(err)
+		log.Fatal(err.Error())
 	}
 
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	log.Println("OpenGL version", version)
-
-	gl.ClearColor(0, 0.1568627451, 0.2039215686, 1)
-
-	width, height := window.GetFramebufferSize()
-	gl.Viewport(0, 0, int32(width), int32(height))
-	gl.FrontFace(gl.CCW)
-	gl.PolygonMode(gl.FRONT_AND_BACK, gl.FILL)
-	gl.Enable(gl.DEPTH_TEST)
-	gl.Enable(gl.BLEND)
-}
-
-func main() {
-	runtime.LockOSThread()
-
-	window := initGLFW()
-	initOpenGL(window)
-
 	points := []float32{
 		0.0, 0.5, 0.0, 0.5, 1.0,
 		0.5, -0.5, 0.0, 1.0, 0.0,
 		-0.5, -0.5, 0.0, 0.0, 0.0,
 	}
 
-	CheckError()
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
+	}
 
 	var vbo uint32
 	gl.GenBuffers(1, &vbo)
@@ -92,56 +49,28 @@ func main() {
 	gl.VertexAttribPointer(1, 2, gl.FLOAT, false, 20, gl.PtrOffset(0))
 	gl.BindVertexArray(0)
 
-	CheckError()
-
-	var diffuse *image.RGBA
-
-	ioreader, err := os.Open("../../grid.png")
-
-	if err != nil {
-		log.Fatal("Error opening image ../../grid.png")
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	im, err := png.Decode(ioreader)
+	diffuse, err := texture.LoadImage("../../grid.png", texture.Options{})
 
 	if err != nil {
-		log.Fatal("Error decoding image ../../grid.png")
-	}
-
-	switch trueim := im.(type) {
-	case *image.RGBA:
-		diffuse = trueim
-	default:
-		copy := image.NewRGBA(trueim.Bounds())
-		draw.Draw(copy, trueim.Bounds(), trueim, image.Pt(0, 0), draw.Src)
-		diffuse = copy
+		log.Fatal(err.Error())
 	}
 
-	res := image.NewRGBA(diffuse.Rect)
-
-	for i := 0; i < len(diffuse.Pix); i += 4 {
-		res.Pix[i] = diffuse.Pix[i]
-		res.Pix[i+1] = diffuse.Pix[i+1]
-		res.Pix[i+2] = diffuse.Pix[i+2]
-		res.Pix[i+3] = diffuse.Pix[i+3]
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
 	}
 
-	CheckError()
-
 
-	var texId uint32
-	gl.GenTextures(1, &texId)
-	gl.BindTexture(gl.TEXTURE_2D, texId)
-	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 1024, 1024, 0, gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(diffuse.Pix))
+	diffuseTexture, err := texture.Upload(diffuse)
 
-	gl.GenerateMipmap(gl.TEXTURE_2D)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.REPEAT)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.REPEAT)
-
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
-	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	gl.BindTexture(gl.TEXTURE_2D, 0)
+	texId := diffuseTexture.ID
 
 	vertexSourceAsString := `#version 330
 in vec3 in_Position;
@@ -160,44 +89,33 @@ void main() {
   out_Colour = vec4(texture(diffuse,out_Texture).rgb , 1.0);
 }`
 
-	vs := gl.CreateShader(gl.VERTEX_SHADER)
-	vertexShaderSource, vertexFree := gl.Strs(fmt.Sprintf("%s%s", vertexSourceAsString, "\x00"))
-	gl.ShaderSource(vs, 1, vertexShaderSource, nil)
-	defer vertexFree()
-	gl.CompileShader(vs)
-
-	fs := gl.CreateShader(gl.FRAGMENT_SHADER)
-	fragmentShaderSource, fragmentFree := gl.Strs(fmt.Sprintf("%s%s", fragmentSourceAsString, "\x00"))
-	gl.ShaderSource(fs, 1, fragmentShaderSource, nil)
-	defer fragmentFree()
-	gl.CompileShader(fs)
-
-	shaderProgram := gl.CreateProgram()
-	gl.AttachShader(shaderProgram, fs)
-	gl.AttachShader(shaderProgram, vs)
+	shaderProgram, err := engine.NewProgram(vertexSourceAsString, fragmentSourceAsString)
 
-	gl.LinkProgram(shaderProgram)
-	gl.ValidateProgram(shaderProgram)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-	CheckError()
+	if err := engine.CheckError(); err != nil {
+		log.Fatal(err.Error())
+	}
 
 
 	for !window.ShouldClose() {
 		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		gl.DepthMask(true)
 		gl.Disable(gl.BLEND)
-		gl.UseProgram(shaderProgram)
+		shaderProgram.Use()
 
-		var location int32 = -1
-		location = gl.GetUniformLocation(shaderProgram, glStr("diffuse"))
-		gl.Uniform1i(location, 0)
+		shaderProgram.SetSampler("diffuse", 0)
 
 		gl.ActiveTexture(gl.TEXTURE0)
 		gl.BindTexture(gl.TEXTURE_2D, texId)
 
 		gl.BindVertexArray(vao)
 		gl.DrawArrays(gl.TRIANGLES, 0, 3)
-		CheckError()
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 
 
 		gl.ActiveTexture(gl.TEXTURE0)
@@ -205,24 +123,8 @@ void main() {
 
 		glfw.PollEvents()
 		window.SwapBuffers()
-		CheckError()
-	}
-}
-
-func glStr(str string) *uint8 {
-	if !strings.HasSuffix(str, "\x00") {
-		str = str + "\x00"
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
 	}
-	return gl.Str(str)
 }
-
-
-func CheckError() {
-
-	err := gl.GetError()
-
-	if err != gl.NO_ERROR {
-		log.Print("CheckError")
-		log.Fatal(err)
-	}
-}
\ No newline at end of file
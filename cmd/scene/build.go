@@ -0,0 +1,334 @@
+package scene
+
+import (
+	"fmt"
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/texture"
+	"io/ioutil"
+	"os"
+)
+
+const defaultVertexShader = `#version 330
+
+uniform mat4 projectionMatrix;
+uniform mat4 viewMatrix;
+uniform mat4 modelMatrix;
+
+layout (location = 0) in vec3 in_Position;
+layout (location = 1) in vec2 in_Texture;
+
+out vec2 out_Texture;
+
+void main() {
+  out_Texture = in_Texture;
+  gl_Position = projectionMatrix * viewMatrix * modelMatrix * vec4(in_Position, 1.0);
+}
+`
+
+const defaultFragmentShader = `#version 330
+
+uniform sampler2D diffuse;
+
+in vec2 out_Texture;
+
+out vec4 out_Colour;
+
+void main() {
+  out_Colour = vec4(texture(diffuse,out_Texture).rgb , 1.0);
+}
+`
+
+type mesh struct {
+	vao, vbo, ebo uint32
+	count         int32
+}
+
+// World is a scene with its GPU resources, ready to draw.
+type World struct {
+	Scene    *Scene
+	meshes   map[string]*mesh
+	textures map[string]*texture.Texture
+	programs map[string]*engine.Program // "" is the default shader
+	order    []*Node                    // parents before children
+}
+
+// Build uploads the scene's meshes and textures and compiles its shaders. It needs a current GL context.
+func (s *Scene) Build() (*World, error) {
+	w := &World{Scene: s, meshes: map[string]*mesh{}, textures: map[string]*texture.Texture{}, programs: map[string]*engine.Program{}}
+
+	for name, m := range s.Meshes {
+		vertices, indices, err := s.meshData(m)
+
+		if err != nil {
+			w.Delete()
+			return nil, fmt.Errorf("scene: mesh %q: %v", name, err)
+		}
+
+		w.meshes[name] = upload(vertices, indices)
+	}
+
+	for name, t := range s.Textures {
+		container, err := texture.LoadImage(s.Path(t.File), texture.Options{FlipY: t.FlipY, Premultiply: t.Premultiply})
+
+		if err == nil {
+			w.textures[name], err = texture.Upload(container)
+		}
+
+		if err != nil {
+			w.Delete()
+			return nil, fmt.Errorf("scene: texture %q: %v", name, err)
+		}
+	}
+
+	program, err := engine.NewProgram(defaultVertexShader, defaultFragmentShader)
+
+	if err != nil {
+		w.Delete()
+		return nil, fmt.Errorf("scene: default shader: %v", err)
+	}
+
+	w.programs[""] = program
+
+	for name, shader := range s.Shaders {
+		vertex, err := ioutil.ReadFile(s.Path(shader.Vertex))
+
+		if err != nil {
+			w.Delete()
+			return nil, fmt.Errorf("scene: shader %q: %v", name, err)
+		}
+
+		fragment, err := ioutil.ReadFile(s.Path(shader.Fragment))
+
+		if err != nil {
+			w.Delete()
+			return nil, fmt.Errorf("scene: shader %q: %v", name, err)
+		}
+
+		program, err := engine.NewProgram(string(vertex), string(fragment))
+
+		if err != nil {
+			w.Delete()
+			return nil, fmt.Errorf("scene: shader %q: %v", name, err)
+		}
+
+		w.programs[name] = program
+	}
+
+	// validate has ruled out cycles, so every pass places at least one node
+	placed := map[string]bool{"": true}
+
+	for len(w.order) < len(s.Nodes) {
+		for _, n := range s.Nodes {
+			if !placed[n.Name] && placed[n.Parent] {
+				w.order = append(w.order, n)
+				placed[n.Name] = true
+			}
+		}
+	}
+
+	if err := gl.GetError(); err != gl.NO_ERROR {
+		w.Delete()
+		return nil, fmt.Errorf("scene: GL error 0x%X building the scene", err)
+	}
+
+	return w, nil
+}
+
+// meshData returns interleaved x, y, z, u, v vertices and the indices of a mesh.
+func (s *Scene) meshData(m *Mesh) ([]float32, []uint32, error) {
+	if m.File == "" {
+		return m.Vertices, m.Indices, nil
+	}
+
+	file, err := os.Open(s.Path(m.File))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer file.Close()
+
+	meshes, err := animation.DecodeFlatMeshes(file)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flat, present := meshes[m.Name]
+
+	if !present {
+		return nil, nil, fmt.Errorf("%s has no mesh %q", m.File, m.Name)
+	}
+
+	vertices := make([]float32, 0, flat.VertexCount()*5)
+
+	for i := 0; i < flat.VertexCount(); i++ {
+		vertices = append(vertices, flat.Positions[i*3:i*3+3]...)
+		vertices = append(vertices, flat.UVs[i*2:i*2+2]...)
+	}
+
+	return vertices, flat.Indices, nil
+}
+
+func upload(vertices []float32, indices []uint32) *mesh {
+	m := &mesh{count: int32(len(indices))}
+
+	gl.GenVertexArrays(1, &m.vao)
+	gl.GenBuffers(1, &m.vbo)
+	gl.GenBuffers(1, &m.ebo)
+
+	gl.BindVertexArray(m.vao)
+
+	// gl.Ptr refuses empty slices, an empty mesh simply draws nothing
+	if len(vertices) > 0 && len(indices) > 0 {
+		gl.BindBuffer(gl.ARRAY_BUFFER, m.vbo)
+		gl.BufferData(gl.ARRAY_BUFFER, len(vertices)*4, gl.Ptr(vertices), gl.STATIC_DRAW)
+
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, m.ebo)
+		gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(indices)*4, gl.Ptr(indices), gl.STATIC_DRAW)
+	}
+
+	gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 20, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(0)
+
+	gl.VertexAttribPointer(1, 2, gl.FLOAT, false, 20, gl.PtrOffset(12))
+	gl.EnableVertexAttribArray(1)
+
+	gl.BindVertexArray(0)
+
+	return m
+}
+
+// ModelMatrices returns every node's model matrix at a time in seconds, parents applied.
+func (w *World) ModelMatrices(time float32) map[string]*animation.Matrix4f {
+	matrices := map[string]*animation.Matrix4f{}
+
+	for _, n := range w.order {
+		local := n.Local()
+
+		if motion := w.Scene.Animations[n.Animation]; motion != nil {
+			sampled := motion.Sample(time)
+
+			if len(motion.Translation) > 0 {
+				local.Translation = sampled.Translation
+			}
+
+			if len(motion.Rotation) > 0 {
+				local.Rotation = sampled.Rotation
+			}
+
+			if len(motion.Scale) > 0 {
+				local.Scale = sampled.Scale
+			}
+		}
+
+		matrices[n.Name] = local.Matrix()
+
+		if n.Parent != "" {
+			matrices[n.Name] = matrices[n.Parent].Mul(matrices[n.Name])
+		}
+	}
+
+	return matrices
+}
+
+// Draw clears the framebuffer and draws every node with a mesh at a time in seconds.
+func (w *World) Draw(time float32, width, height int) {
+	clearColor := engine.DefaultClearColor
+
+	if w.Scene.ClearColor != nil {
+		clearColor = *w.Scene.ClearColor
+	}
+
+	projectionMatrix, viewMatrix := w.cameraMatrices(width, height)
+	modelMatrices := w.ModelMatrices(time)
+
+	gl.ClearColor(clearColor[0], clearColor[1], clearColor[2], clearColor[3])
+	gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
+
+	for _, n := range w.order {
+		m := w.meshes[n.Mesh]
+
+		if m == nil {
+			continue
+		}
+
+		program := w.programs[n.Shader]
+		program.Use()
+		program.SetMat4("projectionMatrix", projectionMatrix.Get1D())
+		program.SetMat4("viewMatrix", viewMatrix.Get1D())
+		program.SetMat4("modelMatrix", modelMatrices[n.Name].Get1D())
+		program.SetSampler("diffuse", 0)
+
+		if t := w.textures[n.Texture]; t != nil {
+			t.Bind(0)
+		}
+
+		gl.BindVertexArray(m.vao)
+		gl.DrawElements(gl.TRIANGLES, m.count, gl.UNSIGNED_INT, gl.PtrOffset(0))
+		gl.BindVertexArray(0)
+
+		if t := w.textures[n.Texture]; t != nil {
+			gl.BindTexture(t.Target, 0)
+		}
+	}
+
+	gl.UseProgram(0)
+}
+
+func (w *World) cameraMatrices(width, height int) (*animation.Matrix4f, *animation.Matrix4f) {
+	identity := animation.IdentityTransform().Matrix()
+	c := w.Scene.Camera
+
+	if c == nil {
+		return identity, identity
+	}
+
+	fieldOfView, near, far, up := c.FieldOfView, c.Near, c.Far, [3]float32{0, 1, 0}
+
+	if fieldOfView == 0 {
+		fieldOfView = 80
+	}
+
+	if near == 0 {
+		near = 0.1
+	}
+
+	if far == 0 {
+		far = 300
+	}
+
+	if c.Up != nil {
+		up = *c.Up
+	}
+
+	projectionMatrix := animation.NewPerspectiveMatrix(fieldOfView, float32(width)/float32(height), near, far)
+	viewMatrix := animation.NewLookAtMatrix(vector(c.Position), vector(c.Target), vector(up))
+
+	return projectionMatrix, viewMatrix
+}
+
+func vector(v [3]float32) animation.Vector3f {
+	return animation.Vector3f{X: v[0], Y: v[1], Z: v[2]}
+}
+
+// Delete frees the GPU resources.
+func (w *World) Delete() {
+	for _, m := range w.meshes {
+		gl.DeleteVertexArrays(1, &m.vao)
+		gl.DeleteBuffers(1, &m.vbo)
+		gl.DeleteBuffers(1, &m.ebo)
+	}
+
+	for _, t := range w.textures {
+		if t != nil {
+			t.Delete()
+		}
+	}
+
+	for _, program := range w.programs {
+		program.Delete()
+	}
+}
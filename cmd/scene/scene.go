@@ -0,0 +1,198 @@
+// Package scene describes what an example draws in a JSON file, meshes, textures, shaders, a node hierarchy with
+// transforms and animations, the camera and the clear colour, and builds the GPU resources for it.
+//
+// Paths in a scene file are relative to the file. A node without a mesh only groups its children, and nodes
+// without a shader use one that takes projectionMatrix, viewMatrix and modelMatrix uniforms, a diffuse sampler,
+// the position at attribute 0 and the UV at attribute 1; custom shaders follow the same names.
+package scene
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/renderingskinnedanimation/animation"
+	"io/ioutil"
+	"path/filepath"
+)
+
+type Scene struct {
+	ClearColor *[4]float32                              `json:"clearColor"` // defaults to engine.DefaultClearColor
+	Camera     *Camera                                  `json:"camera"`     // nil draws in clip space
+	Meshes     map[string]*Mesh                         `json:"meshes"`
+	Textures   map[string]*Texture                      `json:"textures"`
+	Shaders    map[string]*Shader                       `json:"shaders"`
+	Animations map[string]*animation.TransformAnimation `json:"animations"`
+	Nodes      []*Node                                  `json:"nodes"`
+
+	dir string
+}
+
+type Camera struct {
+	Position    [3]float32  `json:"position"`
+	Target      [3]float32  `json:"target"`
+	Up          *[3]float32 `json:"up"`          // defaults to +y
+	FieldOfView float32     `json:"fieldOfView"` // vertical, in degrees, defaults to 80
+	Near        float32     `json:"near"`        // defaults to 0.1
+	Far         float32     `json:"far"`         // defaults to 300
+}
+
+// Mesh is either inline, Vertices holding x, y, z, u, v for each vertex, or the mesh called Name in an
+// exported vertex data File.
+type Mesh struct {
+	Vertices []float32 `json:"vertices"`
+	Indices  []uint32  `json:"indices"`
+	File     string    `json:"file"`
+	Name     string    `json:"name"`
+}
+
+type Texture struct {
+	File        string `json:"file"`
+	FlipY       bool   `json:"flipY"`
+	Premultiply bool   `json:"premultiply"`
+}
+
+type Shader struct {
+	Vertex   string `json:"vertex"`
+	Fragment string `json:"fragment"`
+}
+
+// Node places a mesh. Its transform is relative to its parent's, and an animation replaces the components
+// it has keys for.
+type Node struct {
+	Name        string      `json:"name"`
+	Parent      string      `json:"parent"`
+	Mesh        string      `json:"mesh"`
+	Texture     string      `json:"texture"`
+	Shader      string      `json:"shader"`
+	Animation   string      `json:"animation"`
+	Translation [3]float32  `json:"translation"`
+	Rotation    *[4]float32 `json:"rotation"` // x, y, z, w, defaults to none
+	Scale       *[3]float32 `json:"scale"`    // defaults to 1, 1, 1
+}
+
+// Load reads and checks a scene file. Unknown fields are errors so that typos do not go unnoticed.
+func Load(path string) (*Scene, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	s := &Scene{dir: filepath.Dir(path)}
+
+	if err := decoder.Decode(s); err != nil {
+		return nil, fmt.Errorf("scene: %s: %v", path, err)
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("scene: %s: %v", path, err)
+	}
+
+	return s, nil
+}
+
+// Path resolves a path from the scene file.
+func (s *Scene) Path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(s.dir, path)
+}
+
+func (s *Scene) validate() error {
+	for name, m := range s.Meshes {
+		switch {
+		case m.File != "" && (m.Vertices != nil || m.Indices != nil):
+			return fmt.Errorf("mesh %q has both a file and inline vertices", name)
+		case m.File != "" && m.Name == "":
+			return fmt.Errorf("mesh %q needs the name of the mesh in %s", name, m.File)
+		case m.File == "" && len(m.Vertices)%5 != 0:
+			return fmt.Errorf("mesh %q has %d vertex values, expected x, y, z, u, v per vertex", name, len(m.Vertices))
+		}
+
+		for _, index := range m.Indices {
+			if int(index) >= len(m.Vertices)/5 {
+				return fmt.Errorf("mesh %q index %d is out of range for %d vertices", name, index, len(m.Vertices)/5)
+			}
+		}
+	}
+
+	for name, t := range s.Textures {
+		if t.File == "" {
+			return fmt.Errorf("texture %q needs a file", name)
+		}
+	}
+
+	for name, shader := range s.Shaders {
+		if shader.Vertex == "" || shader.Fragment == "" {
+			return fmt.Errorf("shader %q needs a vertex and a fragment file", name)
+		}
+	}
+
+	nodes := map[string]*Node{}
+
+	for i, n := range s.Nodes {
+		if n.Name == "" {
+			return fmt.Errorf("node %d needs a name", i)
+		}
+
+		if nodes[n.Name] != nil {
+			return fmt.Errorf("node %q appears more than once", n.Name)
+		}
+
+		nodes[n.Name] = n
+	}
+
+	for _, n := range s.Nodes {
+		if _, present := s.Meshes[n.Mesh]; n.Mesh != "" && !present {
+			return fmt.Errorf("node %q uses mesh %q which does not exist", n.Name, n.Mesh)
+		}
+
+		if _, present := s.Textures[n.Texture]; n.Texture != "" && !present {
+			return fmt.Errorf("node %q uses texture %q which does not exist", n.Name, n.Texture)
+		}
+
+		if _, present := s.Shaders[n.Shader]; n.Shader != "" && !present {
+			return fmt.Errorf("node %q uses shader %q which does not exist", n.Name, n.Shader)
+		}
+
+		if _, present := s.Animations[n.Animation]; n.Animation != "" && !present {
+			return fmt.Errorf("node %q uses animation %q which does not exist", n.Name, n.Animation)
+		}
+
+		if n.Parent != "" && nodes[n.Parent] == nil {
+			return fmt.Errorf("node %q has parent %q which does not exist", n.Name, n.Parent)
+		}
+
+		// walking up more parents than there are nodes means going round in a circle
+		depth := 0
+
+		for parent := n.Parent; parent != ""; parent = nodes[parent].Parent {
+			if depth++; depth > len(s.Nodes) {
+				return fmt.Errorf("node %q is its own ancestor", n.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Local returns the node's own transform.
+func (n *Node) Local() animation.Transform {
+	t := animation.IdentityTransform()
+	t.Translation = animation.Vector3f{X: n.Translation[0], Y: n.Translation[1], Z: n.Translation[2]}
+
+	if n.Rotation != nil {
+		t.Rotation = animation.Quaternion{X: n.Rotation[0], Y: n.Rotation[1], Z: n.Rotation[2], W: n.Rotation[3]}.Normalize()
+	}
+
+	if n.Scale != nil {
+		t.Scale = animation.Vector3f{X: n.Scale[0], Y: n.Scale[1], Z: n.Scale[2]}
+	}
+
+	return t
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.2/glfw"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/engine"
+	"github.com/hellmouthengine/hellmouthxyz/cmd/scene"
+	"log"
+	"runtime"
+	"time"
+)
+
+const (
+	Width  = 640
+	Height = 480
+	Title  = "Scene viewer"
+)
+
+// viewer draws a scene file, so that new scenes need no Go code.
+func main() {
+	path := flag.String("scene", "grid.json", "scene file")
+	flag.Parse()
+
+	runtime.LockOSThread()
+
+	s, err := scene.Load(*path)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	window, err := engine.Init(engine.Options{Width: Width, Height: Height, Title: Title})
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	world, err := s.Build()
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	defer world.Delete()
+
+	start := time.Now()
+
+	for !window.ShouldClose() {
+		width, height := window.GetFramebufferSize()
+		gl.Viewport(0, 0, int32(width), int32(height))
+
+		world.Draw(float32(time.Since(start).Seconds()), width, height)
+
+		glfw.PollEvents()
+		window.SwapBuffers()
+
+		if err := engine.CheckError(); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package texture
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+)
+
+func init() {
+	image.RegisterFormat("bmp", "BM", decodeBMP, decodeBMPConfig)
+}
+
+type bmpHeader struct {
+	width, height int
+	topDown       bool
+	bitCount      int
+	compression   uint32
+	pixelOffset   int
+	headerSize    int
+}
+
+// readBMPHeader reads the file header and a BITMAPINFOHEADER or any of its longer successors.
+func readBMPHeader(data []byte) (*bmpHeader, error) {
+	if len(data) < 54 || string(data[:2]) != "BM" {
+		return nil, errors.New("not a BMP file")
+	}
+
+	h := &bmpHeader{
+		width:       int(int32(binary.LittleEndian.Uint32(data[18:]))),
+		height:      int(int32(binary.LittleEndian.Uint32(data[22:]))),
+		bitCount:    int(binary.LittleEndian.Uint16(data[28:])),
+		compression: binary.LittleEndian.Uint32(data[30:]),
+		pixelOffset: int(binary.LittleEndian.Uint32(data[10:])),
+		headerSize:  int(binary.LittleEndian.Uint32(data[14:])),
+	}
+
+	if h.headerSize < 40 {
+		return nil, errors.New("BMP core headers are not supported")
+	}
+
+	// a negative height stores rows top down
+	if h.height < 0 {
+		h.height, h.topDown = -h.height, true
+	}
+
+	if h.width <= 0 || h.height <= 0 {
+		return nil, errors.New("BMP size must be positive")
+	}
+
+	return h, nil
+}
+
+func decodeBMPConfig(r io.Reader) (image.Config, error) {
+	data := make([]byte, 54)
+
+	if _, err := io.ReadFull(r, data); err != nil {
+		return image.Config{}, err
+	}
+
+	h, err := readBMPHeader(data)
+
+	if err != nil {
+		return image.Config{}, err
+	}
+
+	model := color.RGBAModel
+
+	if h.bitCount == 32 {
+		model = color.NRGBAModel
+	}
+
+	return image.Config{ColorModel: model, Width: h.width, Height: h.height}, nil
+}
+
+// decodeBMP reads uncompressed 1, 4, 8, 24 and 32 bit bitmaps, the last with BI_BITFIELDS masks too. 32 bit
+// bitmaps without an alpha mask are opaque.
+func decodeBMP(r io.Reader) (image.Image, error) {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := readBMPHeader(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// 0 is BI_RGB, 3 BI_BITFIELDS
+	masks := [4]uint32{0xFF0000, 0xFF00, 0xFF, 0}
+
+	switch {
+	case h.compression == 3 && h.bitCount == 32:
+		if len(data) < 14+40+16 {
+			return nil, errors.New("BMP bit field masks are truncated")
+		}
+
+		for i := 0; i < 3; i++ {
+			masks[i] = binary.LittleEndian.Uint32(data[14+40+4*i:])
+		}
+
+		// only V4 and later headers carry an alpha mask
+		if h.headerSize >= 108 {
+			masks[3] = binary.LittleEndian.Uint32(data[14+40+12:])
+		}
+	case h.compression != 0:
+		return nil, errors.New("compressed BMP files are not supported")
+	}
+
+	var palette color.Palette
+
+	switch h.bitCount {
+	case 1, 4, 8:
+		colors := int(binary.LittleEndian.Uint32(data[46:]))
+
+		if colors == 0 || colors > 1<<uint(h.bitCount) {
+			colors = 1 << uint(h.bitCount)
+		}
+
+		start := 14 + h.headerSize
+
+		if start+4*colors > len(data) {
+			return nil, errors.New("BMP palette is truncated")
+		}
+
+		for i := 0; i < colors; i++ {
+			entry := data[start+4*i:]
+			palette = append(palette, color.RGBA{entry[2], entry[1], entry[0], 255})
+		}
+	case 24, 32:
+	default:
+		return nil, errors.New("unsupported BMP bit count")
+	}
+
+	rowBytes := (h.width*h.bitCount + 31) / 32 * 4
+
+	if h.pixelOffset < 0 || h.pixelOffset > len(data) || rowBytes > len(data) || h.height > (len(data)-h.pixelOffset)/rowBytes {
+		return nil, errors.New("BMP pixels are truncated")
+	}
+
+	var img image.Image
+	var set func(x, y int, row []byte)
+
+	switch {
+	case palette != nil:
+		paletted := image.NewPaletted(image.Rect(0, 0, h.width, h.height), palette)
+		img = paletted
+		set = func(x, y int, row []byte) {
+			bit := x * h.bitCount
+			index := row[bit/8] >> uint(8-h.bitCount-bit%8) & (1<<uint(h.bitCount) - 1)
+
+			// indices past a short palette take its first colour rather than going out of range
+			if int(index) >= len(palette) {
+				index = 0
+			}
+
+			paletted.Pix[y*paletted.Stride+x] = index
+		}
+	case h.bitCount == 24:
+		rgba := image.NewRGBA(image.Rect(0, 0, h.width, h.height))
+		img = rgba
+		set = func(x, y int, row []byte) {
+			copy(rgba.Pix[y*rgba.Stride+4*x:], []byte{row[3*x+2], row[3*x+1], row[3*x], 255})
+		}
+	default:
+		nrgba := image.NewNRGBA(image.Rect(0, 0, h.width, h.height))
+		img = nrgba
+		set = func(x, y int, row []byte) {
+			pixel := binary.LittleEndian.Uint32(row[4*x:])
+			out := nrgba.Pix[y*nrgba.Stride+4*x:]
+
+			for i, mask := range masks {
+				out[i] = 255
+
+				if mask != 0 {
+					out[i] = uint8(uint64(pixel&mask) * 255 / uint64(mask))
+				}
+			}
+		}
+	}
+
+	for y := 0; y < h.height; y++ {
+		row := data[h.pixelOffset+y*rowBytes:]
+		target := y
+
+		if !h.topDown {
+			target = h.height - 1 - y
+		}
+
+		for x := 0; x < h.width; x++ {
+			set(x, target, row)
+		}
+	}
+
+	return img, nil
+}
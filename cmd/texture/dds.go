@@ -0,0 +1,170 @@
+package texture
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	ddsHeaderSize      = 128 // magic and DDS_HEADER
+	ddsDX10HeaderSize  = 20
+	ddsPixelFormatFlag = 0x40 // DDPF_RGB
+	ddsAlphaFlag       = 0x1  // DDPF_ALPHAPIXELS
+	ddsFourCCFlag      = 0x4  // DDPF_FOURCC
+	ddsCubemapFlag     = 0x200
+	ddsMipMapCountFlag = 0x20000
+	ddsDX10CubeFlag    = 0x4
+)
+
+var ddsFourCCs = map[string]string{
+	"DXT1": "BC1_RGBA",
+	"DXT2": "BC2",
+	"DXT3": "BC2",
+	"DXT4": "BC3",
+	"DXT5": "BC3",
+	"ATI1": "BC4",
+	"BC4U": "BC4",
+	"BC4S": "BC4_SNORM",
+	"ATI2": "BC5",
+	"BC5U": "BC5",
+	"BC5S": "BC5_SNORM",
+}
+
+var dxgiFormats = map[uint32]string{
+	2:  "RGBA32F",
+	10: "RGBA16F",
+	28: "RGBA8",
+	29: "SRGB8_ALPHA8",
+	49: "RG8",
+	61: "R8",
+	71: "BC1_RGBA",
+	72: "BC1_SRGB_ALPHA",
+	74: "BC2",
+	75: "BC2_SRGB",
+	77: "BC3",
+	78: "BC3_SRGB",
+	80: "BC4",
+	81: "BC4_SNORM",
+	83: "BC5",
+	84: "BC5_SNORM",
+	87: "BGRA8",
+	91: "BGRA8_SRGB",
+	95: "BC6H_UFLOAT",
+	96: "BC6H_SFLOAT",
+	98: "BC7",
+	99: "BC7_SRGB",
+}
+
+// ParseDDS reads a DirectDraw Surface, including the DX10 extension for array textures and newer formats.
+// DDS stores every mip level of a layer before the next layer; the container regroups them by level.
+func ParseDDS(data []byte) (*Container, error) {
+	if len(data) < ddsHeaderSize || string(data[:4]) != "DDS " {
+		return nil, fmt.Errorf("texture: not a DDS file")
+	}
+
+	u32 := func(offset int) uint32 {
+		return binary.LittleEndian.Uint32(data[offset:])
+	}
+
+	flags := u32(8)
+	height, width := int(u32(12)), int(u32(16))
+	levels := 1
+
+	if flags&ddsMipMapCountFlag != 0 && u32(28) > 1 {
+		levels = int(u32(28))
+	}
+
+	if levels > mipLevels(width, height) {
+		return nil, fmt.Errorf("texture: DDS has %d mip levels, a %dx%d image has at most %d", levels, width, height, mipLevels(width, height))
+	}
+
+	// the pixel format starts at 76, caps at 108
+	pixelFlags, fourCC, bitCount := u32(80), string(data[84:88]), u32(88)
+	masks := [4]uint32{u32(92), u32(96), u32(100), u32(104)}
+	faces, layers := 1, 0
+
+	if u32(112)&ddsCubemapFlag != 0 {
+		faces = 6
+	}
+
+	offset := ddsHeaderSize
+	var name string
+
+	switch {
+	case pixelFlags&ddsFourCCFlag != 0 && fourCC == "DX10":
+		if len(data) < ddsHeaderSize+ddsDX10HeaderSize {
+			return nil, fmt.Errorf("texture: DDS DX10 header is truncated")
+		}
+
+		dxgiFormat, dimension, miscFlag, arraySize := u32(128), u32(132), u32(136), int(u32(140))
+		offset += ddsDX10HeaderSize
+
+		// 3 is D3D10_RESOURCE_DIMENSION_TEXTURE2D
+		if dimension != 3 {
+			return nil, fmt.Errorf("texture: only 2D DDS textures are supported, got dimension %d", dimension)
+		}
+
+		var present bool
+
+		if name, present = dxgiFormats[dxgiFormat]; !present {
+			return nil, fmt.Errorf("texture: unsupported DXGI format %d", dxgiFormat)
+		}
+
+		if miscFlag&ddsDX10CubeFlag != 0 {
+			faces = 6
+		}
+
+		if arraySize > 1 {
+			layers = arraySize
+		}
+	case pixelFlags&ddsFourCCFlag != 0:
+		var present bool
+
+		if name, present = ddsFourCCs[fourCC]; !present {
+			return nil, fmt.Errorf("texture: unsupported DDS FourCC %q", fourCC)
+		}
+	case pixelFlags&ddsPixelFormatFlag != 0 && bitCount == 32:
+		switch {
+		case masks == [4]uint32{0xFF, 0xFF00, 0xFF0000, 0xFF000000}:
+			name = "RGBA8"
+		case masks == [4]uint32{0xFF0000, 0xFF00, 0xFF, 0xFF000000}:
+			name = "BGRA8"
+		}
+	case pixelFlags&ddsPixelFormatFlag != 0 && pixelFlags&ddsAlphaFlag == 0 && bitCount == 24:
+		switch {
+		case masks[0] == 0xFF && masks[1] == 0xFF00 && masks[2] == 0xFF0000:
+			name = "RGB8"
+		case masks[0] == 0xFF0000 && masks[1] == 0xFF00 && masks[2] == 0xFF:
+			name = "BGR8"
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("texture: unsupported DDS pixel format (flags 0x%X, %d bits)", pixelFlags, bitCount)
+	}
+
+	c := &Container{Format: formatNamed(name), Width: width, Height: height, Layers: layers, Faces: faces, Levels: []*Level{}}
+
+	for i := 0; i < levels; i++ {
+		c.Levels = append(c.Levels, &Level{Width: levelSize(width, i), Height: levelSize(height, i), Data: []byte{}})
+	}
+
+	for image := 0; image < c.Images(); image++ {
+		for _, level := range c.Levels {
+			size := c.Format.Size(level.Width, level.Height)
+
+			if offset+size > len(data) {
+				return nil, fmt.Errorf("texture: DDS image %d is truncated", image)
+			}
+
+			level.Data = append(level.Data, data[offset:offset+size]...)
+			offset += size
+		}
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
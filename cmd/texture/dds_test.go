@@ -0,0 +1,162 @@
+package texture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// ddsHeader holds the DDS_HEADER fields the parser reads. dx10 is the DDS_HEADER_DXT10 words, written when
+// not nil.
+type ddsHeader struct {
+	width, height, levels uint32
+	pixelFlags            uint32
+	fourCC                string
+	bitCount              uint32
+	masks                 [4]uint32
+	caps2                 uint32
+	dx10                  []uint32
+}
+
+func ddsFile(h ddsHeader, data []byte) []byte {
+	b := make([]byte, ddsHeaderSize)
+	copy(b, "DDS ")
+
+	put := func(offset int, v uint32) {
+		binary.LittleEndian.PutUint32(b[offset:], v)
+	}
+
+	flags := uint32(0)
+	if h.levels > 0 {
+		flags |= ddsMipMapCountFlag
+	}
+
+	put(4, 124)
+	put(8, flags)
+	put(12, h.height)
+	put(16, h.width)
+	put(28, h.levels)
+	put(76, 32)
+	put(80, h.pixelFlags)
+	copy(b[84:88], h.fourCC)
+	put(88, h.bitCount)
+
+	for i, mask := range h.masks {
+		put(92+i*4, mask)
+	}
+
+	put(112, h.caps2)
+
+	for _, word := range h.dx10 {
+		b = append(b, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(b[len(b)-4:], word)
+	}
+
+	return append(b, data...)
+}
+
+// images returns count images of size bytes, each filled with its index.
+func images(count, size int) []byte {
+	b := []byte{}
+
+	for i := 0; i < count; i++ {
+		b = append(b, bytes.Repeat([]byte{byte(i)}, size)...)
+	}
+
+	return b
+}
+
+func TestParseDDS(t *testing.T) {
+	rgbaMasks := [4]uint32{0xFF, 0xFF00, 0xFF0000, 0xFF000000}
+	bgraMasks := [4]uint32{0xFF0000, 0xFF00, 0xFF, 0xFF000000}
+
+	tests := []struct {
+		name          string
+		data          []byte
+		format        string
+		width, height int
+		layers, faces int
+		levels        []int // bytes held by each level
+		first         []byte
+	}{
+		{"DXT1", ddsFile(ddsHeader{width: 4, height: 4, pixelFlags: ddsFourCCFlag, fourCC: "DXT1"}, make([]byte, 8)),
+			"BC1_RGBA", 4, 4, 0, 1, []int{8}, nil},
+		{"DXT5 mip chain", ddsFile(ddsHeader{width: 8, height: 8, levels: 4, pixelFlags: ddsFourCCFlag, fourCC: "DXT5"}, make([]byte, 64+16+16+16)),
+			"BC3", 8, 8, 0, 1, []int{64, 16, 16, 16}, nil},
+		{"mip count of 1", ddsFile(ddsHeader{width: 4, height: 4, levels: 1, pixelFlags: ddsFourCCFlag, fourCC: "ATI2"}, make([]byte, 16)),
+			"BC5", 4, 4, 0, 1, []int{16}, nil},
+		{"RGBA masks", ddsFile(ddsHeader{width: 2, height: 1, pixelFlags: ddsPixelFormatFlag | ddsAlphaFlag, bitCount: 32, masks: rgbaMasks},
+			[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+			"RGBA8", 2, 1, 0, 1, []int{8}, []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"BGRA masks", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsPixelFormatFlag | ddsAlphaFlag, bitCount: 32, masks: bgraMasks},
+			make([]byte, 4)),
+			"BGRA8", 1, 1, 0, 1, []int{4}, nil},
+		{"BGR masks", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsPixelFormatFlag, bitCount: 24, masks: [4]uint32{0xFF0000, 0xFF00, 0xFF}},
+			make([]byte, 3)),
+			"BGR8", 1, 1, 0, 1, []int{3}, nil},
+		{"cube map", ddsFile(ddsHeader{width: 4, height: 4, pixelFlags: ddsFourCCFlag, fourCC: "DXT1", caps2: ddsCubemapFlag}, images(6, 8)),
+			"BC1_RGBA", 4, 4, 0, 6, []int{48}, images(6, 8)},
+		{"DX10", ddsFile(ddsHeader{width: 4, height: 4, pixelFlags: ddsFourCCFlag, fourCC: "DX10", dx10: []uint32{98, 3, 0, 1, 0}},
+			make([]byte, 16)),
+			"BC7", 4, 4, 0, 1, []int{16}, nil},
+		// each layer's levels are stored together, the container holds each level's layers together
+		{"DX10 array regrouped", ddsFile(ddsHeader{width: 4, height: 4, levels: 2, pixelFlags: ddsFourCCFlag, fourCC: "DX10",
+			dx10: []uint32{99, 3, 0, 3, 0}}, images(6, 16)),
+			"BC7_SRGB", 4, 4, 3, 1, []int{48, 48}, append(append(bytes.Repeat([]byte{0}, 16), bytes.Repeat([]byte{2}, 16)...), bytes.Repeat([]byte{4}, 16)...)},
+		{"DX10 cube map", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsFourCCFlag, fourCC: "DX10", dx10: []uint32{28, 3, ddsDX10CubeFlag, 1, 0}},
+			make([]byte, 24)),
+			"RGBA8", 1, 1, 0, 6, []int{24}, nil},
+	}
+
+	for _, test := range tests {
+		c, err := ParseDDS(test.data)
+
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+
+		checkContainer(t, test.name, c, test.format, test.width, test.height, test.layers, test.faces, test.levels, test.first)
+	}
+}
+
+func TestParseDDSErrors(t *testing.T) {
+	dxt1 := ddsHeader{width: 4, height: 4, pixelFlags: ddsFourCCFlag, fourCC: "DXT1"}
+	valid := ddsFile(dxt1, make([]byte, 8))
+
+	with := func(change func(h *ddsHeader)) ddsHeader {
+		h := dxt1
+		change(&h)
+		return h
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", valid[:ddsHeaderSize-1]},
+		{"bad magic", append([]byte("DDS_"), valid[4:]...)},
+		{"too many mip levels", ddsFile(with(func(h *ddsHeader) { h.levels = 4 }), make([]byte, 32))},
+		{"unsupported FourCC", ddsFile(with(func(h *ddsHeader) { h.fourCC = "ETC1" }), make([]byte, 8))},
+		{"unsupported masks", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsPixelFormatFlag, bitCount: 32,
+			masks: [4]uint32{0xFF00, 0xFF, 0xFF0000, 0xFF000000}}, make([]byte, 4))},
+		{"24 bits with alpha", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsPixelFormatFlag | ddsAlphaFlag, bitCount: 24,
+			masks: [4]uint32{0xFF, 0xFF00, 0xFF0000}}, make([]byte, 3))},
+		{"16 bits", ddsFile(ddsHeader{width: 1, height: 1, pixelFlags: ddsPixelFormatFlag, bitCount: 16,
+			masks: [4]uint32{0xF800, 0x7E0, 0x1F}}, make([]byte, 2))},
+		{"no pixel format flags", ddsFile(with(func(h *ddsHeader) { h.pixelFlags = 0 }), make([]byte, 8))},
+		{"DX10 header truncated", ddsFile(with(func(h *ddsHeader) { h.fourCC = "DX10" }), make([]byte, 8))},
+		{"DX10 3D", ddsFile(with(func(h *ddsHeader) { h.fourCC, h.dx10 = "DX10", []uint32{98, 4, 0, 1, 0} }), make([]byte, 16))},
+		{"DX10 unsupported format", ddsFile(with(func(h *ddsHeader) { h.fourCC, h.dx10 = "DX10", []uint32{1, 3, 0, 1, 0} }), make([]byte, 16))},
+		{"truncated data", valid[:len(valid)-1]},
+		{"truncated cube map", ddsFile(with(func(h *ddsHeader) { h.caps2 = ddsCubemapFlag }), make([]byte, 40))},
+		{"zero width", ddsFile(with(func(h *ddsHeader) { h.width = 0 }), make([]byte, 8))},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseDDS(test.data); err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package texture
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Decompress decodes a BC1, BC2 or BC3 container to RGBA8, or SRGB8_ALPHA8 for the sRGB variants. It is the
+// fallback for drivers without S3TC; the other compressed families have no CPU decoder.
+func Decompress(c *Container) (*Container, error) {
+	var decode func(block []byte, pixels *[16][4]uint8)
+	srgb := false
+
+	switch c.Format.Name {
+	case "BC1_RGB", "BC1_RGBA":
+		decode = decodeBC1
+	case "BC1_SRGB", "BC1_SRGB_ALPHA":
+		decode, srgb = decodeBC1, true
+	case "BC2":
+		decode = decodeBC2
+	case "BC2_SRGB":
+		decode, srgb = decodeBC2, true
+	case "BC3":
+		decode = decodeBC3
+	case "BC3_SRGB":
+		decode, srgb = decodeBC3, true
+	default:
+		return nil, fmt.Errorf("texture: no CPU decoder for %s", c.Format.Name)
+	}
+
+	out := &Container{Format: formatNamed("RGBA8"), Width: c.Width, Height: c.Height, Layers: c.Layers, Faces: c.Faces, Levels: []*Level{}}
+
+	if srgb {
+		out.Format = formatNamed("SRGB8_ALPHA8")
+	}
+
+	var pixels [16][4]uint8
+
+	for _, level := range c.Levels {
+		blocksWide, blocksHigh := c.Format.blocksWide(level.Width), (level.Height+3)/4
+		imageSize := c.Format.Size(level.Width, level.Height)
+		decoded := &Level{Width: level.Width, Height: level.Height, Data: make([]byte, 4*level.Width*level.Height*c.Images())}
+
+		for image := 0; image < c.Images(); image++ {
+			source := level.Data[image*imageSize:]
+			target := decoded.Data[image*4*level.Width*level.Height:]
+
+			for by := 0; by < blocksHigh; by++ {
+				for bx := 0; bx < blocksWide; bx++ {
+					decode(source[(by*blocksWide+bx)*c.Format.BlockBytes:], &pixels)
+
+					// blocks on the right and bottom edge of small levels hang over the image
+					for i, pixel := range pixels {
+						x, y := bx*4+i%4, by*4+i/4
+
+						if x < level.Width && y < level.Height {
+							copy(target[4*(y*level.Width+x):], pixel[:])
+						}
+					}
+				}
+			}
+		}
+
+		out.Levels = append(out.Levels, decoded)
+	}
+
+	return out, nil
+}
+
+// decodeBC1 decodes a colour block. The first endpoint not exceeding the second selects 3 colours and transparent
+// black, which only BC1 honours; BC2 and BC3 colour blocks always use 4 colours, see decodeColors.
+func decodeBC1(block []byte, pixels *[16][4]uint8) {
+	decodeColors(block, pixels, true)
+}
+
+func decodeBC2(block []byte, pixels *[16][4]uint8) {
+	decodeColors(block[8:], pixels, false)
+	alpha := binary.LittleEndian.Uint64(block)
+
+	for i := range pixels {
+		pixels[i][3] = uint8(alpha>>(4*uint(i))&0xF) * 17
+	}
+}
+
+func decodeBC3(block []byte, pixels *[16][4]uint8) {
+	decodeColors(block[8:], pixels, false)
+
+	var alphas [8]uint8
+	alphas[0], alphas[1] = block[0], block[1]
+	a0, a1 := int(block[0]), int(block[1])
+
+	if a0 > a1 {
+		for i := 1; i < 7; i++ {
+			alphas[i+1] = uint8(((7-i)*a0 + i*a1) / 7)
+		}
+	} else {
+		for i := 1; i < 5; i++ {
+			alphas[i+1] = uint8(((5-i)*a0 + i*a1) / 5)
+		}
+
+		alphas[6], alphas[7] = 0, 255
+	}
+
+	// 16 3 bit indices packed little endian into the remaining 6 bytes
+	var indices uint64
+
+	for i := 7; i >= 2; i-- {
+		indices = indices<<8 | uint64(block[i])
+	}
+
+	for i := range pixels {
+		pixels[i][3] = alphas[indices>>(3*uint(i))&7]
+	}
+}
+
+func decodeColors(block []byte, pixels *[16][4]uint8, punchThrough bool) {
+	c0, c1 := binary.LittleEndian.Uint16(block), binary.LittleEndian.Uint16(block[2:])
+	var colors [4][4]uint8
+	colors[0], colors[1] = expand565(c0), expand565(c1)
+
+	for channel := 0; channel < 3; channel++ {
+		a, b := int(colors[0][channel]), int(colors[1][channel])
+
+		if c0 > c1 || !punchThrough {
+			colors[2][channel] = uint8((2*a + b) / 3)
+			colors[3][channel] = uint8((a + 2*b) / 3)
+		} else {
+			colors[2][channel] = uint8((a + b) / 2)
+		}
+	}
+
+	colors[2][3] = 255
+	colors[3][3] = 255
+
+	if c0 <= c1 && punchThrough {
+		colors[3][3] = 0
+	}
+
+	indices := binary.LittleEndian.Uint32(block[4:])
+
+	for i := range pixels {
+		pixels[i] = colors[indices>>(2*uint(i))&3]
+	}
+}
+
+func expand565(c uint16) [4]uint8 {
+	r, g, b := uint8(c>>11&0x1F), uint8(c>>5&0x3F), uint8(c&0x1F)
+
+	return [4]uint8{r<<3 | r>>2, g<<2 | g>>4, b<<3 | b>>2, 255}
+}
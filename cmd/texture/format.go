@@ -0,0 +1,224 @@
+package texture
+
+import "fmt"
+
+// GL enums used by the containers. They are listed here rather than taken from the gl package because the
+// compressed ones come from extensions and are data in the files as much as arguments to GL.
+const (
+	glRed           = 0x1903
+	glRG            = 0x8227
+	glRGB           = 0x1907
+	glRGBA          = 0x1908
+	glBGR           = 0x80E0
+	glBGRA          = 0x80E1
+	glUnsignedByte  = 0x1401
+	glUnsignedShort = 0x1403
+	glHalfFloat     = 0x140B
+	glFloat         = 0x1406
+	glR8            = 0x8229
+	glR16           = 0x822A
+	glRG8           = 0x822B
+	glRGB8          = 0x8051
+	glSRGB8         = 0x8C41
+	glRGBA8         = 0x8058
+	glRGBA16        = 0x805B
+	glSRGB8Alpha8   = 0x8C43
+	glRGBA16F       = 0x881A
+	glRGBA32F       = 0x8814
+	glRGBDXT1       = 0x83F0
+	glRGBADXT1      = 0x83F1
+	glRGBADXT3      = 0x83F2
+	glRGBADXT5      = 0x83F3
+	glSRGBDXT1      = 0x8C4C
+	glSRGBAlphaDXT1 = 0x8C4D
+	glSRGBAlphaDXT3 = 0x8C4E
+	glSRGBAlphaDXT5 = 0x8C4F
+	glRedRGTC1      = 0x8DBB
+	glSignedRedRGTC = 0x8DBC
+	glRGRGTC2       = 0x8DBD
+	glSignedRGRGTC2 = 0x8DBE
+	glBPTCUnorm     = 0x8E8C
+	glBPTCSRGB      = 0x8E8D
+	glBPTCSigned    = 0x8E8E
+	glBPTCUnsigned  = 0x8E8F
+	glETC1RGB8      = 0x8D64
+	glETC2RGB8      = 0x9274
+	glETC2SRGB8     = 0x9275
+	glETC2RGB8A1    = 0x9276
+	glETC2SRGB8A1   = 0x9277
+	glETC2RGBA8     = 0x9278
+	glETC2SRGB8A8   = 0x9279
+)
+
+// Format describes how a container's pixels are stored and handed to GL. Uncompressed formats are 1x1 blocks
+// of BlockBytes each.
+type Format struct {
+	Name           string
+	InternalFormat uint32
+	PixelFormat    uint32 // uncompressed only
+	PixelType      uint32 // uncompressed only
+	Compressed     bool
+	BlockWidth     int
+	BlockHeight    int
+	BlockBytes     int
+	Luminance      bool // one channel sampled as grey rather than red
+}
+
+func uncompressed(name string, internalFormat, pixelFormat, pixelType uint32, bytes int) *Format {
+	return &Format{name, internalFormat, pixelFormat, pixelType, false, 1, 1, bytes, false}
+}
+
+func compressed(name string, internalFormat uint32, bytes int) *Format {
+	return &Format{name, internalFormat, 0, 0, true, 4, 4, bytes, false}
+}
+
+func luminance(name string, internalFormat, pixelType uint32, bytes int) *Format {
+	return &Format{name, internalFormat, glRed, pixelType, false, 1, 1, bytes, true}
+}
+
+var formats = []*Format{
+	uncompressed("R8", glR8, glRed, glUnsignedByte, 1),
+	uncompressed("RG8", glRG8, glRG, glUnsignedByte, 2),
+	uncompressed("RGB8", glRGB8, glRGB, glUnsignedByte, 3),
+	uncompressed("SRGB8", glSRGB8, glRGB, glUnsignedByte, 3),
+	uncompressed("BGR8", glRGB8, glBGR, glUnsignedByte, 3),
+	uncompressed("RGBA8", glRGBA8, glRGBA, glUnsignedByte, 4),
+	uncompressed("SRGB8_ALPHA8", glSRGB8Alpha8, glRGBA, glUnsignedByte, 4),
+	uncompressed("BGRA8", glRGBA8, glBGRA, glUnsignedByte, 4),
+	uncompressed("BGRA8_SRGB", glSRGB8Alpha8, glBGRA, glUnsignedByte, 4),
+	uncompressed("RGBA16", glRGBA16, glRGBA, glUnsignedShort, 8),
+	uncompressed("RGBA16F", glRGBA16F, glRGBA, glHalfFloat, 8),
+	uncompressed("RGBA32F", glRGBA32F, glRGBA, glFloat, 16),
+	luminance("L8", glR8, glUnsignedByte, 1),
+	luminance("L16", glR16, glUnsignedShort, 2),
+	compressed("BC1_RGB", glRGBDXT1, 8),
+	compressed("BC1_RGBA", glRGBADXT1, 8),
+	compressed("BC1_SRGB", glSRGBDXT1, 8),
+	compressed("BC1_SRGB_ALPHA", glSRGBAlphaDXT1, 8),
+	compressed("BC2", glRGBADXT3, 16),
+	compressed("BC2_SRGB", glSRGBAlphaDXT3, 16),
+	compressed("BC3", glRGBADXT5, 16),
+	compressed("BC3_SRGB", glSRGBAlphaDXT5, 16),
+	compressed("BC4", glRedRGTC1, 8),
+	compressed("BC4_SNORM", glSignedRedRGTC, 8),
+	compressed("BC5", glRGRGTC2, 16),
+	compressed("BC5_SNORM", glSignedRGRGTC2, 16),
+	compressed("BC6H_SFLOAT", glBPTCSigned, 16),
+	compressed("BC6H_UFLOAT", glBPTCUnsigned, 16),
+	compressed("BC7", glBPTCUnorm, 16),
+	compressed("BC7_SRGB", glBPTCSRGB, 16),
+	compressed("ETC1", glETC1RGB8, 8),
+	compressed("ETC2_RGB8", glETC2RGB8, 8),
+	compressed("ETC2_SRGB8", glETC2SRGB8, 8),
+	compressed("ETC2_RGB8A1", glETC2RGB8A1, 8),
+	compressed("ETC2_SRGB8A1", glETC2SRGB8A1, 8),
+	compressed("ETC2_RGBA8", glETC2RGBA8, 16),
+	compressed("ETC2_SRGB8_ALPHA8", glETC2SRGB8A8, 16),
+}
+
+func formatNamed(name string) *Format {
+	for _, f := range formats {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	panic("texture: unknown format " + name)
+}
+
+// formatFor finds the format with the given GL internal format and, for uncompressed data, pixel format and type.
+// Some writers store the unsized internal format, GL_RGBA for GL_RGBA8, which picks the first sized match.
+func formatFor(internalFormat, pixelFormat, pixelType uint32) (*Format, error) {
+	for _, f := range formats {
+		// files say red when they mean red, luminance only comes from images
+		if f.Luminance {
+			continue
+		}
+
+		if f.Compressed {
+			if f.InternalFormat == internalFormat {
+				return f, nil
+			}
+
+			continue
+		}
+
+		if f.PixelFormat == pixelFormat && f.PixelType == pixelType && (f.InternalFormat == internalFormat || internalFormat == pixelFormat) {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("texture: unsupported GL format 0x%X (pixel format 0x%X, type 0x%X)", internalFormat, pixelFormat, pixelType)
+}
+
+// Size returns the number of bytes of one tightly packed width by height image.
+func (f *Format) Size(width, height int) int {
+	return f.blocksWide(width) * ((height + f.BlockHeight - 1) / f.BlockHeight) * f.BlockBytes
+}
+
+func (f *Format) blocksWide(width int) int {
+	return (width + f.BlockWidth - 1) / f.BlockWidth
+}
+
+// Container is a texture read from a KTX, KTX2 or DDS file or converted from an image. Every level holds all layers, and within a layer
+// all faces, one after the other.
+type Container struct {
+	Format *Format
+	Width  int
+	Height int
+	Layers int // 0 unless the file is an array texture
+	Faces  int // 6 for cube maps, 1 otherwise
+	Levels []*Level
+}
+
+type Level struct {
+	Width  int
+	Height int
+	Data   []byte
+}
+
+// Images returns the number of 2D images in a level, layers times faces.
+func (c *Container) Images() int {
+	if c.Layers == 0 {
+		return c.Faces
+	}
+
+	return c.Layers * c.Faces
+}
+
+func levelSize(size, level int) int {
+	size >>= uint(level)
+
+	if size < 1 {
+		return 1
+	}
+
+	return size
+}
+
+// validate checks the sizes and that every level holds exactly its images.
+func (c *Container) validate() error {
+	if c.Width <= 0 || c.Height <= 0 {
+		return fmt.Errorf("texture: size must be positive, got %dx%d", c.Width, c.Height)
+	}
+
+	if c.Faces != 1 && c.Faces != 6 {
+		return fmt.Errorf("texture: %d faces, expected 1 or 6", c.Faces)
+	}
+
+	if len(c.Levels) == 0 {
+		return fmt.Errorf("texture: no mip levels")
+	}
+
+	for i, level := range c.Levels {
+		if level.Width != levelSize(c.Width, i) || level.Height != levelSize(c.Height, i) {
+			return fmt.Errorf("texture: level %d is %dx%d, expected %dx%d", i, level.Width, level.Height, levelSize(c.Width, i), levelSize(c.Height, i))
+		}
+
+		if expected := c.Format.Size(level.Width, level.Height) * c.Images(); len(level.Data) != expected {
+			return fmt.Errorf("texture: level %d holds %d bytes, expected %d", i, len(level.Data), expected)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package texture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Options control how an image becomes texels.
+type Options struct {
+	FlipY       bool // store the bottom row first, for UVs with v pointing up
+	Premultiply bool // multiply colour by alpha, for blending with ONE, ONE_MINUS_SRC_ALPHA
+}
+
+// LoadImage reads a PNG, JPEG, GIF, BMP or TGA file at its own size. TGA has no magic number and is picked by
+// its extension.
+func LoadImage(path string, options Options) (*Container, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var img image.Image
+
+	if strings.EqualFold(filepath.Ext(path), ".tga") {
+		img, err = decodeTGA(file)
+	} else {
+		img, _, err = image.Decode(file)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("texture: decoding %s: %v", path, err)
+	}
+
+	return FromImage(img, options), nil
+}
+
+// FromImage converts an image to a single level container. Grayscale images become L8 or L16, 16 bit colour
+// images RGBA16 and everything else RGBA8.
+func FromImage(img image.Image, options Options) *Container {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	format := formatNamed("RGBA8")
+
+	switch img.(type) {
+	case *image.Gray:
+		format = formatNamed("L8")
+	case *image.Gray16:
+		format = formatNamed("L16")
+	case *image.RGBA64, *image.NRGBA64:
+		format = formatNamed("RGBA16")
+	}
+
+	data := make([]byte, format.Size(width, height))
+	rowBytes := width * format.BlockBytes
+
+	for y := 0; y < height; y++ {
+		row := y
+
+		if options.FlipY {
+			row = height - 1 - y
+		}
+
+		out := data[row*rowBytes : (row+1)*rowBytes]
+
+		// the common cases are already laid out as GL wants them
+		switch source := img.(type) {
+		case *image.Gray:
+			copy(out, source.Pix[source.PixOffset(bounds.Min.X, bounds.Min.Y+y):])
+			continue
+		case *image.NRGBA:
+			if !options.Premultiply {
+				copy(out, source.Pix[source.PixOffset(bounds.Min.X, bounds.Min.Y+y):])
+				continue
+			}
+		case *image.RGBA:
+			if options.Premultiply {
+				copy(out, source.Pix[source.PixOffset(bounds.Min.X, bounds.Min.Y+y):])
+				continue
+			}
+		}
+
+		for x := 0; x < width; x++ {
+			c := img.At(bounds.Min.X+x, bounds.Min.Y+y)
+
+			switch format.Name {
+			case "L8":
+				out[x] = color.GrayModel.Convert(c).(color.Gray).Y
+			case "L16":
+				binary.LittleEndian.PutUint16(out[2*x:], color.Gray16Model.Convert(c).(color.Gray16).Y)
+			case "RGBA16":
+				var r, g, b, a uint16
+
+				if options.Premultiply {
+					p := color.RGBA64Model.Convert(c).(color.RGBA64)
+					r, g, b, a = p.R, p.G, p.B, p.A
+				} else {
+					p := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+					r, g, b, a = p.R, p.G, p.B, p.A
+				}
+
+				for i, v := range []uint16{r, g, b, a} {
+					binary.LittleEndian.PutUint16(out[8*x+2*i:], v)
+				}
+			default:
+				if options.Premultiply {
+					p := color.RGBAModel.Convert(c).(color.RGBA)
+					copy(out[4*x:], []byte{p.R, p.G, p.B, p.A})
+				} else {
+					p := color.NRGBAModel.Convert(c).(color.NRGBA)
+					copy(out[4*x:], []byte{p.R, p.G, p.B, p.A})
+				}
+			}
+		}
+	}
+
+	return &Container{Format: format, Width: width, Height: height, Faces: 1, Levels: []*Level{{Width: width, Height: height, Data: data}}}
+}
@@ -0,0 +1,122 @@
+package texture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+var ktxIdentifier = []byte{0xAB, 'K', 'T', 'X', ' ', '1', '1', 0xBB, '\r', '\n', 0x1A, '\n'}
+
+const ktxHeaderSize = 64
+
+// ParseKTX reads a KTX 1.1 file. Files written big endian have their header and, for 2 and 4 byte types, their
+// pixels swapped. Rows are repacked without the file's 4 byte row padding.
+func ParseKTX(data []byte) (*Container, error) {
+	if len(data) < ktxHeaderSize || !bytes.Equal(data[:12], ktxIdentifier) {
+		return nil, fmt.Errorf("texture: not a KTX 1.1 file")
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+
+	switch binary.LittleEndian.Uint32(data[12:]) {
+	case 0x04030201:
+	case 0x01020304:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("texture: KTX endianness field is invalid")
+	}
+
+	header := make([]uint32, 12)
+
+	for i := range header {
+		header[i] = order.Uint32(data[16+i*4:])
+	}
+
+	glType, glTypeSize, glFormat, glInternalFormat := header[0], header[1], header[2], header[3]
+	width, height, depth := int(header[5]), int(header[6]), int(header[7])
+	layers, faces, levels, keyValueBytes := int(header[8]), int(header[9]), int(header[10]), int(header[11])
+
+	if depth > 1 {
+		return nil, fmt.Errorf("texture: 3D KTX textures are not supported")
+	}
+
+	// a height of 0 is a 1D texture, stored like a single row
+	if height == 0 {
+		height = 1
+	}
+
+	format, err := formatFor(glInternalFormat, glFormat, glType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Container{Format: format, Width: width, Height: height, Layers: layers, Faces: faces, Levels: []*Level{}}
+
+	// 0 levels asks the loader to generate them
+	if levels == 0 {
+		levels = 1
+	}
+
+	offset := ktxHeaderSize + keyValueBytes
+
+	for i := 0; i < levels; i++ {
+		if offset+4 > len(data) {
+			return nil, fmt.Errorf("texture: KTX level %d is truncated", i)
+		}
+
+		imageSize := int(order.Uint32(data[offset:]))
+		offset += 4
+
+		level := &Level{Width: levelSize(width, i), Height: levelSize(height, i), Data: []byte{}}
+		stored := format.Size(level.Width, level.Height)
+		rowBytes := format.blocksWide(level.Width) * format.BlockBytes
+		paddedRowBytes := rowBytes
+
+		if !format.Compressed {
+			paddedRowBytes = (rowBytes + 3) &^ 3
+			stored = paddedRowBytes * level.Height
+		}
+
+		// imageSize covers one face of a non-array cube map and the whole level otherwise
+		if imageSize != stored && imageSize != stored*c.Images() {
+			return nil, fmt.Errorf("texture: KTX level %d has %d bytes per image, expected %d", i, imageSize, stored)
+		}
+
+		for image := 0; image < c.Images(); image++ {
+			if offset+stored > len(data) {
+				return nil, fmt.Errorf("texture: KTX level %d is truncated", i)
+			}
+
+			for row := 0; row < stored/paddedRowBytes; row++ {
+				start := offset + row*paddedRowBytes
+				level.Data = append(level.Data, data[start:start+rowBytes]...)
+			}
+
+			// cube faces are padded to 4 bytes, which rows of 4 byte multiples already are
+			offset += (stored + 3) &^ 3
+		}
+
+		if order == binary.BigEndian && glTypeSize > 1 {
+			swapBytes(level.Data, int(glTypeSize))
+		}
+
+		offset = (offset + 3) &^ 3
+		c.Levels = append(c.Levels, level)
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func swapBytes(data []byte, size int) {
+	for i := 0; i+size <= len(data); i += size {
+		for a, b := i, i+size-1; a < b; a, b = a+1, b-1 {
+			data[a], data[b] = data[b], data[a]
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package texture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+var ktx2Identifier = []byte{0xAB, 'K', 'T', 'X', ' ', '2', '0', 0xBB, '\r', '\n', 0x1A, '\n'}
+
+const ktx2HeaderSize = 80
+
+// Vulkan formats KTX2 files name their pixels by.
+var vkFormats = map[uint32]string{
+	9:   "R8",
+	16:  "RG8",
+	23:  "RGB8",
+	29:  "SRGB8",
+	30:  "BGR8",
+	37:  "RGBA8",
+	43:  "SRGB8_ALPHA8",
+	44:  "BGRA8",
+	50:  "BGRA8_SRGB",
+	97:  "RGBA16F",
+	109: "RGBA32F",
+	131: "BC1_RGB",
+	132: "BC1_SRGB",
+	133: "BC1_RGBA",
+	134: "BC1_SRGB_ALPHA",
+	135: "BC2",
+	136: "BC2_SRGB",
+	137: "BC3",
+	138: "BC3_SRGB",
+	139: "BC4",
+	140: "BC4_SNORM",
+	141: "BC5",
+	142: "BC5_SNORM",
+	143: "BC6H_UFLOAT",
+	144: "BC6H_SFLOAT",
+	145: "BC7",
+	146: "BC7_SRGB",
+	147: "ETC2_RGB8",
+	148: "ETC2_SRGB8",
+	149: "ETC2_RGB8A1",
+	150: "ETC2_SRGB8A1",
+	151: "ETC2_RGBA8",
+	152: "ETC2_SRGB8_ALPHA8",
+}
+
+// ParseKTX2 reads a KTX 2.0 file. Supercompressed files (Basis Universal, Zstandard) are not supported.
+func ParseKTX2(data []byte) (*Container, error) {
+	if len(data) < ktx2HeaderSize || !bytes.Equal(data[:12], ktx2Identifier) {
+		return nil, fmt.Errorf("texture: not a KTX 2.0 file")
+	}
+
+	u32 := func(offset int) int {
+		return int(binary.LittleEndian.Uint32(data[offset:]))
+	}
+
+	vkFormat := uint32(u32(12))
+	width, height, depth := u32(20), u32(24), u32(28)
+	layers, faces, levels, supercompression := u32(32), u32(36), u32(40), u32(44)
+
+	if supercompression != 0 {
+		return nil, fmt.Errorf("texture: KTX2 supercompression scheme %d is not supported", supercompression)
+	}
+
+	if depth > 1 {
+		return nil, fmt.Errorf("texture: 3D KTX2 textures are not supported")
+	}
+
+	name, present := vkFormats[vkFormat]
+
+	if !present {
+		return nil, fmt.Errorf("texture: unsupported KTX2 Vulkan format %d", vkFormat)
+	}
+
+	if height == 0 {
+		height = 1
+	}
+
+	// 0 levels asks the loader to generate them
+	if levels == 0 {
+		levels = 1
+	}
+
+	c := &Container{Format: formatNamed(name), Width: width, Height: height, Layers: layers, Faces: faces, Levels: []*Level{}}
+
+	if ktx2HeaderSize+levels*24 > len(data) {
+		return nil, fmt.Errorf("texture: KTX2 level index is truncated")
+	}
+
+	for i := 0; i < levels; i++ {
+		entry := data[ktx2HeaderSize+i*24:]
+		offset := binary.LittleEndian.Uint64(entry)
+		length := binary.LittleEndian.Uint64(entry[8:])
+
+		if offset > uint64(len(data)) || length > uint64(len(data))-offset {
+			return nil, fmt.Errorf("texture: KTX2 level %d lies outside the file", i)
+		}
+
+		c.Levels = append(c.Levels, &Level{
+			Width:  levelSize(width, i),
+			Height: levelSize(height, i),
+			Data:   data[offset : offset+length],
+		})
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,103 @@
+package texture
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// ktx2Header holds the header words from vkFormat to supercompressionScheme.
+type ktx2Header struct {
+	vkFormat, typeSize, width, height, depth, layers, faces, levels, supercompression uint32
+}
+
+// ktx2File writes a KTX 2.0 file with a level index pointing at the levels, stored in order after it.
+func ktx2File(h ktx2Header, levels ...[]byte) []byte {
+	b := make([]byte, ktx2HeaderSize+len(levels)*24)
+	copy(b, ktx2Identifier)
+
+	for i, word := range []uint32{h.vkFormat, h.typeSize, h.width, h.height, h.depth, h.layers, h.faces, h.levels, h.supercompression} {
+		binary.LittleEndian.PutUint32(b[12+i*4:], word)
+	}
+
+	for i, level := range levels {
+		entry := b[ktx2HeaderSize+i*24:]
+		binary.LittleEndian.PutUint64(entry, uint64(len(b)))
+		binary.LittleEndian.PutUint64(entry[8:], uint64(len(level)))
+		binary.LittleEndian.PutUint64(entry[16:], uint64(len(level)))
+		b = append(b, level...)
+	}
+
+	return b
+}
+
+func TestParseKTX2(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		format        string
+		width, height int
+		layers, faces int
+		levels        []int // bytes held by each level
+		first         []byte
+	}{
+		{"rgba8", ktx2File(ktx2Header{vkFormat: 37, typeSize: 1, width: 1, height: 1, faces: 1, levels: 1}, []byte{1, 2, 3, 4}),
+			"RGBA8", 1, 1, 0, 1, []int{4}, []byte{1, 2, 3, 4}},
+		{"mip chain", ktx2File(ktx2Header{vkFormat: 145, typeSize: 1, width: 8, height: 4, faces: 1, levels: 3},
+			make([]byte, 32), make([]byte, 16), make([]byte, 16)),
+			"BC7", 8, 4, 0, 1, []int{32, 16, 16}, nil},
+		{"zero levels read as one", ktx2File(ktx2Header{vkFormat: 9, typeSize: 1, width: 2, height: 1, faces: 1}, []byte{5, 6}),
+			"R8", 2, 1, 0, 1, []int{2}, []byte{5, 6}},
+		{"1D", ktx2File(ktx2Header{vkFormat: 16, typeSize: 1, width: 2, faces: 1, levels: 1}, make([]byte, 4)),
+			"RG8", 2, 1, 0, 1, []int{4}, nil},
+		{"cube map array", ktx2File(ktx2Header{vkFormat: 133, typeSize: 1, width: 4, height: 4, layers: 2, faces: 6, levels: 1}, make([]byte, 96)),
+			"BC1_RGBA", 4, 4, 2, 6, []int{96}, nil},
+	}
+
+	for _, test := range tests {
+		c, err := ParseKTX2(test.data)
+
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+
+		checkContainer(t, test.name, c, test.format, test.width, test.height, test.layers, test.faces, test.levels, test.first)
+	}
+}
+
+func TestParseKTX2Errors(t *testing.T) {
+	rgba8 := ktx2Header{vkFormat: 37, typeSize: 1, width: 1, height: 1, faces: 1, levels: 1}
+	valid := ktx2File(rgba8, make([]byte, 4))
+
+	with := func(change func(h *ktx2Header)) ktx2Header {
+		h := rgba8
+		change(&h)
+		return h
+	}
+
+	outside := ktx2File(rgba8, make([]byte, 4))
+	binary.LittleEndian.PutUint64(outside[ktx2HeaderSize:], 1<<63)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", valid[:ktx2HeaderSize-1]},
+		{"KTX 1.1 identifier", append(append([]byte{}, ktxIdentifier...), valid[12:]...)},
+		{"supercompressed", ktx2File(with(func(h *ktx2Header) { h.supercompression = 1 }), make([]byte, 4))},
+		{"3D", ktx2File(with(func(h *ktx2Header) { h.depth = 2 }), make([]byte, 8))},
+		{"unsupported format", ktx2File(with(func(h *ktx2Header) { h.vkFormat = 1 }), make([]byte, 4))},
+		{"truncated level index", valid[:ktx2HeaderSize+23]},
+		{"level outside the file", valid[:len(valid)-1]},
+		{"level offset overflows", outside},
+		{"wrong level size", ktx2File(rgba8, make([]byte, 8))},
+		{"zero width", ktx2File(with(func(h *ktx2Header) { h.width = 0 }), make([]byte, 4))},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseKTX2(test.data); err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package texture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// ktxHeader holds the twelve words after the endianness field, in file order.
+type ktxHeader struct {
+	glType, glTypeSize, glFormat, glInternalFormat, glBaseInternalFormat uint32
+	width, height, depth, layers, faces, levels, keyValueBytes           uint32
+}
+
+// ktxFile writes a KTX 1.1 file in the given byte order. Each level's imageSize is its length, or one face's
+// length for a non-array cube map, and levels are padded to 4 bytes.
+func ktxFile(order binary.ByteOrder, h ktxHeader, levels ...[]byte) []byte {
+	var b bytes.Buffer
+	b.Write(ktxIdentifier)
+
+	for _, word := range []uint32{0x04030201, h.glType, h.glTypeSize, h.glFormat, h.glInternalFormat, h.glBaseInternalFormat,
+		h.width, h.height, h.depth, h.layers, h.faces, h.levels, h.keyValueBytes} {
+		binary.Write(&b, order, word)
+	}
+
+	b.Write(make([]byte, h.keyValueBytes))
+
+	for _, level := range levels {
+		imageSize := len(level)
+
+		if h.faces == 6 && h.layers == 0 {
+			imageSize /= 6
+		}
+
+		binary.Write(&b, order, uint32(imageSize))
+		b.Write(level)
+		b.Write(make([]byte, (4-len(level)%4)%4))
+	}
+
+	return b.Bytes()
+}
+
+func TestParseKTX(t *testing.T) {
+	rgba8 := ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA, glInternalFormat: glRGBA8, glBaseInternalFormat: glRGBA,
+		width: 2, height: 2, faces: 1, levels: 1}
+
+	tests := []struct {
+		name          string
+		data          []byte
+		format        string
+		width, height int
+		layers, faces int
+		levels        []int // bytes held by each level
+		first         []byte
+	}{
+		{"rgba8", ktxFile(binary.LittleEndian, rgba8, bytes.Repeat([]byte{7}, 16)),
+			"RGBA8", 2, 2, 0, 1, []int{16}, bytes.Repeat([]byte{7}, 16)},
+		{"unsized internal format", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA,
+			glInternalFormat: glRGBA, width: 1, height: 1, faces: 1, levels: 1}, make([]byte, 4)),
+			"RGBA8", 1, 1, 0, 1, []int{4}, nil},
+		{"key value data skipped", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA,
+			glInternalFormat: glRGBA8, width: 1, height: 1, faces: 1, levels: 1, keyValueBytes: 8}, []byte{1, 2, 3, 4}),
+			"RGBA8", 1, 1, 0, 1, []int{4}, []byte{1, 2, 3, 4}},
+		{"row padding removed", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGB,
+			glInternalFormat: glRGB8, width: 1, height: 2, faces: 1, levels: 1}, []byte{1, 2, 3, 0, 4, 5, 6, 0}),
+			"RGB8", 1, 2, 0, 1, []int{6}, []byte{1, 2, 3, 4, 5, 6}},
+		{"big endian swapped", ktxFile(binary.BigEndian, ktxHeader{glType: glUnsignedShort, glTypeSize: 2, glFormat: glRGBA,
+			glInternalFormat: glRGBA16, width: 1, height: 1, faces: 1, levels: 1}, []byte{1, 2, 3, 4, 5, 6, 7, 8}),
+			"RGBA16", 1, 1, 0, 1, []int{8}, []byte{2, 1, 4, 3, 6, 5, 8, 7}},
+		{"big endian bytes kept", ktxFile(binary.BigEndian, rgba8, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			"RGBA8", 2, 2, 0, 1, []int{16}, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+		{"mip chain", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA,
+			glInternalFormat: glRGBA8, width: 4, height: 2, faces: 1, levels: 3}, make([]byte, 32), make([]byte, 8), make([]byte, 4)),
+			"RGBA8", 4, 2, 0, 1, []int{32, 8, 4}, nil},
+		{"zero levels read as one", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA,
+			glInternalFormat: glRGBA8, width: 1, height: 1, faces: 1}, make([]byte, 4)),
+			"RGBA8", 1, 1, 0, 1, []int{4}, nil},
+		{"1D", ktxFile(binary.LittleEndian, ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRed,
+			glInternalFormat: glR8, width: 4, faces: 1, levels: 1}, []byte{1, 2, 3, 4}),
+			"R8", 4, 1, 0, 1, []int{4}, []byte{1, 2, 3, 4}},
+		{"compressed cube map", ktxFile(binary.LittleEndian, ktxHeader{glInternalFormat: glRGBADXT1, width: 4, height: 4,
+			faces: 6, levels: 1}, make([]byte, 48)),
+			"BC1_RGBA", 4, 4, 0, 6, []int{48}, nil},
+		{"array", ktxFile(binary.LittleEndian, ktxHeader{glInternalFormat: glBPTCUnorm, width: 4, height: 4, layers: 3,
+			faces: 1, levels: 1}, make([]byte, 48)),
+			"BC7", 4, 4, 3, 1, []int{48}, nil},
+	}
+
+	for _, test := range tests {
+		c, err := ParseKTX(test.data)
+
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+
+		checkContainer(t, test.name, c, test.format, test.width, test.height, test.layers, test.faces, test.levels, test.first)
+	}
+}
+
+func TestParseKTXErrors(t *testing.T) {
+	rgba8 := ktxHeader{glType: glUnsignedByte, glTypeSize: 1, glFormat: glRGBA, glInternalFormat: glRGBA8, glBaseInternalFormat: glRGBA,
+		width: 1, height: 1, faces: 1, levels: 1}
+	valid := ktxFile(binary.LittleEndian, rgba8, make([]byte, 4))
+
+	with := func(change func(h *ktxHeader)) ktxHeader {
+		h := rgba8
+		change(&h)
+		return h
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", valid[:ktxHeaderSize-1]},
+		{"KTX2 identifier", append(append([]byte{}, ktx2Identifier...), valid[12:]...)},
+		{"bad endianness", append(append(append([]byte{}, valid[:12]...), 1, 1, 1, 1), valid[16:]...)},
+		{"3D", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.depth = 2 }), make([]byte, 8))},
+		{"unsupported format", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.glType = glFloat }), make([]byte, 4))},
+		{"no level", valid[:ktxHeaderSize]},
+		{"truncated level", valid[:len(valid)-1]},
+		{"wrong image size", ktxFile(binary.LittleEndian, rgba8, make([]byte, 8))},
+		{"missing mip level", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.width, h.levels = 2, 2 }), make([]byte, 8))},
+		{"key value data overruns", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.keyValueBytes = 1 << 20 }))[:ktxHeaderSize]},
+		{"zero width", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.width = 0 }), make([]byte, 4))},
+		{"five faces", ktxFile(binary.LittleEndian, with(func(h *ktxHeader) { h.faces = 5 }), make([]byte, 20))},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseKTX(test.data); err == nil {
+			t.Errorf("%s: got no error", test.name)
+		}
+	}
+}
+
+// checkContainer compares a parsed container's format, size, image counts and level lengths. first is the
+// expected data of level 0 when it is not nil.
+func checkContainer(t *testing.T, name string, c *Container, format string, width, height, layers, faces int, levels []int, first []byte) {
+	if c.Format.Name != format || c.Width != width || c.Height != height || c.Layers != layers || c.Faces != faces {
+		t.Errorf("%s: got %s %dx%d with %d layers and %d faces, want %s %dx%d with %d layers and %d faces", name,
+			c.Format.Name, c.Width, c.Height, c.Layers, c.Faces, format, width, height, layers, faces)
+		return
+	}
+
+	if len(c.Levels) != len(levels) {
+		t.Errorf("%s: got %d levels, want %d", name, len(c.Levels), len(levels))
+		return
+	}
+
+	for i, level := range c.Levels {
+		if len(level.Data) != levels[i] {
+			t.Errorf("%s: level %d holds %d bytes, want %d", name, i, len(level.Data), levels[i])
+		}
+	}
+
+	if first != nil && !bytes.Equal(c.Levels[0].Data, first) {
+		t.Errorf("%s: level 0 is %v, want %v", name, c.Levels[0].Data, first)
+	}
+}
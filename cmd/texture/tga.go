@@ -0,0 +1,205 @@
+package texture
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+)
+
+// decodeTGA reads colour mapped, true colour and grayscale Targa files, run length encoded or not. Grayscale
+// files become image.Gray, everything else image.NRGBA.
+func decodeTGA(r io.Reader) (image.Image, error) {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 18 {
+		return nil, errors.New("TGA header is truncated")
+	}
+
+	idLength, colorMapType, imageType := int(data[0]), data[1], data[2]
+	mapFirst, mapLength, mapEntryBits := int(data[3])|int(data[4])<<8, int(data[5])|int(data[6])<<8, int(data[7])
+	width, height := int(data[12])|int(data[13])<<8, int(data[14])|int(data[15])<<8
+	pixelBits, descriptor := int(data[16]), data[17]
+
+	if width == 0 || height == 0 {
+		return nil, errors.New("TGA size must be positive")
+	}
+
+	// types 9 to 11 are the run length encoded versions of 1 to 3
+	encoded := imageType >= 9
+
+	if encoded {
+		imageType -= 8
+	}
+
+	if imageType < 1 || imageType > 3 {
+		return nil, errors.New("unsupported TGA image type")
+	}
+
+	offset := 18 + idLength
+	var palette []color.NRGBA
+
+	if colorMapType == 1 {
+		entryBytes := (mapEntryBits + 7) / 8
+
+		if offset+mapLength*entryBytes > len(data) {
+			return nil, errors.New("TGA colour map is truncated")
+		}
+
+		for i := 0; i < mapLength; i++ {
+			entry, err := tgaColor(data[offset+i*entryBytes:], mapEntryBits, descriptor)
+
+			if err != nil {
+				return nil, err
+			}
+
+			palette = append(palette, entry)
+		}
+
+		offset += mapLength * entryBytes
+	}
+
+	pixelBytes := (pixelBits + 7) / 8
+
+	// a run packet of 1 + pixelBytes bytes expands to at most 128 pixels
+	if width*height > 128*len(data) {
+		return nil, errors.New("TGA pixels are truncated")
+	}
+
+	trueColor := pixelBits == 15 || pixelBits == 16 || pixelBits == 24 || pixelBits == 32
+
+	if imageType == 1 && (palette == nil || pixelBytes != 1 && pixelBytes != 2) || imageType == 2 && !trueColor || imageType == 3 && pixelBits != 8 {
+		return nil, errors.New("unsupported TGA pixel depth")
+	}
+
+	// unpack every pixel to its raw bytes first, a run repeats one pixel
+	pixels := make([]byte, 0, width*height*pixelBytes)
+
+	for len(pixels) < cap(pixels) {
+		count, raw := 1, true
+
+		if encoded {
+			if offset >= len(data) {
+				return nil, errors.New("TGA pixels are truncated")
+			}
+
+			count, raw = int(data[offset]&0x7F)+1, data[offset]&0x80 == 0
+			offset++
+		}
+
+		if (cap(pixels)-len(pixels))/pixelBytes < count {
+			return nil, errors.New("TGA run overflows the image")
+		}
+
+		size := pixelBytes
+
+		if raw {
+			size *= count
+		}
+
+		if offset+size > len(data) {
+			return nil, errors.New("TGA pixels are truncated")
+		}
+
+		if raw {
+			pixels = append(pixels, data[offset:offset+size]...)
+		} else {
+			for i := 0; i < count; i++ {
+				pixels = append(pixels, data[offset:offset+size]...)
+			}
+		}
+
+		offset += size
+	}
+
+	// bit 5 of the descriptor puts the first row at the top, bit 4 the first column on the right
+	rowAt := func(y int) int {
+		if descriptor&0x20 != 0 {
+			return y
+		}
+
+		return height - 1 - y
+	}
+
+	columnAt := func(x int) int {
+		if descriptor&0x10 != 0 {
+			return width - 1 - x
+		}
+
+		return x
+	}
+
+	if imageType == 3 {
+		gray := image.NewGray(image.Rect(0, 0, width, height))
+
+		for i, value := range pixels {
+			gray.Pix[rowAt(i/width)*gray.Stride+columnAt(i%width)] = value
+		}
+
+		return gray, nil
+	}
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for i := 0; i < width*height; i++ {
+		pixel := pixels[i*pixelBytes:]
+		var c color.NRGBA
+
+		if imageType == 1 {
+			index := int(pixel[0])
+
+			if pixelBytes == 2 {
+				index |= int(pixel[1]) << 8
+			}
+
+			index -= mapFirst
+
+			if index < 0 || index >= len(palette) {
+				return nil, errors.New("TGA colour index is out of range")
+			}
+
+			c = palette[index]
+		} else if c, err = tgaColor(pixel, pixelBits, descriptor); err != nil {
+			return nil, err
+		}
+
+		offset := rowAt(i/width)*nrgba.Stride + 4*columnAt(i%width)
+		nrgba.Pix[offset], nrgba.Pix[offset+1], nrgba.Pix[offset+2], nrgba.Pix[offset+3] = c.R, c.G, c.B, c.A
+	}
+
+	return nrgba, nil
+}
+
+// tgaColor reads a little endian BGR(A) pixel. 32 bit pixels are opaque when the descriptor declares no
+// alpha bits, which many writers leave as garbage.
+func tgaColor(pixel []byte, bits int, descriptor byte) (color.NRGBA, error) {
+	switch bits {
+	case 15, 16:
+		value := int(pixel[0]) | int(pixel[1])<<8
+		expand := func(v int) uint8 { return uint8(v<<3 | v>>2) }
+		c := color.NRGBA{expand(value >> 10 & 0x1F), expand(value >> 5 & 0x1F), expand(value & 0x1F), 255}
+
+		if bits == 16 && descriptor&0xF != 0 && value&0x8000 == 0 {
+			c.A = 0
+		}
+
+		return c, nil
+	case 24:
+		return color.NRGBA{pixel[2], pixel[1], pixel[0], 255}, nil
+	case 32:
+		c := color.NRGBA{pixel[2], pixel[1], pixel[0], pixel[3]}
+
+		if descriptor&0xF == 0 {
+			c.A = 255
+		}
+
+		return c, nil
+	}
+
+	return color.NRGBA{}, errors.New("unsupported TGA pixel depth")
+}
@@ -0,0 +1,279 @@
+package texture
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+// Texture is a GL texture created from a container.
+type Texture struct {
+	ID     uint32
+	Target uint32 // TEXTURE_2D, TEXTURE_CUBE_MAP or TEXTURE_2D_ARRAY
+	Width  int
+	Height int
+	Layers int
+	Levels int
+}
+
+// Load reads a KTX, KTX2 or DDS file, told apart by their magic numbers, or any image LoadImage reads with
+// default options.
+func Load(path string) (*Container, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(data, ktxIdentifier):
+		return ParseKTX(data)
+	case bytes.HasPrefix(data, ktx2Identifier):
+		return ParseKTX2(data)
+	case bytes.HasPrefix(data, []byte("DDS ")):
+		return ParseDDS(data)
+	}
+
+	return LoadImage(path, Options{})
+}
+
+// Upload creates a texture holding every level, layer and face of the container. Compressed formats the driver
+// does not support are decompressed on the CPU where possible. A single uncompressed level gets a generated
+// mip chain.
+func Upload(c *Container) (*Texture, error) {
+	if c.Format.Compressed && !Supported(c.Format) {
+		decompressed, err := Decompress(c)
+
+		if err != nil {
+			return nil, fmt.Errorf("texture: %s is not supported by the driver: %v", c.Format.Name, err)
+		}
+
+		c = decompressed
+	}
+
+	if c.Layers > 0 && c.Faces == 6 {
+		return nil, fmt.Errorf("texture: cube map arrays are not supported")
+	}
+
+	t := &Texture{Target: gl.TEXTURE_2D, Width: c.Width, Height: c.Height, Layers: c.Layers, Levels: len(c.Levels)}
+	generate := len(c.Levels) == 1 && !c.Format.Compressed
+
+	if generate {
+		t.Levels = mipLevels(c.Width, c.Height)
+	}
+
+	switch {
+	case c.Faces == 6:
+		t.Target = gl.TEXTURE_CUBE_MAP
+	case c.Layers > 0:
+		t.Target = gl.TEXTURE_2D_ARRAY
+	}
+
+	gl.GenTextures(1, &t.ID)
+	gl.BindTexture(t.Target, t.ID)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+
+	if t.Target == gl.TEXTURE_2D_ARRAY {
+		gl.TexStorage3D(t.Target, int32(t.Levels), c.Format.InternalFormat, int32(c.Width), int32(c.Height), int32(c.Layers))
+	} else {
+		gl.TexStorage2D(t.Target, int32(t.Levels), c.Format.InternalFormat, int32(c.Width), int32(c.Height))
+	}
+
+	for i, level := range c.Levels {
+		width, height := int32(level.Width), int32(level.Height)
+		imageSize := c.Format.Size(level.Width, level.Height)
+
+		switch {
+		case t.Target == gl.TEXTURE_2D_ARRAY && c.Format.Compressed:
+			gl.CompressedTexSubImage3D(t.Target, int32(i), 0, 0, 0, width, height, int32(c.Layers), c.Format.InternalFormat, int32(len(level.Data)), gl.Ptr(level.Data))
+		case t.Target == gl.TEXTURE_2D_ARRAY:
+			gl.TexSubImage3D(t.Target, int32(i), 0, 0, 0, width, height, int32(c.Layers), c.Format.PixelFormat, c.Format.PixelType, gl.Ptr(level.Data))
+		default:
+			for face := 0; face < c.Faces; face++ {
+				target := uint32(gl.TEXTURE_2D)
+
+				if c.Faces == 6 {
+					target = gl.TEXTURE_CUBE_MAP_POSITIVE_X + uint32(face)
+				}
+
+				data := level.Data[face*imageSize : (face+1)*imageSize]
+
+				if c.Format.Compressed {
+					gl.CompressedTexSubImage2D(target, int32(i), 0, 0, width, height, c.Format.InternalFormat, int32(imageSize), gl.Ptr(data))
+				} else {
+					gl.TexSubImage2D(target, int32(i), 0, 0, width, height, c.Format.PixelFormat, c.Format.PixelType, gl.Ptr(data))
+				}
+			}
+		}
+	}
+
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 4)
+
+	if generate {
+		gl.GenerateMipmap(t.Target)
+	}
+
+	// files may stop short of a 1x1 level, which leaves the texture incomplete unless the chain is cut there
+	gl.TexParameteri(t.Target, gl.TEXTURE_BASE_LEVEL, 0)
+	gl.TexParameteri(t.Target, gl.TEXTURE_MAX_LEVEL, int32(t.Levels-1))
+
+	if t.Target == gl.TEXTURE_CUBE_MAP {
+		gl.TexParameteri(t.Target, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+		gl.TexParameteri(t.Target, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+		gl.TexParameteri(t.Target, gl.TEXTURE_WRAP_R, gl.CLAMP_TO_EDGE)
+	} else {
+		gl.TexParameteri(t.Target, gl.TEXTURE_WRAP_S, gl.REPEAT)
+		gl.TexParameteri(t.Target, gl.TEXTURE_WRAP_T, gl.REPEAT)
+	}
+
+	if c.Format.Luminance {
+		gl.TexParameteri(t.Target, gl.TEXTURE_SWIZZLE_G, gl.RED)
+		gl.TexParameteri(t.Target, gl.TEXTURE_SWIZZLE_B, gl.RED)
+	}
+
+	gl.TexParameteri(t.Target, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+
+	if t.Levels > 1 {
+		gl.TexParameteri(t.Target, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR)
+	} else {
+		gl.TexParameteri(t.Target, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	}
+
+	gl.BindTexture(t.Target, 0)
+
+	if err := gl.GetError(); err != gl.NO_ERROR {
+		gl.DeleteTextures(1, &t.ID)
+		return nil, fmt.Errorf("texture: GL error 0x%X uploading %s", err, c.Format.Name)
+	}
+
+	return t, nil
+}
+
+// UploadArray stacks single layer containers, one per mesh, into a TEXTURE_2D_ARRAY. They must share a format,
+// size and level count.
+func UploadArray(containers []*Container) (*Texture, error) {
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("texture: no layers to upload")
+	}
+
+	first := containers[0]
+	stacked := &Container{Format: first.Format, Width: first.Width, Height: first.Height, Layers: len(containers), Faces: 1, Levels: []*Level{}}
+
+	for _, level := range first.Levels {
+		stacked.Levels = append(stacked.Levels, &Level{Width: level.Width, Height: level.Height, Data: []byte{}})
+	}
+
+	for i, c := range containers {
+		if c.Images() != 1 {
+			return nil, fmt.Errorf("texture: layer %d holds %d images, expected 1", i, c.Images())
+		}
+
+		if c.Format != first.Format || c.Width != first.Width || c.Height != first.Height || len(c.Levels) != len(first.Levels) {
+			return nil, fmt.Errorf("texture: layer %d is %s %dx%d with %d levels, expected %s %dx%d with %d levels", i,
+				c.Format.Name, c.Width, c.Height, len(c.Levels), first.Format.Name, first.Width, first.Height, len(first.Levels))
+		}
+
+		for j, level := range c.Levels {
+			stacked.Levels[j].Data = append(stacked.Levels[j].Data, level.Data...)
+		}
+	}
+
+	return Upload(stacked)
+}
+
+// Bind makes the texture current on a texture unit, 0 for TEXTURE0.
+func (t *Texture) Bind(unit uint32) {
+	gl.ActiveTexture(gl.TEXTURE0 + unit)
+	gl.BindTexture(t.Target, t.ID)
+}
+
+// Delete frees the GL texture.
+func (t *Texture) Delete() {
+	gl.DeleteTextures(1, &t.ID)
+}
+
+func mipLevels(width, height int) int {
+	levels := 1
+
+	for width > 1 || height > 1 {
+		width, height = width/2, height/2
+		levels++
+	}
+
+	return levels
+}
+
+var supportedFormats map[uint32]bool
+
+// Supported reports whether the driver accepts a compressed format. It needs a current GL context.
+func Supported(f *Format) bool {
+	if !f.Compressed {
+		return true
+	}
+
+	if supportedFormats == nil {
+		supportedFormats = queryFormats()
+	}
+
+	return supportedFormats[f.InternalFormat]
+}
+
+// queryFormats combines the formats the driver lists with the extension families, as core profiles often leave
+// S3TC and BPTC out of COMPRESSED_TEXTURE_FORMATS.
+func queryFormats() map[uint32]bool {
+	found := map[uint32]bool{}
+	var count int32
+
+	gl.GetIntegerv(gl.NUM_COMPRESSED_TEXTURE_FORMATS, &count)
+
+	if count > 0 {
+		listed := make([]int32, count)
+		gl.GetIntegerv(gl.COMPRESSED_TEXTURE_FORMATS, &listed[0])
+
+		for _, format := range listed {
+			found[uint32(format)] = true
+		}
+	}
+
+	// RGTC is core since GL 3.0
+	families := map[string][]uint32{
+		"":                                    {glRedRGTC1, glSignedRedRGTC, glRGRGTC2, glSignedRGRGTC2},
+		"GL_EXT_texture_compression_s3tc":     {glRGBDXT1, glRGBADXT1, glRGBADXT3, glRGBADXT5},
+		"GL_ARB_texture_compression_bptc":     {glBPTCUnorm, glBPTCSRGB, glBPTCSigned, glBPTCUnsigned},
+		"GL_ARB_ES3_compatibility":            {glETC2RGB8, glETC2SRGB8, glETC2RGB8A1, glETC2SRGB8A1, glETC2RGBA8, glETC2SRGB8A8},
+		"GL_OES_compressed_ETC1_RGB8_texture": {glETC1RGB8},
+	}
+
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &count)
+	extensions := map[string]bool{"": true}
+
+	for i := uint32(0); i < uint32(count); i++ {
+		extensions[gl.GoStr(gl.GetStringi(gl.EXTENSIONS, i))] = true
+	}
+
+	for extension, members := range families {
+		if !extensions[extension] {
+			continue
+		}
+
+		for _, format := range members {
+			found[format] = true
+		}
+	}
+
+	// ETC1 data is valid ETC2 data
+	if found[glETC2RGB8] {
+		found[glETC1RGB8] = true
+	}
+
+	// the sRGB S3TC formats need both S3TC and sRGB textures
+	if extensions["GL_EXT_texture_compression_s3tc"] && extensions["GL_EXT_texture_sRGB"] {
+		for _, format := range []uint32{glSRGBDXT1, glSRGBAlphaDXT1, glSRGBAlphaDXT3, glSRGBAlphaDXT5} {
+			found[format] = true
+		}
+	}
+
+	return found
+}